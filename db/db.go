@@ -43,6 +43,20 @@ func (mongo *MongoClient) CreateCollection(collectionName string, opts *options.
 	return db.CreateCollection(Ctx, collectionName, opts)
 }
 
+// Run fn in a transaction, the operations of fn must use the session
+// context to be part of it
+func (mongo *MongoClient) WithTransaction(
+	fn func(sessCtx mongo.SessionContext) (interface{}, error),
+) (interface{}, error) {
+	session, err := mongo.client.StartSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.EndSession(Ctx)
+
+	return session.WithTransaction(Ctx, fn)
+}
+
 func NewConnection(host string, dbName string) *MongoClient {
 	uri := fmt.Sprintf(
 		"%s://%s:%s@%s",
@@ -1 +1,2 @@
-./swag init -g ./query/main_query.go -o ./query/docs
+./swag init --parseDependency -g ./query/main_query.go -o ./query/docs
+./swag init --parseDependency -g ./feed/main_feed.go -o ./feed/docs
@@ -68,7 +68,7 @@ func (g *GradesController) UploadProgramGrade(c *gin.Context) {
 
 // UploadGrade godoc
 // @Summary     Upload grade
-// @Description Upload grade in module to student, ROLS=[teacher]
+// @Description Upload grade in module to student, a draft grade is hidden until released, ROLS=[teacher]
 // @Tags        grades
 // @Tags        classroom
 // @Tags        roles.teacher
@@ -118,7 +118,7 @@ func (g *GradesController) UploadGrade(c *gin.Context) {
 
 // UpdateGrade godoc
 // @Summary     Update grade
-// @Description Update grade in module to student, ROLS=[teacher]
+// @Description Update grade in module to student, the change is registered in the grade history, ROLS=[teacher]
 // @Tags        grades
 // @Tags        classroom
 // @Tags        roles.teacher
@@ -139,6 +139,7 @@ func (g *GradesController) UpdateGrade(c *gin.Context) {
 	var grade *forms.UpdateGradeForm
 	idModule := c.Param("idModule")
 	idGrade := c.Param("idGrade")
+	claims, _ := services.NewClaimsFromContext(c)
 
 	if err := c.BindJSON(&grade); err != nil {
 		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
@@ -147,7 +148,7 @@ func (g *GradesController) UpdateGrade(c *gin.Context) {
 		})
 		return
 	}
-	err := gradesService.UpdateGrade(grade, idModule, idGrade)
+	err := gradesService.UpdateGrade(grade, idModule, idGrade, claims.ID)
 	if err != nil {
 		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
 			Success: false,
@@ -193,3 +194,273 @@ func (g *GradesController) DeleteGradeProgram(c *gin.Context) {
 		Success: true,
 	})
 }
+
+// ReleaseProgram godoc
+// @Summary     Release grades
+// @Description Release the draft grades of a program, with date the release is scheduled, ROLS=[teacher]
+// @Tags        grades
+// @Tags        classroom
+// @Tags        roles.teacher
+// @Accept      json
+// @Param       idModule  path     string                  true "MongoID"
+// @Param       idProgram path     string                  true "MongoID"
+// @Param       release   body     forms.ReleaseGradesForm true "Desc"
+// @Success     200       {object} res.Response{}
+// @Failure     400       {object} res.Response{} "Bad body"
+// @Failure     400       {object} res.Response{} "La calificación no pertenece a este módulo"
+// @Failure     401       {object} res.Response{} "Unauthorized"
+// @Failure     401       {object} res.Response{} "Unauthorized role"
+// @Failure     503       {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"..
+// @Router      /grades/release_program/{idModule}/{idProgram} [post]
+func (g *GradesController) ReleaseProgram(c *gin.Context) {
+	var release *forms.ReleaseGradesForm
+	idModule := c.Param("idModule")
+	idProgram := c.Param("idProgram")
+
+	if err := c.BindJSON(&release); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	err := gradesService.ReleaseProgram(release, idModule, idProgram)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// ImportGrades godoc
+// @Summary     Import grades
+// @Description Import grades from a xlsx or csv file with the layout of the export, students are matched by rut. All rows are validated and imported atomically, ROLS=[teacher]
+// @Tags        grades
+// @Tags        classroom
+// @Tags        roles.teacher
+// @Accept      multipart/form-data
+// @Param       idModule path     string true  "MongoID"
+// @Param       file     formData file   true  "xlsx or csv file"
+// @Param       program  query    string false "MongoID, only import the column of this program"
+// @Param       dry_run  query    bool   false "Only validate the file"
+// @Success     200      {object} res.Response{body=smaps.ImportGradesMap}
+// @Failure     400      {object} res.Response{} "Bad formData"
+// @Failure     400      {object} res.Response{body=smaps.ImportGradesMap} "El archivo tiene errores, no se importó ninguna calificación"
+// @Failure     400      {object} res.Response{} "El archivo debe ser xlsx o csv"
+// @Failure     401      {object} res.Response{} "Unauthorized"
+// @Failure     401      {object} res.Response{} "Unauthorized role"
+// @Failure     503      {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"..
+// @Router      /grades/import_grades/{idModule} [post]
+func (g *GradesController) ImportGrades(c *gin.Context) {
+	idModule := c.Param("idModule")
+	idProgram := c.Query("program")
+	dryRun := c.Query("dry_run") == "true"
+	claims, _ := services.NewClaimsFromContext(c)
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	report, errRes := gradesService.ImportGrades(file, idModule, idProgram, claims.ID, dryRun)
+	if errRes != nil {
+		c.AbortWithStatusJSON(errRes.StatusCode, &res.Response{
+			Success: false,
+			Message: errRes.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["report"] = report
+	if !dryRun && len(report.Errors) > 0 {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: "El archivo tiene errores, no se importó ninguna calificación",
+			Data:    response,
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// UploadProgramTemplate godoc
+// @Summary     Upload grade program template
+// @Description Upload a template of grade programs for a subject and/or course, ROLS=[director,directive]
+// @Tags        grades
+// @Tags        classroom
+// @Tags        roles.director
+// @Tags        roles.directive
+// @Accept      json
+// @Produce     json
+// @Param       template body     forms.GradeProgramTemplateForm true "Desc"
+// @Success     201      {object} res.Response{body=smaps.IdInsertedMap}
+// @Failure     400      {object} res.Response{} "Bad body"
+// @Failure     400      {object} res.Response{} "El porcentaje sumatorio de las calificaciones debe ser exactamente 100 por ciento"
+// @Failure     400      {object} res.Response{} "La calificación N°%d está repetida"
+// @Failure     401      {object} res.Response{} "Unauthorized"
+// @Failure     401      {object} res.Response{} "Unauthorized role"
+// @Failure     409      {object} res.Response{} "Ya existe una plantilla para esta asignatura y nivel"
+// @Failure     503      {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /program_templates/upload_template [post]
+func (g *GradesController) UploadProgramTemplate(c *gin.Context) {
+	var template *forms.GradeProgramTemplateForm
+	claims, _ := services.NewClaimsFromContext(c)
+
+	if err := c.BindJSON(&template); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Upload
+	id, err := gradesService.UploadProgramTemplate(template, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+
+	// Response
+	response := make(map[string]interface{})
+	response["_id"] = id.(primitive.ObjectID).Hex()
+
+	c.JSON(201, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// UpdateProgramTemplate godoc
+// @Summary     Update grade program template
+// @Description Update a template of grade programs, the modules keep its programs until the template is applied, ROLS=[director,directive]
+// @Tags        grades
+// @Tags        classroom
+// @Tags        roles.director
+// @Tags        roles.directive
+// @Accept      json
+// @Produce     json
+// @Param       idTemplate path     string                         true "MongoID"
+// @Param       template   body     forms.GradeProgramTemplateForm true "Desc"
+// @Success     200        {object} res.Response{}
+// @Failure     400        {object} res.Response{} "Bad body"
+// @Failure     400        {object} res.Response{} "El porcentaje sumatorio de las calificaciones debe ser exactamente 100 por ciento"
+// @Failure     401        {object} res.Response{} "Unauthorized"
+// @Failure     401        {object} res.Response{} "Unauthorized role"
+// @Failure     404        {object} res.Response{} "No existe la plantilla"
+// @Failure     409        {object} res.Response{} "Ya existe una plantilla para esta asignatura y nivel"
+// @Failure     503        {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /program_templates/update_template/{idTemplate} [put]
+func (g *GradesController) UpdateProgramTemplate(c *gin.Context) {
+	var template *forms.GradeProgramTemplateForm
+	idTemplate := c.Param("idTemplate")
+
+	if err := c.BindJSON(&template); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Update
+	if err := gradesService.UpdateProgramTemplate(template, idTemplate); err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// DeleteProgramTemplate godoc
+// @Summary     Delete grade program template
+// @Description Delete a template of grade programs, the programs of the modules are kept, ROLS=[director,directive]
+// @Tags        grades
+// @Tags        classroom
+// @Tags        roles.director
+// @Tags        roles.directive
+// @Accept      json
+// @Param       idTemplate path     string true "MongoID"
+// @Success     200        {object} res.Response{}
+// @Failure     400        {object} res.Response{} "Bad path param"
+// @Failure     401        {object} res.Response{} "Unauthorized"
+// @Failure     401        {object} res.Response{} "Unauthorized role"
+// @Failure     404        {object} res.Response{} "No existe la plantilla"
+// @Failure     503        {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /program_templates/delete_template/{idTemplate} [delete]
+func (g *GradesController) DeleteProgramTemplate(c *gin.Context) {
+	idTemplate := c.Param("idTemplate")
+
+	if err := gradesService.DeleteProgramTemplate(idTemplate); err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// ApplyProgramTemplates godoc
+// @Summary     Apply grade program templates
+// @Description Create the grade programs of all the modules of the semester from its template, in one operation, ROLS=[director,directive]
+// @Tags        grades
+// @Tags        classroom
+// @Tags        roles.director
+// @Tags        roles.directive
+// @Accept      json
+// @Produce     json
+// @Param       apply body     forms.ApplyProgramTemplatesForm true "Desc"
+// @Success     200   {object} res.Response{body=smaps.ApplyTemplatesMap}
+// @Failure     400   {object} res.Response{} "Bad body"
+// @Failure     401   {object} res.Response{} "Unauthorized"
+// @Failure     401   {object} res.Response{} "Unauthorized role"
+// @Failure     404   {object} res.Response{} "No hay plantillas de calificaciones"
+// @Failure     503   {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /program_templates/apply_templates [post]
+func (g *GradesController) ApplyProgramTemplates(c *gin.Context) {
+	var apply *forms.ApplyProgramTemplatesForm
+	if err := c.BindJSON(&apply); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Apply
+	applied, err := gradesService.ApplyProgramTemplates(apply)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["applied"] = applied
+
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
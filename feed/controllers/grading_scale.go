@@ -0,0 +1,159 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"github.com/CPU-commits/Intranet_BClassroom/services"
+	"github.com/gin-gonic/gin"
+)
+
+type GradingScaleController struct{}
+
+// Services
+var gradingScaleService = services.NewGradingScaleService()
+
+// UploadModuleScale godoc
+// @Summary     Upload module grading scale
+// @Description Set the grading scale of a module, it replaces the semester scale, ROLS=[teacher]
+// @Tags        classroom
+// @Tags        grades
+// @Tags        roles.teacher
+// @Accept      json
+// @Produce     json
+// @Param       scale    body     forms.GradingScaleForm true "Grading scale"
+// @Param       idModule path     string                 true "Mongo ID Module"
+// @Success     200      {object} res.Response{}
+// @Failure     400      {object} res.Response{} "Bad request - Bad body || Bad scale"
+// @Failure     401      {object} res.Response{} "Unauthorized"
+// @Failure     401      {object} res.Response{} "Unauthorized role"
+// @Failure     503      {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /grades/upload_grading_scale/{idModule} [post]
+func (g *GradingScaleController) UploadModuleScale(c *gin.Context) {
+	var scale *forms.GradingScaleForm
+	idModule := c.Param("idModule")
+	claims, _ := services.NewClaimsFromContext(c)
+
+	if err := c.BindJSON(&scale); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Upload
+	err := gradingScaleService.UploadModuleScale(scale, idModule, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// DeleteModuleScale godoc
+// @Summary     Delete module grading scale
+// @Description Delete the grading scale of a module, it goes back to the semester scale, ROLS=[teacher]
+// @Tags        classroom
+// @Tags        grades
+// @Tags        roles.teacher
+// @Accept      json
+// @Produce     json
+// @Param       idModule path     string true "Mongo ID Module"
+// @Success     200      {object} res.Response{}
+// @Failure     400      {object} res.Response{} "Bad path param"
+// @Failure     401      {object} res.Response{} "Unauthorized"
+// @Failure     401      {object} res.Response{} "Unauthorized role"
+// @Failure     404      {object} res.Response{} "No existe una escala de calificación configurada"
+// @Failure     503      {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /grades/delete_grading_scale/{idModule} [delete]
+func (g *GradingScaleController) DeleteModuleScale(c *gin.Context) {
+	idModule := c.Param("idModule")
+
+	// Delete
+	err := gradingScaleService.DeleteModuleScale(idModule)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// UploadSemesterScale godoc
+// @Summary     Upload semester grading scale
+// @Description Set the grading scale of the current semester for the modules without scale, ROLS=[director,directive]
+// @Tags        classroom
+// @Tags        grades
+// @Tags        roles.directive
+// @Accept      json
+// @Produce     json
+// @Param       scale body     forms.GradingScaleForm true "Grading scale"
+// @Success     200   {object} res.Response{}
+// @Failure     400   {object} res.Response{} "Bad request - Bad body || Bad scale"
+// @Failure     401   {object} res.Response{} "Unauthorized"
+// @Failure     401   {object} res.Response{} "Unauthorized role"
+// @Failure     503   {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /grading_scales/upload_semester_scale [post]
+func (g *GradingScaleController) UploadSemesterScale(c *gin.Context) {
+	var scale *forms.GradingScaleForm
+	claims, _ := services.NewClaimsFromContext(c)
+
+	if err := c.BindJSON(&scale); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Upload
+	err := gradingScaleService.UploadSemesterScale(scale, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// DeleteSemesterScale godoc
+// @Summary     Delete semester grading scale
+// @Description Delete the grading scale of the current semester, ROLS=[director,directive]
+// @Tags        classroom
+// @Tags        grades
+// @Tags        roles.directive
+// @Accept      json
+// @Produce     json
+// @Success     200 {object} res.Response{}
+// @Failure     401 {object} res.Response{} "Unauthorized"
+// @Failure     401 {object} res.Response{} "Unauthorized role"
+// @Failure     404 {object} res.Response{} "No existe una escala de calificación configurada"
+// @Failure     503 {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /grading_scales/delete_semester_scale [delete]
+func (g *GradingScaleController) DeleteSemesterScale(c *gin.Context) {
+	// Delete
+	err := gradingScaleService.DeleteSemesterScale()
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"github.com/CPU-commits/Intranet_BClassroom/services"
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type QuestionBankController struct{}
+
+// Services
+var questionBankService = services.NewQuestionBankService()
+
+// UploadBankQuestion godoc
+// @Summary     Upload question to bank
+// @Description Upload a reusable question to the question bank, ROLS=[teacher]
+// @Tags        classroom
+// @Tags        forms
+// @Tags        roles.teacher
+// @Accept      json
+// @Produce     json
+// @Param       question body     forms.BankQuestionForm true "Add question"
+// @Success     201      {object} res.Response{body=smaps.IdInsertedMap}
+// @Failure     400      {object} res.Response{} "Bad request - Bad body"
+// @Failure     400      {object} res.Response{} "Una pregunta del banco no puede importar otra pregunta del banco"
+// @Failure     401      {object} res.Response{} "Unauthorized"
+// @Failure     401      {object} res.Response{} "Unauthorized role"
+// @Failure     503      {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /forms/upload_bank_question [post]
+func (b *QuestionBankController) UploadBankQuestion(c *gin.Context) {
+	var question *forms.BankQuestionForm
+	claims, _ := services.NewClaimsFromContext(c)
+
+	if err := c.BindJSON(&question); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Insert
+	id, err := questionBankService.UploadQuestion(question, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["_id"] = id.(primitive.ObjectID).Hex()
+
+	c.JSON(http.StatusCreated, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// UpdateBankQuestion godoc
+// @Summary     Update question of bank
+// @Description Update a bank question, form questions imported by reference and not answered are updated too, ROLS=[teacher]
+// @Tags        classroom
+// @Tags        forms
+// @Tags        roles.teacher
+// @Accept      json
+// @Produce     json
+// @Param       question   body     forms.BankQuestionForm true "Update question"
+// @Param       idQuestion path     string                 true "Mongo ID Bank Question"
+// @Success     200        {object} res.Response{}
+// @Failure     400        {object} res.Response{} "Bad request - Bad body"
+// @Failure     401        {object} res.Response{} "Unauthorized"
+// @Failure     401        {object} res.Response{} "No tienes acceso para editar esta pregunta"
+// @Failure     401        {object} res.Response{} "Unauthorized role"
+// @Failure     404        {object} res.Response{} "No existe la pregunta en el banco de preguntas"
+// @Failure     503        {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /forms/update_bank_question/{idQuestion} [put]
+func (b *QuestionBankController) UpdateBankQuestion(c *gin.Context) {
+	idQuestion := c.Param("idQuestion")
+	var question *forms.BankQuestionForm
+	claims, _ := services.NewClaimsFromContext(c)
+
+	if err := c.BindJSON(&question); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Update
+	err := questionBankService.UpdateQuestion(question, idQuestion, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// DeleteBankQuestion godoc
+// @Summary     Delete question of bank
+// @Description Author deletes(soft) a bank question, ROLS=[teacher]
+// @Tags        classroom
+// @Tags        forms
+// @Tags        roles.teacher
+// @Accept      json
+// @Produce     json
+// @Param       idQuestion path     string true "Mongo ID Bank Question"
+// @Success     200        {object} res.Response{}
+// @Failure     400        {object} res.Response{} "Bad path param"
+// @Failure     401        {object} res.Response{} "Unauthorized"
+// @Failure     401        {object} res.Response{} "Unauthorized role"
+// @Failure     401        {object} res.Response{} "No estás autorizado a eliminar esta pregunta"
+// @Failure     404        {object} res.Response{} "No existe la pregunta en el banco de preguntas"
+// @Failure     503        {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /forms/delete_bank_question/{idQuestion} [delete]
+func (b *QuestionBankController) DeleteBankQuestion(c *gin.Context) {
+	idQuestion := c.Param("idQuestion")
+	claims, _ := services.NewClaimsFromContext(c)
+
+	// Delete
+	err := questionBankService.DeleteQuestion(idQuestion, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
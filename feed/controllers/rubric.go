@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"github.com/CPU-commits/Intranet_BClassroom/services"
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type RubricController struct{}
+
+// Services
+var rubricService = services.NewRubricService()
+
+// UploadRubric godoc
+// @Summary     Upload rubric template
+// @Description Upload a rubric template reusable in files works, ROLS=[teacher]
+// @Tags        classroom
+// @Tags        works
+// @Tags        roles.teacher
+// @Accept      json
+// @Produce     json
+// @Param       rubric body     forms.RubricForm true "Add rubric"
+// @Success     201    {object} res.Response{body=smaps.IdInsertedMap}
+// @Failure     400    {object} res.Response{} "Bad request - Bad body"
+// @Failure     401    {object} res.Response{} "Unauthorized"
+// @Failure     401    {object} res.Response{} "Unauthorized role"
+// @Failure     503    {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /works/upload_rubric [post]
+func (r *RubricController) UploadRubric(c *gin.Context) {
+	var rubric *forms.RubricForm
+	claims, _ := services.NewClaimsFromContext(c)
+
+	if err := c.BindJSON(&rubric); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Insert
+	id, err := rubricService.UploadRubric(rubric, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["_id"] = id.(primitive.ObjectID).Hex()
+
+	c.JSON(http.StatusCreated, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// UpdateRubric godoc
+// @Summary     Update rubric template
+// @Description Update a rubric template, works that already use it keep their criteria, ROLS=[teacher]
+// @Tags        classroom
+// @Tags        works
+// @Tags        roles.teacher
+// @Accept      json
+// @Produce     json
+// @Param       rubric   body     forms.RubricForm true "Update rubric"
+// @Param       idRubric path     string           true "Mongo ID Rubric"
+// @Success     200      {object} res.Response{}
+// @Failure     400      {object} res.Response{} "Bad request - Bad body"
+// @Failure     401      {object} res.Response{} "Unauthorized"
+// @Failure     401      {object} res.Response{} "No tienes acceso a esta rúbrica"
+// @Failure     401      {object} res.Response{} "Unauthorized role"
+// @Failure     404      {object} res.Response{} "No existe la rúbrica indicada"
+// @Failure     503      {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /works/update_rubric/{idRubric} [put]
+func (r *RubricController) UpdateRubric(c *gin.Context) {
+	idRubric := c.Param("idRubric")
+	var rubric *forms.RubricForm
+	claims, _ := services.NewClaimsFromContext(c)
+
+	if err := c.BindJSON(&rubric); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Update
+	err := rubricService.UpdateRubric(rubric, idRubric, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// DeleteRubric godoc
+// @Summary     Delete rubric template
+// @Description Author deletes(soft) a rubric template, ROLS=[teacher]
+// @Tags        classroom
+// @Tags        works
+// @Tags        roles.teacher
+// @Accept      json
+// @Produce     json
+// @Param       idRubric path     string true "Mongo ID Rubric"
+// @Success     200      {object} res.Response{}
+// @Failure     400      {object} res.Response{} "Bad path param"
+// @Failure     401      {object} res.Response{} "Unauthorized"
+// @Failure     401      {object} res.Response{} "Unauthorized role"
+// @Failure     401      {object} res.Response{} "No tienes acceso a esta rúbrica"
+// @Failure     404      {object} res.Response{} "No existe la rúbrica indicada"
+// @Failure     503      {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /works/delete_rubric/{idRubric} [delete]
+func (r *RubricController) DeleteRubric(c *gin.Context) {
+	idRubric := c.Param("idRubric")
+	claims, _ := services.NewClaimsFromContext(c)
+
+	// Delete
+	err := rubricService.DeleteRubric(idRubric, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
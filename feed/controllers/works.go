@@ -7,6 +7,7 @@ import (
 	"github.com/CPU-commits/Intranet_BClassroom/res"
 	"github.com/CPU-commits/Intranet_BClassroom/services"
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 // Services
@@ -203,6 +204,43 @@ func (w *WorkController) FinishForm(c *gin.Context) {
 	})
 }
 
+// NewAttempt godoc
+// @Summary Start a new attempt of the form
+// @Desc    Start a new attempt of the form, the current attempt is archived
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.student
+// @Tags    roles.student_directive
+// @Accept  json
+// @Produce json
+// @Param   idWork path     string true "MongoID"
+// @Success 200    {object} res.Response{}
+// @Failure 400    {object} res.Response{} "Bad path param"
+// @Failure 400    {object} res.Response{} "Este formulario no admite múltiples intentos"
+// @Failure 401    {object} res.Response{} "Ya no se pueden realizar intentos en este formulario"
+// @Failure 401    {object} res.Response{} "Unauthorized"
+// @Failure 401    {object} res.Response{} "Unauthorized role"
+// @Failure 403    {object} res.Response{} "No te quedan intentos disponibles"
+// @Failure 409    {object} res.Response{} "Debes terminar el intento actual antes de comenzar otro"
+// @Failure 503    {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/new_attempt/{idWork} [post]
+func (w *WorkController) NewAttempt(c *gin.Context) {
+	idWork := c.Param("idWork")
+	claims, _ := services.NewClaimsFromContext(c)
+	// New attempt
+	errRes := workService.NewAttempt(idWork, claims.ID)
+	if errRes != nil {
+		c.AbortWithStatusJSON(errRes.StatusCode, &res.Response{
+			Message: errRes.Err.Error(),
+			Success: false,
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
 // UploadPointsQuestion godoc
 // @Summary Upload points question
 // @Desc    Upload points question
@@ -516,6 +554,7 @@ func (w *WorkController) UpdateWork(c *gin.Context) {
 // @Accept  json
 // @Produce json
 // @Param   idWork path     string true "MongoID"
+// @Param   draft  query    bool   false "Keep the grades as draft until released"
 // @Success 200    {object} res.Response{}
 // @Failure 400    {object} res.Response{} "Bad path param"
 // @Failure 400    {object} res.Response{} "Este formulario no puede ser calificado, el formulario no tiene puntos"
@@ -530,8 +569,9 @@ func (w *WorkController) UpdateWork(c *gin.Context) {
 func (w *WorkController) GradeForm(c *gin.Context) {
 	claims, _ := services.NewClaimsFromContext(c)
 	idWork := c.Param("idWork")
+	draft := c.Query("draft") == "true"
 	// Grade
-	err := workService.GradeWork(idWork, claims.ID, "form")
+	err := workService.GradeWork(idWork, claims.ID, "form", draft)
 	if err != nil {
 		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
 			Success: false,
@@ -553,6 +593,7 @@ func (w *WorkController) GradeForm(c *gin.Context) {
 // @Accept  json
 // @Produce json
 // @Param   idWork path     string true "MongoID"
+// @Param   draft  query    bool   false "Keep the grades as draft until released"
 // @Success 200    {object} res.Response{}
 // @Failure 400    {object} res.Response{} "Bad path param"
 // @Failure 400    {object} res.Response{} "El trabajo no es de tipo archivos"
@@ -566,8 +607,9 @@ func (w *WorkController) GradeForm(c *gin.Context) {
 func (w *WorkController) GradeFiles(c *gin.Context) {
 	claims, _ := services.NewClaimsFromContext(c)
 	idWork := c.Param("idWork")
+	draft := c.Query("draft") == "true"
 	// Grade
-	err := workService.GradeWork(idWork, claims.ID, "files")
+	err := workService.GradeWork(idWork, claims.ID, "files", draft)
 	if err != nil {
 		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
 			Success: false,
@@ -589,6 +631,7 @@ func (w *WorkController) GradeFiles(c *gin.Context) {
 // @Accept  json
 // @Produce json
 // @Param   idWork path     string true "MongoID"
+// @Param   draft  query    bool   false "Keep the grades as draft until released"
 // @Success 200    {object} res.Response{}
 // @Failure 400    {object} res.Response{} "Bad path param"
 // @Failure 400    {object} res.Response{} "El trabajo no es de tipo presencial"
@@ -602,8 +645,232 @@ func (w *WorkController) GradeFiles(c *gin.Context) {
 func (*WorkController) GradeInperson(c *gin.Context) {
 	claims, _ := services.NewClaimsFromContext(c)
 	idWork := c.Param("idWork")
+	draft := c.Query("draft") == "true"
 	// Grade
-	err := workService.GradeWork(idWork, claims.ID, "in-person")
+	err := workService.GradeWork(idWork, claims.ID, "in-person", draft)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// ReleaseWorkGrades godoc
+// @Summary Release work grades
+// @Desc    Release the draft grades of a work, with date the release is scheduled
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork  path     string                  true "MongoID"
+// @Param   release body     forms.ReleaseGradesForm true "Desc"
+// @Success 200     {object} res.Response{}
+// @Failure 400     {object} res.Response{} "Bad body"
+// @Failure 400     {object} res.Response{} "Bad path param"
+// @Failure 400     {object} res.Response{} "Este trabajo todavía no está evaluado"
+// @Failure 401     {object} res.Response{} "Unauthorized"
+// @Failure 401     {object} res.Response{} "Unauthorized role"
+// @Failure 503     {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/release_grades/{idWork} [post]
+func (w *WorkController) ReleaseWorkGrades(c *gin.Context) {
+	var release *forms.ReleaseGradesForm
+	idWork := c.Param("idWork")
+
+	if err := c.BindJSON(&release); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Release
+	err := workService.ReleaseWorkGrades(release, idWork)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// UploadPeerReview godoc
+// @Summary Upload peer review
+// @Desc    Upload the review of a classmate submission against the pattern of the work, within the review window
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.student
+// @Tags    roles.student_directive
+// @Accept  json
+// @Produce json
+// @Param   idWork   path     string                    true "MongoID"
+// @Param   idReview path     string                    true "MongoID"
+// @Param   evaluate body     []forms.EvaluateFilesForm true "Desc"
+// @Success 200      {object} res.Response{}
+// @Failure 400      {object} res.Response{} "Bad path param || Bad body"
+// @Failure 400      {object} res.Response{} "Este trabajo no tiene revisión de pares"
+// @Failure 400      {object} res.Response{} "La revisión debe evaluar todos los items de la pauta"
+// @Failure 401      {object} res.Response{} "Unauthorized"
+// @Failure 401      {object} res.Response{} "Unauthorized role"
+// @Failure 401      {object} res.Response{} "La revisión de pares todavía no comienza"
+// @Failure 401      {object} res.Response{} "La revisión de pares de este trabajo ya terminó"
+// @Failure 404      {object} res.Response{} "No existe la revisión indicada"
+// @Failure 503      {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/upload_peer_review/{idWork}/{idReview} [post]
+func (w *WorkController) UploadPeerReview(c *gin.Context) {
+	var evaluate []forms.EvaluateFilesForm
+	idWork := c.Param("idWork")
+	idReview := c.Param("idReview")
+
+	if err := c.BindJSON(&evaluate); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	claims, _ := services.NewClaimsFromContext(c)
+	// Upload
+	err := workService.UploadPeerReview(evaluate, idWork, idReview, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// ResolvePeerReview godoc
+// @Summary Resolve peer review
+// @Desc    Evaluate the submission of a student accepting, blending or overriding the peer reviews, after the review window
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork    path     string                      true "MongoID"
+// @Param   idStudent path     string                      true "MongoID"
+// @Param   resolve   body     forms.ResolvePeerReviewForm true "Desc"
+// @Success 200       {object} res.Response{}
+// @Failure 400       {object} res.Response{} "Bad path param || Bad body"
+// @Failure 400       {object} res.Response{} "Este trabajo no tiene revisión de pares"
+// @Failure 400       {object} res.Response{} "La entrega del alumno no tiene revisiones de pares"
+// @Failure 401       {object} res.Response{} "Unauthorized"
+// @Failure 401       {object} res.Response{} "Unauthorized role"
+// @Failure 401       {object} res.Response{} "La revisión de pares de este trabajo todavía no termina"
+// @Failure 503       {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/resolve_peer_review/{idWork}/{idStudent} [post]
+func (w *WorkController) ResolvePeerReview(c *gin.Context) {
+	var resolve *forms.ResolvePeerReviewForm
+	idWork := c.Param("idWork")
+	idStudent := c.Param("idStudent")
+
+	if err := c.BindJSON(&resolve); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	claims, _ := services.NewClaimsFromContext(c)
+	// Resolve
+	err := workService.ResolvePeerReview(resolve, idWork, claims.ID, idStudent)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// AnalyzeSimilarity godoc
+// @Summary Analyze similarity
+// @Desc    Start the similarity analysis of the written answers or the uploaded PDF and text files of a work. The analysis runs in background, its report is get by the query service
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork     path     string                   true "MongoID"
+// @Param   similarity body     forms.WorkSimilarityForm true "Threshold"
+// @Success 200        {object} res.Response{}
+// @Failure 400        {object} res.Response{} "Bad path param || Bad body"
+// @Failure 400        {object} res.Response{} "El análisis de similitud es solo para trabajos de formulario o archivos"
+// @Failure 401        {object} res.Response{} "Unauthorized"
+// @Failure 401        {object} res.Response{} "Unauthorized role"
+// @Failure 409        {object} res.Response{} "Ya hay un análisis de similitud en curso para este trabajo"
+// @Failure 503        {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/analyze_similarity/{idWork} [post]
+func (w *WorkController) AnalyzeSimilarity(c *gin.Context) {
+	var similarity *forms.WorkSimilarityForm
+	idWork := c.Param("idWork")
+
+	if err := c.BindJSON(&similarity); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	claims, _ := services.NewClaimsFromContext(c)
+	// Analyze
+	err := workService.AnalyzeSimilarity(similarity, idWork, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// RemoveQuestion godoc
+// @Summary Remove question from the grade
+// @Desc    Remove a question of a revised form work from the grade and grade again all the students
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork     path     string true "MongoID"
+// @Param   idQuestion path     string true "MongoID"
+// @Success 200        {object} res.Response{}
+// @Failure 400        {object} res.Response{} "Bad path param"
+// @Failure 400        {object} res.Response{} "Este trabajo todavía no está evaluado"
+// @Failure 400        {object} res.Response{} "No se pueden eliminar todas las preguntas con puntaje"
+// @Failure 401        {object} res.Response{} "Unauthorized"
+// @Failure 401        {object} res.Response{} "Unauthorized role"
+// @Failure 404        {object} res.Response{} "La pregunta no pertenece al trabajo indicado"
+// @Failure 409        {object} res.Response{} "Esta pregunta ya fue eliminada de la calificación"
+// @Failure 503        {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/remove_question/{idWork}/{idQuestion} [post]
+func (w *WorkController) RemoveQuestion(c *gin.Context) {
+	idWork := c.Param("idWork")
+	idQuestion := c.Param("idQuestion")
+
+	claims, _ := services.NewClaimsFromContext(c)
+	// Remove
+	err := workService.RemoveQuestion(idWork, idQuestion, claims.ID)
 	if err != nil {
 		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
 			Success: false,
@@ -755,3 +1022,787 @@ func (w *WorkController) DeleteItemPattern(c *gin.Context) {
 		Success: true,
 	})
 }
+
+// GetExtensions godoc
+// @Summary Get extensions
+// @Desc    Get students extensions of work
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork path     string true "MongoID"
+// @Success 200    {object} res.Response{body=smaps.WorkExtensionsMap}
+// @Failure 400    {object} res.Response{} "Bad path param"
+// @Failure 401    {object} res.Response{} "Unauthorized"
+// @Failure 401    {object} res.Response{} "Unauthorized role"
+// @Failure 503    {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/get_extensions/{idWork} [get]
+func (w *WorkController) GetExtensions(c *gin.Context) {
+	idWork := c.Param("idWork")
+	// Get
+	extensions, err := workService.GetExtensions(idWork)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["extensions"] = extensions
+
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// UploadExtension godoc
+// @Summary Upload extension
+// @Desc    Upload or replace student extension of work
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork    path     string                  true "MongoID"
+// @Param   extension body     forms.WorkExtensionForm true "Desc"
+// @Success 201       {object} res.Response{body=smaps.IdInsertedMap}
+// @Failure 400       {object} res.Response{} "Bad body"
+// @Failure 400       {object} res.Response{} "Bad path param"
+// @Failure 400       {object} res.Response{} "No se pueden otorgar prórrogas a un trabajo presencial"
+// @Failure 400       {object} res.Response{} "El tiempo de acceso solo aplica a formularios con tiempo"
+// @Failure 400       {object} res.Response{} "La fecha límite de la prórroga debe ser mayor a la del trabajo"
+// @Failure 400       {object} res.Response{} "El estudiante no pertenece a este módulo"
+// @Failure 401       {object} res.Response{} "Unauthorized"
+// @Failure 401       {object} res.Response{} "Unauthorized role"
+// @Failure 403       {object} res.Response{} "Este trabajo ya está evaluado"
+// @Failure 503       {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/upload_extension/{idWork} [post]
+func (w *WorkController) UploadExtension(c *gin.Context) {
+	var extension *forms.WorkExtensionForm
+	idWork := c.Param("idWork")
+	claims, _ := services.NewClaimsFromContext(c)
+
+	if err := c.BindJSON(&extension); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Upload
+	id, err := workService.UploadExtension(extension, idWork, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["_id"] = id.(primitive.ObjectID).Hex()
+
+	c.JSON(201, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// DeleteExtension godoc
+// @Summary Delete extension
+// @Desc    Revoke student extension of work
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork      path     string true "MongoID"
+// @Param   idExtension path     string true "MongoID"
+// @Success 200         {object} res.Response{}
+// @Failure 400         {object} res.Response{} "Bad path param"
+// @Failure 401         {object} res.Response{} "Unauthorized"
+// @Failure 401         {object} res.Response{} "Unauthorized role"
+// @Failure 403         {object} res.Response{} "Este trabajo ya está evaluado"
+// @Failure 404         {object} res.Response{} "No existe la prórroga indicada"
+// @Failure 409         {object} res.Response{} "Esta prórroga no pertenece al trabajo indicado"
+// @Failure 503         {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/delete_extension/{idWork}/{idExtension} [delete]
+func (w *WorkController) DeleteExtension(c *gin.Context) {
+	idWork := c.Param("idWork")
+	idExtension := c.Param("idExtension")
+	// Delete
+	err := workService.DeleteExtension(idWork, idExtension)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// PublishWork godoc
+// @Summary Publish work
+// @Desc    Publish a draft work now, or schedule its publication with a date.
+// @Desc    The students are notified once the work is published
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork  path     string                true "MongoID"
+// @Param   publish body     forms.PublishWorkForm true "Desc"
+// @Success 200     {object} res.Response{}
+// @Failure 400     {object} res.Response{} "Bad body"
+// @Failure 400     {object} res.Response{} "Bad path param"
+// @Failure 400     {object} res.Response{} "La fecha de publicación debe ser anterior a la fecha límite"
+// @Failure 401     {object} res.Response{} "Unauthorized"
+// @Failure 401     {object} res.Response{} "Unauthorized role"
+// @Failure 409     {object} res.Response{} "Este trabajo ya está publicado"
+// @Failure 503     {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/publish_work/{idWork} [post]
+func (w *WorkController) PublishWork(c *gin.Context) {
+	var publish *forms.PublishWorkForm
+	idWork := c.Param("idWork")
+
+	if err := c.BindJSON(&publish); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Publish
+	err := workService.PublishWork(publish, idWork)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// CloneWork godoc
+// @Summary Clone work
+// @Desc    Clone the work, with its form and questions, into the modules of the teacher.
+// @Desc    The dates are shifted by the offset in days, the sessions are only kept in the same section
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork path     string              true "MongoID"
+// @Param   clone  body     forms.WorkCloneForm true "Desc"
+// @Success 201    {object} res.Response{body=smaps.WorkClonesMap}
+// @Failure 400    {object} res.Response{} "Bad body"
+// @Failure 400    {object} res.Response{} "Bad path param"
+// @Failure 400    {object} res.Response{} "Este trabajo no es calificado, no se le puede asignar una calificación"
+// @Failure 400    {object} res.Response{} "La calificación indicada no pertenece a este módulo"
+// @Failure 400    {object} res.Response{} "Esta calificación está registrada ya a un trabajo"
+// @Failure 401    {object} res.Response{} "Unauthorized"
+// @Failure 401    {object} res.Response{} "Unauthorized role"
+// @Failure 401    {object} res.Response{} "No tienes acceso al módulo"
+// @Failure 503    {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/clone_work/{idWork} [post]
+func (w *WorkController) CloneWork(c *gin.Context) {
+	var clone *forms.WorkCloneForm
+	idWork := c.Param("idWork")
+	claims, _ := services.NewClaimsFromContext(c)
+
+	if err := c.BindJSON(&clone); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Clone
+	works, err := workService.CloneWork(clone, idWork, claims)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["works"] = works
+
+	c.JSON(201, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// UploadGroup godoc
+// @Summary Upload group
+// @Desc    Form a group of students of a group work
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork path     string              true "MongoID"
+// @Param   group  body     forms.WorkGroupForm true "Desc"
+// @Success 201    {object} res.Response{body=smaps.IdInsertedMap}
+// @Failure 400    {object} res.Response{} "Bad body"
+// @Failure 400    {object} res.Response{} "Bad path param"
+// @Failure 400    {object} res.Response{} "Este trabajo no es en grupo"
+// @Failure 400    {object} res.Response{} "El grupo debe tener entre N y M integrantes"
+// @Failure 400    {object} res.Response{} "El estudiante no pertenece a este módulo"
+// @Failure 401    {object} res.Response{} "Unauthorized"
+// @Failure 401    {object} res.Response{} "Unauthorized role"
+// @Failure 403    {object} res.Response{} "Este trabajo ya está evaluado"
+// @Failure 409    {object} res.Response{} "El estudiante ya pertenece a otro grupo"
+// @Failure 409    {object} res.Response{} "El estudiante ya tiene una entrega en este trabajo"
+// @Failure 503    {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/upload_group/{idWork} [post]
+func (w *WorkController) UploadGroup(c *gin.Context) {
+	var group *forms.WorkGroupForm
+	idWork := c.Param("idWork")
+	claims, _ := services.NewClaimsFromContext(c)
+
+	if err := c.BindJSON(&group); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Upload
+	id, err := workService.UploadGroup(group, idWork, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["_id"] = id.(primitive.ObjectID).Hex()
+
+	c.JSON(201, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// UpdateGroup godoc
+// @Summary Update group
+// @Desc    Update the name and members of a group, while the group has no submission
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork  path     string              true "MongoID"
+// @Param   idGroup path     string              true "MongoID"
+// @Param   group   body     forms.WorkGroupForm true "Desc"
+// @Success 200     {object} res.Response{}
+// @Failure 400     {object} res.Response{} "Bad body"
+// @Failure 400     {object} res.Response{} "Bad path param"
+// @Failure 400     {object} res.Response{} "Este trabajo no es en grupo"
+// @Failure 400     {object} res.Response{} "El grupo debe tener entre N y M integrantes"
+// @Failure 400     {object} res.Response{} "El estudiante no pertenece a este módulo"
+// @Failure 401     {object} res.Response{} "Unauthorized"
+// @Failure 401     {object} res.Response{} "Unauthorized role"
+// @Failure 403     {object} res.Response{} "Este trabajo ya está evaluado"
+// @Failure 404     {object} res.Response{} "No existe el grupo indicado"
+// @Failure 409     {object} res.Response{} "El grupo ya tiene una entrega, no se pueden modificar sus integrantes"
+// @Failure 409     {object} res.Response{} "El estudiante ya pertenece a otro grupo"
+// @Failure 503     {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/update_group/{idWork}/{idGroup} [put]
+func (w *WorkController) UpdateGroup(c *gin.Context) {
+	var group *forms.WorkGroupForm
+	idWork := c.Param("idWork")
+	idGroup := c.Param("idGroup")
+
+	if err := c.BindJSON(&group); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Update
+	err := workService.UpdateGroup(group, idWork, idGroup)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// DeleteGroup godoc
+// @Summary Delete group
+// @Desc    Delete a group, while the group has no submission
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork  path     string true "MongoID"
+// @Param   idGroup path     string true "MongoID"
+// @Success 200     {object} res.Response{}
+// @Failure 400     {object} res.Response{} "Bad path param"
+// @Failure 400     {object} res.Response{} "Este trabajo no es en grupo"
+// @Failure 401     {object} res.Response{} "Unauthorized"
+// @Failure 401     {object} res.Response{} "Unauthorized role"
+// @Failure 403     {object} res.Response{} "Este trabajo ya está evaluado"
+// @Failure 404     {object} res.Response{} "No existe el grupo indicado"
+// @Failure 409     {object} res.Response{} "El grupo ya tiene una entrega, no se pueden modificar sus integrantes"
+// @Failure 503     {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/delete_group/{idWork}/{idGroup} [delete]
+func (w *WorkController) DeleteGroup(c *gin.Context) {
+	idWork := c.Param("idWork")
+	idGroup := c.Param("idGroup")
+	// Delete
+	err := workService.DeleteGroup(idWork, idGroup)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// AdjustGroupMember godoc
+// @Summary Adjust group member
+// @Desc    Set the adjustment of a member to the grade of the group, before grading the work
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork     path     string                    true "MongoID"
+// @Param   idGroup    path     string                    true "MongoID"
+// @Param   idStudent  path     string                    true "MongoID"
+// @Param   adjustment body     forms.GroupAdjustmentForm true "Desc"
+// @Success 200        {object} res.Response{}
+// @Failure 400        {object} res.Response{} "Bad body"
+// @Failure 400        {object} res.Response{} "Bad path param"
+// @Failure 400        {object} res.Response{} "Este trabajo no es en grupo"
+// @Failure 400        {object} res.Response{} "El estudiante no pertenece a este grupo"
+// @Failure 400        {object} res.Response{} "El ajuste debe estar entre N y M"
+// @Failure 401        {object} res.Response{} "Unauthorized"
+// @Failure 401        {object} res.Response{} "Unauthorized role"
+// @Failure 403        {object} res.Response{} "Los ajustes solo se pueden modificar antes de calificar el trabajo"
+// @Failure 404        {object} res.Response{} "No existe el grupo indicado"
+// @Failure 503        {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/adjust_group_member/{idWork}/{idGroup}/{idStudent} [post]
+func (w *WorkController) AdjustGroupMember(c *gin.Context) {
+	var adjustment *forms.GroupAdjustmentForm
+	idWork := c.Param("idWork")
+	idGroup := c.Param("idGroup")
+	idStudent := c.Param("idStudent")
+
+	if err := c.BindJSON(&adjustment); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Adjust
+	err := workService.AdjustGroupMember(adjustment, idWork, idGroup, idStudent)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// CreateGroup godoc
+// @Summary Create group
+// @Desc    Create a group of a self mode group work with the student as its first member
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.student
+// @Tags    roles.student_directive
+// @Accept  json
+// @Produce json
+// @Param   idWork path     string                  true "MongoID"
+// @Param   group  body     forms.SelfWorkGroupForm true "Desc"
+// @Success 201    {object} res.Response{body=smaps.IdInsertedMap}
+// @Failure 400    {object} res.Response{} "Bad body"
+// @Failure 400    {object} res.Response{} "Bad path param"
+// @Failure 400    {object} res.Response{} "Este trabajo no es en grupo"
+// @Failure 401    {object} res.Response{} "Unauthorized"
+// @Failure 401    {object} res.Response{} "Unauthorized role"
+// @Failure 401    {object} res.Response{} "Los grupos de este trabajo los forma el profesor"
+// @Failure 401    {object} res.Response{} "Ya no se pueden modificar los grupos de este trabajo"
+// @Failure 409    {object} res.Response{} "El estudiante ya pertenece a otro grupo"
+// @Failure 409    {object} res.Response{} "El estudiante ya tiene una entrega en este trabajo"
+// @Failure 503    {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/create_group/{idWork} [post]
+func (w *WorkController) CreateGroup(c *gin.Context) {
+	var group *forms.SelfWorkGroupForm
+	idWork := c.Param("idWork")
+	claims, _ := services.NewClaimsFromContext(c)
+
+	if err := c.BindJSON(&group); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Create
+	id, err := workService.CreateGroup(group, idWork, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["_id"] = id.(primitive.ObjectID).Hex()
+
+	c.JSON(201, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// JoinGroup godoc
+// @Summary Join group
+// @Desc    Join a group of a self mode group work, while the group has room and no submission
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.student
+// @Tags    roles.student_directive
+// @Accept  json
+// @Produce json
+// @Param   idWork  path     string true "MongoID"
+// @Param   idGroup path     string true "MongoID"
+// @Success 200     {object} res.Response{}
+// @Failure 400     {object} res.Response{} "Bad path param"
+// @Failure 400     {object} res.Response{} "Este trabajo no es en grupo"
+// @Failure 401     {object} res.Response{} "Unauthorized"
+// @Failure 401     {object} res.Response{} "Unauthorized role"
+// @Failure 401     {object} res.Response{} "Los grupos de este trabajo los forma el profesor"
+// @Failure 401     {object} res.Response{} "Ya no se pueden modificar los grupos de este trabajo"
+// @Failure 404     {object} res.Response{} "No existe el grupo indicado"
+// @Failure 409     {object} res.Response{} "El grupo ya tiene el máximo de N integrantes"
+// @Failure 409     {object} res.Response{} "El grupo ya tiene una entrega, no se pueden modificar sus integrantes"
+// @Failure 409     {object} res.Response{} "El estudiante ya pertenece a otro grupo"
+// @Failure 503     {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/join_group/{idWork}/{idGroup} [post]
+func (w *WorkController) JoinGroup(c *gin.Context) {
+	idWork := c.Param("idWork")
+	idGroup := c.Param("idGroup")
+	claims, _ := services.NewClaimsFromContext(c)
+	// Join
+	err := workService.JoinGroup(idWork, idGroup, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// LeaveGroup godoc
+// @Summary Leave group
+// @Desc    Leave the group of a self mode group work, the group is deleted with its last member
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.student
+// @Tags    roles.student_directive
+// @Accept  json
+// @Produce json
+// @Param   idWork path     string true "MongoID"
+// @Success 200    {object} res.Response{}
+// @Failure 400    {object} res.Response{} "Bad path param"
+// @Failure 400    {object} res.Response{} "Este trabajo no es en grupo"
+// @Failure 401    {object} res.Response{} "Unauthorized"
+// @Failure 401    {object} res.Response{} "Unauthorized role"
+// @Failure 401    {object} res.Response{} "Los grupos de este trabajo los forma el profesor"
+// @Failure 401    {object} res.Response{} "Ya no se pueden modificar los grupos de este trabajo"
+// @Failure 404    {object} res.Response{} "No perteneces a un grupo en este trabajo"
+// @Failure 409    {object} res.Response{} "El grupo ya tiene una entrega, no se pueden modificar sus integrantes"
+// @Failure 503    {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/leave_group/{idWork} [post]
+func (w *WorkController) LeaveGroup(c *gin.Context) {
+	idWork := c.Param("idWork")
+	claims, _ := services.NewClaimsFromContext(c)
+	// Leave
+	err := workService.LeaveGroup(idWork, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// GetFeedback godoc
+// @Summary Get feedback
+// @Desc    Get feedback comments of the student in the work
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork    path     string true "MongoID"
+// @Param   idStudent path     string true "MongoID"
+// @Success 200       {object} res.Response{body=smaps.WorkFeedbackMap}
+// @Failure 400       {object} res.Response{} "Bad path param"
+// @Failure 401       {object} res.Response{} "Unauthorized"
+// @Failure 401       {object} res.Response{} "Unauthorized role"
+// @Failure 503       {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/get_feedback/{idWork}/{idStudent} [get]
+func (w *WorkController) GetFeedback(c *gin.Context) {
+	idWork := c.Param("idWork")
+	idStudent := c.Param("idStudent")
+	// Get
+	feedback, err := workService.GetFeedback(idWork, idStudent)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["feedback"] = feedback
+
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// UploadFeedback godoc
+// @Summary Upload feedback
+// @Desc    Upload or replace a feedback comment on a question, an uploaded file or the whole work.
+// @Desc    Students see it when the work is revised
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork    path     string                 true "MongoID"
+// @Param   idStudent path     string                 true "MongoID"
+// @Param   feedback  body     forms.WorkFeedbackForm true "Desc"
+// @Success 201       {object} res.Response{body=smaps.IdInsertedMap}
+// @Failure 400       {object} res.Response{} "Bad body"
+// @Failure 400       {object} res.Response{} "Bad path param"
+// @Failure 400       {object} res.Response{} "El estudiante no pertenece a este módulo"
+// @Failure 400       {object} res.Response{} "Solo se pueden comentar preguntas en un formulario"
+// @Failure 400       {object} res.Response{} "Solo se pueden comentar archivos en un trabajo de archivos"
+// @Failure 401       {object} res.Response{} "Todavía no se puede evaluar el trabajo"
+// @Failure 401       {object} res.Response{} "Unauthorized"
+// @Failure 401       {object} res.Response{} "Unauthorized role"
+// @Failure 404       {object} res.Response{} "La pregunta no pertenece al trabajo indicado"
+// @Failure 404       {object} res.Response{} "El archivo no fue subido por el alumno en este trabajo"
+// @Failure 503       {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/upload_feedback/{idWork}/{idStudent} [post]
+func (w *WorkController) UploadFeedback(c *gin.Context) {
+	var feedback *forms.WorkFeedbackForm
+	idWork := c.Param("idWork")
+	idStudent := c.Param("idStudent")
+	claims, _ := services.NewClaimsFromContext(c)
+
+	if err := c.BindJSON(&feedback); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Upload
+	id, err := workService.UploadFeedback(feedback, idWork, idStudent, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["_id"] = id.(primitive.ObjectID).Hex()
+
+	c.JSON(201, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// DeleteFeedback godoc
+// @Summary Delete feedback
+// @Desc    Delete feedback comment of work
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork     path     string true "MongoID"
+// @Param   idFeedback path     string true "MongoID"
+// @Success 200        {object} res.Response{}
+// @Failure 400        {object} res.Response{} "Bad path param"
+// @Failure 401        {object} res.Response{} "Unauthorized"
+// @Failure 401        {object} res.Response{} "Unauthorized role"
+// @Failure 404        {object} res.Response{} "No existe el comentario indicado"
+// @Failure 409        {object} res.Response{} "Este comentario no pertenece al trabajo indicado"
+// @Failure 503        {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/delete_feedback/{idWork}/{idFeedback} [delete]
+func (w *WorkController) DeleteFeedback(c *gin.Context) {
+	idWork := c.Param("idWork")
+	idFeedback := c.Param("idFeedback")
+	// Delete
+	err := workService.DeleteFeedback(idWork, idFeedback)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
+
+// UploadAppeal godoc
+// @Summary Upload appeal
+// @Desc    Student or attorney asks the teacher to review the grade of a work
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.student
+// @Tags    roles.student_directive
+// @Tags    roles.attorney
+// @Accept  json
+// @Produce json
+// @Param   idWork path     string               true "MongoID"
+// @Param   appeal body     forms.WorkAppealForm true "Desc"
+// @Success 201    {object} res.Response{body=smaps.IdInsertedMap}
+// @Failure 400    {object} res.Response{} "Bad body"
+// @Failure 400    {object} res.Response{} "Bad path param"
+// @Failure 400    {object} res.Response{} "Se debe indicar el estudiante de la apelación"
+// @Failure 400    {object} res.Response{} "Este trabajo todavía no está evaluado"
+// @Failure 401    {object} res.Response{} "No eres apoderado de este estudiante"
+// @Failure 401    {object} res.Response{} "Unauthorized"
+// @Failure 401    {object} res.Response{} "Unauthorized role"
+// @Failure 404    {object} res.Response{} "El estudiante no tiene calificación en este trabajo"
+// @Failure 409    {object} res.Response{} "Ya existe una apelación abierta para este trabajo"
+// @Failure 503    {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/upload_appeal/{idWork} [post]
+func (w *WorkController) UploadAppeal(c *gin.Context) {
+	var appeal *forms.WorkAppealForm
+	idWork := c.Param("idWork")
+	claims, _ := services.NewClaimsFromContext(c)
+
+	if err := c.BindJSON(&appeal); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Upload
+	id, err := workService.UploadAppeal(appeal, idWork, claims)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["_id"] = id.(primitive.ObjectID).Hex()
+
+	c.JSON(201, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// AnswerAppeal godoc
+// @Summary Answer appeal
+// @Desc    Accept an appeal, resolved with the re-evaluation of the student, or reject it with a reason
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork   path     string                     true "MongoID"
+// @Param   idAppeal path     string                     true "MongoID"
+// @Param   answer   body     forms.AnswerWorkAppealForm true "Desc"
+// @Success 200      {object} res.Response{}
+// @Failure 400      {object} res.Response{} "Bad body"
+// @Failure 400      {object} res.Response{} "Bad path param"
+// @Failure 400      {object} res.Response{} "Se debe indicar el motivo del rechazo"
+// @Failure 401      {object} res.Response{} "Unauthorized"
+// @Failure 401      {object} res.Response{} "Unauthorized role"
+// @Failure 404      {object} res.Response{} "No existe la apelación"
+// @Failure 409      {object} res.Response{} "La apelación no pertenece al trabajo indicado"
+// @Failure 409      {object} res.Response{} "Esta apelación ya fue respondida"
+// @Failure 503      {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/answer_appeal/{idWork}/{idAppeal} [put]
+func (w *WorkController) AnswerAppeal(c *gin.Context) {
+	var answer *forms.AnswerWorkAppealForm
+	idWork := c.Param("idWork")
+	idAppeal := c.Param("idAppeal")
+	claims, _ := services.NewClaimsFromContext(c)
+
+	if err := c.BindJSON(&answer); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+	// Answer
+	err := workService.AnswerAppeal(answer, idWork, idAppeal, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &res.Response{
+		Success: true,
+	})
+}
@@ -0,0 +1,11434 @@
+// Package docs GENERATED BY SWAG; DO NOT EDIT
+// This file was generated by swaggo/swag
+package docs
+
+import "github.com/swaggo/swag"
+
+const docTemplate = `{
+    "schemes": {{ marshal .Schemes }},
+    "consumes": [
+        "application/json"
+    ],
+    "produces": [
+        "application/json"
+    ],
+    "swagger": "2.0",
+    "info": {
+        "description": "{{escape .Description}}",
+        "title": "{{.Title}}",
+        "termsOfService": "http://swagger.io/terms/",
+        "contact": {
+            "name": "API Support",
+            "url": "http://www.swagger.io/support",
+            "email": "support@swagger.io"
+        },
+        "license": {
+            "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+        },
+        "version": "{{.Version}}"
+    },
+    "host": "{{.Host}}",
+    "basePath": "{{.BasePath}}",
+    "paths": {
+        "/forms/delete_bank_question/{idQuestion}": {
+            "delete": {
+                "description": "Author deletes(soft) a bank question, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classroom",
+                    "forms",
+                    "roles.teacher"
+                ],
+                "summary": "Delete question of bank",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Bank Question",
+                        "name": "idQuestion",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "No estás autorizado a eliminar esta pregunta",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la pregunta en el banco de preguntas",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/forms/delete_form/{idForm}": {
+            "delete": {
+                "description": "Form own deletes(soft) a form, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classroom",
+                    "forms",
+                    "roles.teacher"
+                ],
+                "summary": "Delete form of user",
+                "parameters": [
+                    {
+                        "description": "Update form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.FormForm"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Form",
+                        "name": "idForm",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Bad body",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "No estás autorizado a eliminar este formulario",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este formulario está asignado a un trabajo revisado, no se puede editar",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/forms/get_form/{idForm}": {
+            "get": {
+                "description": "Get a single user form, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "forms",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get a single form",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.FormMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe este formulario",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/forms/get_forms": {
+            "get": {
+                "description": "Get forms generated for user",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "forms",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get forms of user, ROLES=[teacher]",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.FormsMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/forms/search_bank": {
+            "get": {
+                "description": "Search reusable questions by text, subject, difficulty, type and tags",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "forms",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Search question bank, ROLES=[teacher]",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search in question",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Subject",
+                        "name": "subject",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "easy,medium,hard",
+                        "name": "difficulty",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Question type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tags separated by comma",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only questions of user?",
+                        "name": "mine",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Get total?",
+                        "name": "total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.BankQuestionsMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad query param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/forms/update_bank_question/{idQuestion}": {
+            "put": {
+                "description": "Update a bank question, form questions imported by reference and not answered are updated too, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classroom",
+                    "forms",
+                    "roles.teacher"
+                ],
+                "summary": "Update question of bank",
+                "parameters": [
+                    {
+                        "description": "Update question",
+                        "name": "question",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.BankQuestionForm"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Bank Question",
+                        "name": "idQuestion",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Bad body",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la pregunta en el banco de preguntas",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/forms/update_form/{idForm}": {
+            "put": {
+                "description": "Update a form to teacher, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classroom",
+                    "forms",
+                    "roles.teacher"
+                ],
+                "summary": "Update form to user",
+                "parameters": [
+                    {
+                        "description": "Update form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.FormForm"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Form",
+                        "name": "idForm",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Bad body",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este formulario está asignado a un trabajo revisado, no se puede editar",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/forms/upload_bank_question": {
+            "post": {
+                "description": "Upload a reusable question to the question bank, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classroom",
+                    "forms",
+                    "roles.teacher"
+                ],
+                "summary": "Upload question to bank",
+                "parameters": [
+                    {
+                        "description": "Add question",
+                        "name": "question",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.BankQuestionForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Una pregunta del banco no puede importar otra pregunta del banco",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/forms/upload_form": {
+            "post": {
+                "description": "Upload a form to teacher, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classroom",
+                    "forms",
+                    "roles.teacher"
+                ],
+                "summary": "Upload form to user",
+                "parameters": [
+                    {
+                        "description": "Add form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.FormForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Bad body",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/delete_grading_scale/{idModule}": {
+            "delete": {
+                "description": "Delete the grading scale of a module, it goes back to the semester scale, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classroom",
+                    "grades",
+                    "roles.teacher"
+                ],
+                "summary": "Delete module grading scale",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Module",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe una escala de calificación configurada",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/delete_program/{idModule}/{idProgram}": {
+            "delete": {
+                "description": "Delete grade program in module, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Delete grade program",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idProgram",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la programación de calificación",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Esta programación está en uso en alguna calificación",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable\"..",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/download_grades": {
+            "get": {
+                "description": "Get the report card of the student (in a semester) -\u003e export to PDF. Attorneys must indicate the student",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive",
+                    "roles.attorney"
+                ],
+                "summary": "Export student grades",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "semester",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID. Required if attorney",
+                        "name": "student",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "400": {
+                        "description": "Se debe indicar el estudiante",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "No eres apoderado de este estudiante",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "510": {
+                        "description": "Buffer io.Writter",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/download_section_grades/{idSection}": {
+            "get": {
+                "description": "Get the report cards of all the students of a section (in a semester) -\u003e export to a zip with a PDF per student",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.director",
+                    "roles.directive"
+                ],
+                "summary": "Export section report cards",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Section",
+                        "name": "idSection",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "semester",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Esta sección no tiene módulos || Esta sección no tiene estudiantes",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "510": {
+                        "description": "Buffer io.Writter",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/export_grades/{idModule}": {
+            "get": {
+                "description": "Get students grades (in a module) -\u003e export to Excel, ODS, CSV or JSON. The Excel and ODS files have weighted formulas and a summary sheet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+                    "application/vnd.oasis.opendocument.spreadsheet",
+                    "text/csv",
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.teacher",
+                    "roles.directive",
+                    "roles.director"
+                ],
+                "summary": "Export grades",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Form",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "xlsx",
+                            "ods",
+                            "csv",
+                            "json"
+                        ],
+                        "type": "string",
+                        "description": "Default: xlsx",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "400": {
+                        "description": "Formato de exportación inválido",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "510": {
+                        "description": "Buffer io.Writter",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/get_averages": {
+            "get": {
+                "description": "Get the averages of the student by module and the semester average. Until the semester is closed the averages are partial. Attorneys and directives must indicate the student",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive",
+                    "roles.attorney",
+                    "roles.director",
+                    "roles.directive"
+                ],
+                "summary": "Get student averages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "semester",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID. Required if attorney, director or directive",
+                        "name": "student",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.StudentAveragesMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Se debe indicar el estudiante",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "No eres apoderado de este estudiante",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el estudiante",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/get_close_semester": {
+            "get": {
+                "description": "Get the state of the close of a semester, with the progress by module. Current semester by default",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.director",
+                    "roles.directive"
+                ],
+                "summary": "Get semester close",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "semester",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.SemesterCloseMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad query param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "El semestre no ha sido cerrado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/get_grade_history/{idModule}/{idGrade}": {
+            "get": {
+                "description": "Get the changes of a grade or work grade (newest first)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.teacher",
+                    "roles.director",
+                    "roles.directive"
+                ],
+                "summary": "Get grade history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Module",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Grade || Work grade",
+                        "name": "idGrade",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.GradeHistoryMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe historial para esta calificación",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/get_grade_programs/{idModule}": {
+            "get": {
+                "description": "Get program grade by MongoId (Sort by program number)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.all"
+                ],
+                "summary": "Get program grade",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Form",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.ProgramGradeMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/get_grades_analytics/{idModule}": {
+            "get": {
+                "description": "Get the stats of the grades of a module by program, the students at risk and the comparison with the other sections of the subject in the semester",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.teacher",
+                    "roles.director",
+                    "roles.directive"
+                ],
+                "summary": "Get grades analytics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Module",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.GradesAnalyticsMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/get_grading_scale/{idModule}": {
+            "get": {
+                "description": "Get the grading scale used by the module: its own scale, else the semester scale, else the linear min-max scale",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom"
+                ],
+                "summary": "Get grading scale",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Module",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.GradingScaleMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el módulo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/get_student_grade_history/{idModule}/{idStudent}": {
+            "get": {
+                "description": "Get the changes of all the grades of a student in a module (newest first)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.teacher",
+                    "roles.director",
+                    "roles.directive"
+                ],
+                "summary": "Get student grade history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Module",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Student",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.GradeHistoryMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/get_student_grades/{idModule}": {
+            "get": {
+                "description": "Get student grades (in a module)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Get student grades",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Form",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/get_students_grades/{idModule}": {
+            "get": {
+                "description": "Get all students and their grades (in a module)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.teacher",
+                    "roles.director",
+                    "roles.directive"
+                ],
+                "summary": "Get students grades",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Form",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/import_grades/{idModule}": {
+            "post": {
+                "description": "Import grades from a xlsx or csv file with the layout of the export, students are matched by rut. All rows are validated and imported atomically, ROLS=[teacher]",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Import grades",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "xlsx or csv file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID, only import the column of this program",
+                        "name": "program",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only validate the file",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.ImportGradesMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "El archivo debe ser xlsx o csv",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable\"..",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/preview_close_semester": {
+            "get": {
+                "description": "Get the grades that the close of the current semester would fill with the min grade, nothing is registered",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.director",
+                    "roles.directive"
+                ],
+                "summary": "Preview semester close",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.CloseSemesterPreviewMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/release_program/{idModule}/{idProgram}": {
+            "post": {
+                "description": "Release the draft grades of a program, with date the release is scheduled, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Release grades",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idProgram",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "release",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.ReleaseGradesForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "La calificación no pertenece a este módulo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable\"..",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/update_grade/{idModule}/{idGrade}": {
+            "put": {
+                "description": "Update grade in module to student, the change is registered in the grade history, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Update grade",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idGrade",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "grade",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.UpdateGradeForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Calificación inválida. Mín: %v. Máx: %v",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la programación de calificación",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Esta calificación no pertenece al módulo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable\"..",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/upload_grade/{idModule}/{idStudent}": {
+            "post": {
+                "description": "Upload grade in module to student, a draft grade is hidden until released, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload grade",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "grade",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.GradeForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Calificación inválida. Mín: %v. Máx: %v",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "No se puede agregar una calificación ya subida",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/upload_grading_scale/{idModule}": {
+            "post": {
+                "description": "Set the grading scale of a module, it replaces the semester scale, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classroom",
+                    "grades",
+                    "roles.teacher"
+                ],
+                "summary": "Upload module grading scale",
+                "parameters": [
+                    {
+                        "description": "Grading scale",
+                        "name": "scale",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.GradingScaleForm"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Module",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Bad body || Bad scale",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/upload_program/{idModule}": {
+            "post": {
+                "description": "Upload program grade in module, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload program grade",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "programGrade",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.GradeProgramForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "El porcentaje sumatorio de las calificaciones acumulativas debe ser exactamente 100 por cierto",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Esta calificación ya está programada",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grading_scales/delete_semester_scale": {
+            "delete": {
+                "description": "Delete the grading scale of the current semester, ROLS=[director,directive]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classroom",
+                    "grades",
+                    "roles.directive"
+                ],
+                "summary": "Delete semester grading scale",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe una escala de calificación configurada",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grading_scales/upload_semester_scale": {
+            "post": {
+                "description": "Set the grading scale of the current semester for the modules without scale, ROLS=[director,directive]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classroom",
+                    "grades",
+                    "roles.directive"
+                ],
+                "summary": "Upload semester grading scale",
+                "parameters": [
+                    {
+                        "description": "Grading scale",
+                        "name": "scale",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.GradingScaleForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Bad body || Bad scale",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/modules/download_file/{idFile}/{idModule}": {
+            "get": {
+                "description": "Download file of module",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "modules",
+                    "classroom",
+                    "roles.teacher",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Download file module",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idFile",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.TokenMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path params",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/modules/get_module/{idModule}": {
+            "get": {
+                "description": "Get module",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "modules",
+                    "classroom",
+                    "roles.teacher",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Get module",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.ModuleMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/modules/get_modules": {
+            "get": {
+                "description": "Get user modules",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "modules",
+                    "classroom",
+                    "roles.teacher",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Get modules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.ModulesMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "No estás asignado a ningún curso",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/modules/get_modules_history": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "modules",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Get total?",
+                        "name": "total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "400": {
+                        "description": "Bad query param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/modules/new_sub_section/{idModule}": {
+            "post": {
+                "description": "New sub section to module",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "modules",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "New sub section",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.InsertedIdMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path params",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/modules/search/{idModule}": {
+            "get": {
+                "description": "search in all module content",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "modules",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Desc",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.SearchHitsMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/program_templates/apply_templates": {
+            "post": {
+                "description": "Create the grade programs of all the modules of the semester from its template, in one operation, ROLS=[director,directive]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.director",
+                    "roles.directive"
+                ],
+                "summary": "Apply grade program templates",
+                "parameters": [
+                    {
+                        "description": "Desc",
+                        "name": "apply",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.ApplyProgramTemplatesForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.ApplyTemplatesMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad body",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No hay plantillas de calificaciones",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/program_templates/delete_template/{idTemplate}": {
+            "delete": {
+                "description": "Delete a template of grade programs, the programs of the modules are kept, ROLS=[director,directive]",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.director",
+                    "roles.directive"
+                ],
+                "summary": "Delete grade program template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idTemplate",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la plantilla",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/program_templates/get_templates": {
+            "get": {
+                "description": "Get the templates of grade programs of the subjects and courses",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.director",
+                    "roles.directive"
+                ],
+                "summary": "Get grade program templates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.ProgramTemplatesMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/program_templates/update_template/{idTemplate}": {
+            "put": {
+                "description": "Update a template of grade programs, the modules keep its programs until the template is applied, ROLS=[director,directive]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.director",
+                    "roles.directive"
+                ],
+                "summary": "Update grade program template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idTemplate",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.GradeProgramTemplateForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "El porcentaje sumatorio de las calificaciones debe ser exactamente 100 por ciento",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la plantilla",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Ya existe una plantilla para esta asignatura y nivel",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/program_templates/upload_template": {
+            "post": {
+                "description": "Upload a template of grade programs for a subject and/or course, ROLS=[director,directive]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.director",
+                    "roles.directive"
+                ],
+                "summary": "Upload grade program template",
+                "parameters": [
+                    {
+                        "description": "Desc",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.GradeProgramTemplateForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "La calificación N°%d está repetida",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Ya existe una plantilla para esta asignatura y nivel",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/publications/delete/{idPublication}/{idModule}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publications",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Delete publication",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idPublication",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Not Access module",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Publication not found",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/publications/delete_attached/{idAttached}/{idModule}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publications",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Delete publication attached",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idAttached",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Esta publicación no tiene elementos adjuntos",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el elemento adjunto",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/publications/get_publication/{idModule}/{idPublication}": {
+            "get": {
+                "description": "Get module publication",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publications",
+                    "classroom",
+                    "roles.teacher",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Get publication",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idPublication",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.PublicationMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/publications/get_publications/{idModule}": {
+            "get": {
+                "description": "Get module publications",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publications",
+                    "classroom",
+                    "roles.teacher",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Get publications",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Section",
+                        "name": "section",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Get total?",
+                        "name": "total",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.PublicationsMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe esta sección",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/publications/update/{idPublication}": {
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publications",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Update publication",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idPublication",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "No tienes acceso a esta publicación",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Publication not found",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/publications/upload/{idModule}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publications",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "New publication",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Default: 0",
+                        "name": "section",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.NewPublicationMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "No existe esta sección",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/adjust_group_member/{idWork}/{idGroup}/{idStudent}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Adjust group member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idGroup",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "adjustment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.GroupAdjustmentForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "El ajuste debe estar entre N y M",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Los ajustes solo se pueden modificar antes de calificar el trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el grupo indicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/analyze_similarity/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Analyze similarity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Threshold",
+                        "name": "similarity",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WorkSimilarityForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "El análisis de similitud es solo para trabajos de formulario o archivos",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Ya hay un análisis de similitud en curso para este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/answer_appeal/{idWork}/{idAppeal}": {
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Answer appeal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idAppeal",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "answer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.AnswerWorkAppealForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Se debe indicar el motivo del rechazo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la apelación",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Esta apelación ya fue respondida",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/clone_work/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Clone work",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "clone",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WorkCloneForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.WorkClonesMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Esta calificación está registrada ya a un trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "No tienes acceso al módulo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/create_group/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Create group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.SelfWorkGroupForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo no es en grupo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Ya no se pueden modificar los grupos de este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "El estudiante ya tiene una entrega en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/delete_attached/{idWork}/{idAttached}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Delete Attached",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idAttached",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe este elemento adjunto al trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/delete_extension/{idWork}/{idExtension}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Delete extension",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idExtension",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este trabajo ya está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la prórroga indicada",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Esta prórroga no pertenece al trabajo indicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/delete_feedback/{idWork}/{idFeedback}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Delete feedback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idFeedback",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el comentario indicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Este comentario no pertenece al trabajo indicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/delete_file_work/{idWork}/{idFile}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Delete file classroom",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idFile",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "No se puede eliminar un trabajo calificado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No se encontró el archivo a eliminar en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/delete_group/{idWork}/{idGroup}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Delete group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idGroup",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo no es en grupo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este trabajo ya está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el grupo indicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "El grupo ya tiene una entrega, no se pueden modificar sus integrantes",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/delete_item_pattern/{idWork}/{idItem}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Delete Item Pattern",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idItem",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el item a eliminar",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/delete_rubric/{idRubric}": {
+            "delete": {
+                "description": "Author deletes(soft) a rubric template, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classroom",
+                    "works",
+                    "roles.teacher"
+                ],
+                "summary": "Delete rubric template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Rubric",
+                        "name": "idRubric",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "No tienes acceso a esta rúbrica",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la rúbrica indicada",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/delete_work/{idWork}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Delete work",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "No se puede eliminar un trabajo calificado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/download_files_work_student/{idWork}/{idStudent}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Download files work student",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Zip file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/download_peer_review_files/{idWork}/{idReview}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Download peer review files",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idReview",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Zip file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la revisión indicada",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/finish_form/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Finish form",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "answers",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.AnswersForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_extensions/{idWork}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get extensions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.WorkExtensionsMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_feedback/{idWork}/{idStudent}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get feedback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.WorkFeedbackMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_form/{idWork}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Get form",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.FormWorkMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "No accediste al formulario, no hay respuestas a revisar",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_form_student/{idWork}/{idStudent}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get form student",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Este alumno no ha tiene respuestas, ya que no abrió el formulario",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_groups/{idWork}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Get groups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.WorkGroupsMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_item_analysis/{idWork}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get item analysis",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.ItemAnalysisMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo todavía no está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_modules_works": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Get modules works",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.ModulesWorksMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_peer_reviews/{idWork}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Get peer reviews",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.PeerReviewsMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo no tiene revisión de pares",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "La revisión de pares todavía no comienza",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_rubrics": {
+            "get": {
+                "description": "Get the rubric templates of the user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get rubric templates, ROLES=[teacher]",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.RubricsMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_similarity_report/{idWork}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get similarity report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.SimilarityReportMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Este trabajo no tiene análisis de similitud",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_student_peer_reviews/{idWork}/{idStudent}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get student peer reviews",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.StudentPeerReviewsMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo no tiene revisión de pares",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "La revisión de pares todavía no comienza",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_students_status/{idModule}/{idWork}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get students status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.StudentsStatusMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Ningún estudiante pertenece a este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_work/{idWork}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Get work",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_works/{idModule}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Get works",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.WorksMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/grade_files/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Grade files",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Keep the grades as draft until released",
+                        "name": "draft",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "No existen alumnos a evaluar en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este trabajo ya está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/grade_form/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Grade form",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Keep the grades as draft until released",
+                        "name": "draft",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "No existen alumnos a evaluar en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este trabajo ya está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/grade_inperson/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Grade inperson work",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Keep the grades as draft until released",
+                        "name": "draft",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "No existen alumnos a evaluar en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este trabajo ya está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/join_group/{idWork}/{idGroup}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Join group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idGroup",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo no es en grupo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Ya no se pueden modificar los grupos de este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el grupo indicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "El estudiante ya pertenece a otro grupo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/leave_group/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Leave group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo no es en grupo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Ya no se pueden modificar los grupos de este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No perteneces a un grupo en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "El grupo ya tiene una entrega, no se pueden modificar sus integrantes",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/new_attempt/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Start a new attempt of the form",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Este formulario no admite múltiples intentos",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "No te quedan intentos disponibles",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Debes terminar el intento actual antes de comenzar otro",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/publish_work/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Publish work",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "publish",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.PublishWorkForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "La fecha de publicación debe ser anterior a la fecha límite",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Este trabajo ya está publicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/release_grades/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Release work grades",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "release",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.ReleaseGradesForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo todavía no está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/remove_question/{idWork}/{idQuestion}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Remove question from the grade",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idQuestion",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "No se pueden eliminar todas las preguntas con puntaje",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "La pregunta no pertenece al trabajo indicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Esta pregunta ya fue eliminada de la calificación",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/resolve_peer_review/{idWork}/{idStudent}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Resolve peer review",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "resolve",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.ResolvePeerReviewForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "La entrega del alumno no tiene revisiones de pares",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "La revisión de pares de este trabajo todavía no termina",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/save_answer/{idWork}/{idQuestion}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Save answer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idQuestion",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "answer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.AnswerForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "El trabajo no es de tipo formulario",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/update_group/{idWork}/{idGroup}": {
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Update group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idGroup",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WorkGroupForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "El estudiante no pertenece a este módulo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este trabajo ya está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el grupo indicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "El estudiante ya pertenece a otro grupo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/update_rubric/{idRubric}": {
+            "put": {
+                "description": "Update a rubric template, works that already use it keep their criteria, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classroom",
+                    "works",
+                    "roles.teacher"
+                ],
+                "summary": "Update rubric template",
+                "parameters": [
+                    {
+                        "description": "Update rubric",
+                        "name": "rubric",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.RubricForm"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Rubric",
+                        "name": "idRubric",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Bad body",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la rúbrica indicada",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/update_work/{idWork}": {
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Update work",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "work",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.UpdateWorkForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "La fecha y hora de inicio registrada es mayor a la limite",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No se puede actualizar un item que no está registrado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_appeal/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive",
+                    "roles.attorney"
+                ],
+                "summary": "Upload appeal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "appeal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WorkAppealForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo todavía no está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "El estudiante no tiene calificación en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Ya existe una apelación abierta para este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_evaluate_files/{idWork}/{idStudent}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload evaluate files",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "evaluate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/forms.EvaluateFilesForm"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Los puntos evaluados superan el máx. del item",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el item #%s en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "No se encontraron archivos subidos por parte del alumno",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_evaluate_inperson/{idWork}/{idStudent}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload evaluate Inperson",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "evaluate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.EvaluateInperson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad body",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la programación de calificación",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "No se encontraron archivos subidos por parte del alumno",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_extension/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload extension",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "extension",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WorkExtensionForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "El estudiante no pertenece a este módulo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este trabajo ya está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_feedback/{idWork}/{idStudent}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload feedback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "feedback",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WorkFeedbackForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Solo se pueden comentar archivos en un trabajo de archivos",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "El archivo no fue subido por el alumno en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_files/{idWork}": {
+            "post": {
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Upload files",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "file"
+                        },
+                        "description": "Form Data in files[]",
+                        "name": "files[]",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "413": {
+                        "description": "Solo se puede subir hasta 3 archivos por trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_group/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WorkGroupForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "El estudiante no pertenece a este módulo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este trabajo ya está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "El estudiante ya tiene una entrega en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_peer_review/{idWork}/{idReview}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Upload peer review",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idReview",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "evaluate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/forms.EvaluateFilesForm"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "La revisión debe evaluar todos los items de la pauta",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "La revisión de pares de este trabajo ya terminó",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la revisión indicada",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_points_question/{idWork}/{idQuestion}/{idStudent}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload points question",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idQuestion",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "points",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.EvaluateQuestion"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Puntaje fuera de rango. Debe ser entre cero y máx %v",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Todavía no se pueden evaluar preguntas en este formulario",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la programación de calificación",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_reevaluate_files/{idWork}/{idStudent}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload reevaluate Person",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "evaluate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.EvaluateInperson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Los puntos evaluados superan el máx. del item",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el item #%s en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "No se encontraron archivos subidos por parte del alumno",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_rubric": {
+            "post": {
+                "description": "Upload a rubric template reusable in files works, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classroom",
+                    "works",
+                    "roles.teacher"
+                ],
+                "summary": "Upload rubric template",
+                "parameters": [
+                    {
+                        "description": "Add rubric",
+                        "name": "rubric",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.RubricForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Bad body",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_work/{idModule}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload work",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "work",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WorkForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "forms.AcumulativeForm": {
+            "type": "object",
+            "required": [
+                "number",
+                "percentage"
+            ],
+            "properties": {
+                "number": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 45
+                }
+            }
+        },
+        "forms.Answer": {
+            "type": "object",
+            "required": [
+                "question"
+            ],
+            "properties": {
+                "answer": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        0,
+                        2
+                    ]
+                },
+                "number": {
+                    "type": "number",
+                    "example": 3.14
+                },
+                "question": {
+                    "type": "string"
+                },
+                "response": {
+                    "type": "string",
+                    "example": "Response..."
+                }
+            }
+        },
+        "forms.AnswerForm": {
+            "description": "answers: multiple_select =\u003e selected answers, matching =\u003e option of each answer, ordering =\u003e answers in order",
+            "type": "object",
+            "properties": {
+                "answer": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        0,
+                        2
+                    ]
+                },
+                "number": {
+                    "type": "number",
+                    "example": 3.14
+                },
+                "response": {
+                    "type": "string",
+                    "example": "Response..."
+                }
+            }
+        },
+        "forms.AnswerWorkAppealForm": {
+            "type": "object",
+            "required": [
+                "accept"
+            ],
+            "properties": {
+                "accept": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "La pauta no considera ese desarrollo"
+                }
+            }
+        },
+        "forms.AnswersForm": {
+            "type": "object",
+            "required": [
+                "answers"
+            ],
+            "properties": {
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.Answer"
+                    }
+                }
+            }
+        },
+        "forms.ApplyProgramTemplatesForm": {
+            "type": "object",
+            "properties": {
+                "replace": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "forms.Attached": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "file": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "link": {
+                    "type": "string",
+                    "example": "https://example.com"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Title!"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "file"
+                }
+            }
+        },
+        "forms.BankQuestionForm": {
+            "type": "object",
+            "required": [
+                "difficulty",
+                "question",
+                "subject"
+            ],
+            "properties": {
+                "difficulty": {
+                    "type": "string",
+                    "enum": [
+                        "easy",
+                        "medium",
+                        "hard"
+                    ],
+                    "example": "medium"
+                },
+                "question": {
+                    "$ref": "#/definitions/forms.QuestionForm"
+                },
+                "subject": {
+                    "type": "string",
+                    "example": "637846a8bc4ea33de990c098"
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "algebra",
+                        "ecuaciones"
+                    ]
+                }
+            }
+        },
+        "forms.EvaluateFilesForm": {
+            "type": "object",
+            "required": [
+                "pattern"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Buen análisis, faltan fuentes"
+                },
+                "level": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "pattern": {
+                    "type": "string",
+                    "example": "Pattern"
+                },
+                "points": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 25
+                }
+            }
+        },
+        "forms.EvaluateInperson": {
+            "type": "object",
+            "required": [
+                "block",
+                "in_date",
+                "pregrade"
+            ],
+            "properties": {
+                "block": {
+                    "type": "string"
+                },
+                "in_date": {
+                    "type": "string"
+                },
+                "pregrade": {
+                    "type": "number",
+                    "example": 6.5
+                }
+            }
+        },
+        "forms.EvaluateQuestion": {
+            "type": "object",
+            "required": [
+                "points"
+            ],
+            "properties": {
+                "points": {
+                    "type": "integer",
+                    "example": 25
+                }
+            }
+        },
+        "forms.FormForm": {
+            "type": "object",
+            "required": [
+                "has_points",
+                "items",
+                "title"
+            ],
+            "properties": {
+                "has_points": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/forms.ItemForm"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Form title"
+                }
+            }
+        },
+        "forms.GradeForm": {
+            "type": "object",
+            "required": [
+                "grade",
+                "program"
+            ],
+            "properties": {
+                "acumulative": {
+                    "type": "string",
+                    "example": "637ab12ae976057567a4d67d"
+                },
+                "draft": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "grade": {
+                    "type": "number",
+                    "example": 70
+                },
+                "program": {
+                    "type": "string",
+                    "example": "637ab12ae976057567a4d67d"
+                }
+            }
+        },
+        "forms.GradeProgramForm": {
+            "type": "object",
+            "required": [
+                "is_acumulative",
+                "number",
+                "percentage"
+            ],
+            "properties": {
+                "acumulative": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.AcumulativeForm"
+                    }
+                },
+                "is_acumulative": {
+                    "type": "boolean"
+                },
+                "number": {
+                    "type": "integer",
+                    "maximum": 30,
+                    "minimum": 1,
+                    "example": 1
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 25
+                }
+            }
+        },
+        "forms.GradeProgramTemplateForm": {
+            "type": "object",
+            "required": [
+                "name",
+                "programs"
+            ],
+            "properties": {
+                "course": {
+                    "type": "string",
+                    "example": "637ab12ae976057567a4d67d"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Plantilla Matemáticas"
+                },
+                "programs": {
+                    "type": "array",
+                    "maxItems": 30,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/forms.GradeProgramForm"
+                    }
+                },
+                "subject": {
+                    "type": "string",
+                    "example": "637ab12ae976057567a4d67d"
+                }
+            }
+        },
+        "forms.GradingScaleForm": {
+            "type": "object",
+            "required": [
+                "max",
+                "min",
+                "type"
+            ],
+            "properties": {
+                "max": {
+                    "type": "number",
+                    "example": 7
+                },
+                "min": {
+                    "type": "number",
+                    "example": 1
+                },
+                "pass_grade": {
+                    "type": "number",
+                    "example": 4
+                },
+                "table": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 2,
+                    "items": {
+                        "$ref": "#/definitions/forms.GradingScaleRange"
+                    }
+                },
+                "threshold": {
+                    "type": "number",
+                    "example": 60
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "linear",
+                        "threshold",
+                        "table"
+                    ],
+                    "example": "threshold"
+                }
+            }
+        },
+        "forms.GradingScaleRange": {
+            "type": "object",
+            "required": [
+                "from",
+                "grade"
+            ],
+            "properties": {
+                "from": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0,
+                    "example": 60
+                },
+                "grade": {
+                    "type": "number",
+                    "example": 4
+                }
+            }
+        },
+        "forms.GroupAdjustmentForm": {
+            "type": "object",
+            "required": [
+                "adjustment"
+            ],
+            "properties": {
+                "adjustment": {
+                    "type": "number",
+                    "example": -0.5
+                },
+                "comment": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "example": "No participó en la presentación"
+                }
+            }
+        },
+        "forms.ItemForm": {
+            "description": "points required if type == \"equal\"",
+            "type": "object",
+            "required": [
+                "_id",
+                "points",
+                "questions",
+                "title"
+            ],
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637846a8bc4ea33de990c098"
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "points_type": {
+                    "type": "string",
+                    "enum": [
+                        "without",
+                        "equal",
+                        "custom"
+                    ],
+                    "example": "without"
+                },
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.QuestionForm"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Item Form Title !"
+                }
+            }
+        },
+        "forms.PublishWorkForm": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                }
+            }
+        },
+        "forms.QuestionForm": {
+            "description": "correct required if type == \"alternatives_correct\" || \"true_false\" (0 = true, 1 = false) corrects required if type == \"multiple_select\" || \"matching\" || \"ordering\" corrects: multiple_select =\u003e correct answers, matching =\u003e option of each answer, ordering =\u003e answers in order options required if type == \"matching\" number required if type == \"numeric\" points required if (father)item.type != \"equal\" question and type are taken from the question bank if bank is sent",
+            "type": "object",
+            "required": [
+                "question",
+                "type"
+            ],
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637846a8bc4ea33de990c098"
+                },
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "a",
+                        " b",
+                        " c"
+                    ]
+                },
+                "bank": {
+                    "type": "string",
+                    "example": "637846a8bc4ea33de990c098"
+                },
+                "bank_mode": {
+                    "type": "string",
+                    "enum": [
+                        "copy",
+                        "reference"
+                    ],
+                    "example": "reference"
+                },
+                "correct": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "corrects": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        0,
+                        2
+                    ]
+                },
+                "number": {
+                    "type": "number",
+                    "example": 3.14
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "a",
+                        " b",
+                        " c"
+                    ]
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 21
+                },
+                "question": {
+                    "type": "string",
+                    "minLength": 3,
+                    "example": "Who is...?"
+                },
+                "tolerance": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 0.01
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "alternatives",
+                        "alternatives_correct",
+                        "written",
+                        "multiple_select",
+                        "numeric",
+                        "true_false",
+                        "matching",
+                        "ordering"
+                    ],
+                    "example": "alternatives"
+                }
+            }
+        },
+        "forms.ReleaseGradesForm": {
+            "type": "object",
+            "properties": {
+                "acumulative": {
+                    "type": "string",
+                    "example": "637ab12ae976057567a4d67d"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                }
+            }
+        },
+        "forms.ResolvePeerReviewForm": {
+            "type": "object",
+            "required": [
+                "mode"
+            ],
+            "properties": {
+                "evaluate": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.EvaluateFilesForm"
+                    }
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "accept",
+                        "blend",
+                        "override"
+                    ],
+                    "example": "blend"
+                },
+                "peer_weight": {
+                    "type": "integer",
+                    "maximum": 99,
+                    "minimum": 1,
+                    "example": 30
+                }
+            }
+        },
+        "forms.RubricForm": {
+            "type": "object",
+            "required": [
+                "criteria",
+                "title"
+            ],
+            "properties": {
+                "criteria": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkPatternFiles"
+                    }
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 300,
+                    "example": "This is a description..."
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Rúbrica de ensayo"
+                }
+            }
+        },
+        "forms.SelfWorkGroupForm": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 1,
+                    "example": "Grupo 1"
+                }
+            }
+        },
+        "forms.UpdateGradeForm": {
+            "type": "object",
+            "required": [
+                "grade"
+            ],
+            "properties": {
+                "grade": {
+                    "type": "number",
+                    "example": 55
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 300,
+                    "example": "Error de digitación"
+                }
+            }
+        },
+        "forms.UpdateWorkForm": {
+            "type": "object",
+            "properties": {
+                "attached": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.Attached"
+                    }
+                },
+                "attempts": {
+                    "$ref": "#/definitions/forms.WorkAttempts"
+                },
+                "date_limit": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                },
+                "date_start": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "example": "This is a description..."
+                },
+                "form": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "form_access": {
+                    "type": "string",
+                    "enum": [
+                        "default",
+                        "wtime"
+                    ]
+                },
+                "form_shuffle": {
+                    "$ref": "#/definitions/forms.WorkFormShuffle"
+                },
+                "grade": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "groups": {
+                    "$ref": "#/definitions/forms.WorkGroups"
+                },
+                "late_policy": {
+                    "$ref": "#/definitions/forms.WorkLatePolicy"
+                },
+                "pattern": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkPatternWIDFiles"
+                    }
+                },
+                "peer_review": {
+                    "$ref": "#/definitions/forms.WorkPeerReview"
+                },
+                "remove_late_policy": {
+                    "type": "boolean"
+                },
+                "rubric": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkSession"
+                    }
+                },
+                "time_access": {
+                    "description": "Seconds",
+                    "type": "integer",
+                    "example": 3600
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Title!"
+                }
+            }
+        },
+        "forms.WorkAppealForm": {
+            "type": "object",
+            "required": [
+                "justification"
+            ],
+            "properties": {
+                "justification": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 1,
+                    "example": "La pregunta 3 está bien desarrollada"
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "forms.WorkAttempts": {
+            "type": "object",
+            "required": [
+                "quantity",
+                "scoring"
+            ],
+            "properties": {
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1,
+                    "example": 3
+                },
+                "scoring": {
+                    "type": "string",
+                    "enum": [
+                        "best",
+                        "last",
+                        "average"
+                    ],
+                    "example": "best"
+                }
+            }
+        },
+        "forms.WorkCloneForm": {
+            "type": "object",
+            "required": [
+                "targets"
+            ],
+            "properties": {
+                "date_publish": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                },
+                "draft": {
+                    "type": "boolean"
+                },
+                "offset": {
+                    "type": "integer",
+                    "maximum": 730,
+                    "minimum": -730,
+                    "example": 182
+                },
+                "targets": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkCloneTarget"
+                    }
+                }
+            }
+        },
+        "forms.WorkCloneTarget": {
+            "type": "object",
+            "required": [
+                "module"
+            ],
+            "properties": {
+                "grade": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "forms.WorkExtensionForm": {
+            "type": "object",
+            "required": [
+                "student"
+            ],
+            "properties": {
+                "date_limit": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 300,
+                    "example": "Licencia médica"
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "time_access": {
+                    "description": "Seconds",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 3600
+                }
+            }
+        },
+        "forms.WorkFeedbackForm": {
+            "type": "object",
+            "required": [
+                "comment"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 1,
+                    "example": "Buen desarrollo, revisa la conclusión"
+                },
+                "file": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "question": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "forms.WorkForm": {
+            "type": "object",
+            "required": [
+                "date_limit",
+                "date_start",
+                "is_qualified",
+                "title",
+                "type",
+                "virtual"
+            ],
+            "properties": {
+                "acumulative": {
+                    "type": "string"
+                },
+                "attached": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.Attached"
+                    }
+                },
+                "attempts": {
+                    "$ref": "#/definitions/forms.WorkAttempts"
+                },
+                "date_limit": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                },
+                "date_publish": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                },
+                "date_start": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "example": "This is a description..."
+                },
+                "draft": {
+                    "type": "boolean"
+                },
+                "form": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "form_access": {
+                    "type": "string",
+                    "enum": [
+                        "default",
+                        "wtime"
+                    ],
+                    "example": "wtime"
+                },
+                "form_shuffle": {
+                    "$ref": "#/definitions/forms.WorkFormShuffle"
+                },
+                "grade": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "groups": {
+                    "$ref": "#/definitions/forms.WorkGroups"
+                },
+                "is_qualified": {
+                    "type": "boolean"
+                },
+                "late_policy": {
+                    "$ref": "#/definitions/forms.WorkLatePolicy"
+                },
+                "pattern": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkPatternFiles"
+                    }
+                },
+                "peer_review": {
+                    "$ref": "#/definitions/forms.WorkPeerReview"
+                },
+                "rubric": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkSession"
+                    }
+                },
+                "time_access": {
+                    "description": "Seconds",
+                    "type": "integer",
+                    "example": 3600
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Title!"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "files",
+                        "form"
+                    ],
+                    "example": "files"
+                },
+                "virtual": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "forms.WorkFormDraw": {
+            "type": "object",
+            "required": [
+                "item",
+                "quantity"
+            ],
+            "properties": {
+                "item": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 5
+                }
+            }
+        },
+        "forms.WorkFormShuffle": {
+            "type": "object",
+            "properties": {
+                "answers": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "draws": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkFormDraw"
+                    }
+                },
+                "items": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "questions": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "forms.WorkGroupForm": {
+            "type": "object",
+            "required": [
+                "members",
+                "name"
+            ],
+            "properties": {
+                "members": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "637d5de216f58bc8ec7f7f51"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 1,
+                    "example": "Grupo 1"
+                }
+            }
+        },
+        "forms.WorkGroups": {
+            "type": "object",
+            "required": [
+                "max_size",
+                "min_size",
+                "mode"
+            ],
+            "properties": {
+                "max_size": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1,
+                    "example": 4
+                },
+                "min_size": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1,
+                    "example": 2
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "teacher",
+                        "self"
+                    ],
+                    "example": "self"
+                }
+            }
+        },
+        "forms.WorkLatePolicy": {
+            "type": "object",
+            "required": [
+                "cap",
+                "penalty",
+                "unit",
+                "window"
+            ],
+            "properties": {
+                "cap": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 20
+                },
+                "penalty": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 5
+                },
+                "unit": {
+                    "type": "string",
+                    "enum": [
+                        "day",
+                        "hour"
+                    ],
+                    "example": "day"
+                },
+                "window": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 48
+                }
+            }
+        },
+        "forms.WorkPatternFiles": {
+            "type": "object",
+            "required": [
+                "description",
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 300,
+                    "minLength": 1,
+                    "example": "This is a description..."
+                },
+                "levels": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 2,
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkPatternLevel"
+                    }
+                },
+                "points": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 25
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Title!"
+                }
+            }
+        },
+        "forms.WorkPatternLevel": {
+            "type": "object",
+            "required": [
+                "points",
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 300,
+                    "example": "Explica el fenómeno con ejemplos"
+                },
+                "points": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 25
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Logrado"
+                }
+            }
+        },
+        "forms.WorkPatternWIDFiles": {
+            "type": "object",
+            "required": [
+                "description",
+                "title"
+            ],
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 300,
+                    "minLength": 1,
+                    "example": "This is a description..."
+                },
+                "levels": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 2,
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkPatternLevel"
+                    }
+                },
+                "points": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 25
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Title!"
+                }
+            }
+        },
+        "forms.WorkPeerReview": {
+            "type": "object",
+            "required": [
+                "date_limit",
+                "reviewers"
+            ],
+            "properties": {
+                "date_limit": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                },
+                "reviewers": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "example": 2
+                }
+            }
+        },
+        "forms.WorkSession": {
+            "type": "object",
+            "required": [
+                "block",
+                "dates"
+            ],
+            "properties": {
+                "block": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "dates": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "forms.WorkSimilarityForm": {
+            "type": "object",
+            "properties": {
+                "threshold": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0.1,
+                    "example": 0.5
+                }
+            }
+        },
+        "models.Acumulative": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637850aafa8118c9f0f1202a"
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 20
+                }
+            }
+        },
+        "models.Answer": {
+            "type": "object",
+            "properties": {
+                "answer": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "x-omitempty": true,
+                    "example": [
+                        0,
+                        2
+                    ]
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "number": {
+                    "type": "number",
+                    "x-omitempty": true,
+                    "example": 3.14
+                },
+                "question": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "response": {
+                    "type": "string",
+                    "example": "Response..."
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "work": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.Attached": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "file": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "link": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "https://example.com"
+                },
+                "title": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "This is a title"
+                },
+                "type": {
+                    "description": "Link or file",
+                    "type": "string",
+                    "enum": [
+                        "link",
+                        "file"
+                    ],
+                    "example": "link"
+                }
+            }
+        },
+        "models.BankQuestionWLookup": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "author": {
+                    "$ref": "#/definitions/models.SimpleUser"
+                },
+                "difficulty": {
+                    "type": "string",
+                    "enum": [
+                        "easy",
+                        "medium",
+                        "hard"
+                    ],
+                    "example": "medium"
+                },
+                "question": {
+                    "$ref": "#/definitions/models.ItemQuestion"
+                },
+                "subject": {
+                    "$ref": "#/definitions/models.Subject"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "algebra",
+                        "ecuaciones"
+                    ]
+                },
+                "update_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "upload_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                }
+            }
+        },
+        "models.CalendarBlock": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "hour_finish": {
+                    "type": "string"
+                },
+                "hour_start": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Course": {
+            "type": "object",
+            "properties": {
+                "__v": {
+                    "type": "integer"
+                },
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "course": {
+                    "type": "string",
+                    "example": "First"
+                }
+            }
+        },
+        "models.EvaluatedFiles": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "comment": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "Buen análisis, faltan fuentes"
+                },
+                "level": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "pattern": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 25
+                }
+            }
+        },
+        "models.File": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "filename.png"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "$53r34re"
+                },
+                "permissions": {
+                    "type": "string",
+                    "enum": [
+                        "private",
+                        "public",
+                        "public_classroom"
+                    ],
+                    "example": "private"
+                },
+                "status": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Title"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "application/pdf"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/file"
+                }
+            }
+        },
+        "models.FileUploadedClassroomWLookup": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_update": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "evaluate": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EvaluatedFiles"
+                    },
+                    "x-omitempty": true
+                },
+                "files_uploaded": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.File"
+                    }
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "work": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.Form": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "6376c8283cc695e19d785b08"
+                },
+                "author": {
+                    "type": "string",
+                    "example": "6376c8283cc695e19d785b08"
+                },
+                "has_points": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FormItem"
+                    }
+                },
+                "status": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "This is a form!"
+                },
+                "update_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "upload_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                }
+            }
+        },
+        "models.FormAccess": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "attempt": {
+                    "type": "integer",
+                    "x-omitempty": true,
+                    "example": 2
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_finish": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "opened",
+                        "finished",
+                        "revised"
+                    ],
+                    "example": "opened"
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "work": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.FormItem": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "6376c8283cc695e19d785b08"
+                },
+                "points_type": {
+                    "type": "string",
+                    "x-omitempty": true
+                },
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "6376c8283cc695e19d785b08"
+                    ]
+                },
+                "title": {
+                    "type": "string",
+                    "example": "This is a item form!"
+                }
+            }
+        },
+        "models.FormItemWLookup": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "6376c8283cc695e19d785b08"
+                },
+                "points_type": {
+                    "type": "string",
+                    "x-omitempty": true
+                },
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ItemQuestion"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "This is a item title!"
+                }
+            }
+        },
+        "models.FormWLookup": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "6376c8283cc695e19d785b08"
+                },
+                "has_points": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FormItemWLookup"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "This is a form!"
+                },
+                "update_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "upload_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                }
+            }
+        },
+        "models.GradeHistoryWLookup": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "actor": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.SimpleUser"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "collection": {
+                    "type": "string",
+                    "enum": [
+                        "grades",
+                        "work_grades"
+                    ],
+                    "example": "grades"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "grade": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "new_grade": {
+                    "type": "number",
+                    "example": 5.2
+                },
+                "old_grade": {
+                    "type": "number",
+                    "x-omitempty": true,
+                    "example": 4.5
+                },
+                "reason": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "Error de digitación"
+                },
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "manual",
+                        "evaluation",
+                        "reevaluation",
+                        "close_grades_semester"
+                    ],
+                    "example": "manual"
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.GradeProgramTemplate": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "author": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "course": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Plantilla Matemáticas"
+                },
+                "programs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TemplateProgram"
+                    }
+                },
+                "subject": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "update_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                }
+            }
+        },
+        "models.GradesProgram": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637850aafa8118c9f0f1202a"
+                },
+                "acumulative": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Acumulative"
+                    },
+                    "x-omitempty": true
+                },
+                "is_acumulative": {
+                    "type": "boolean"
+                },
+                "module": {
+                    "type": "string",
+                    "example": "637850aafa8118c9f0f1202a"
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 25
+                }
+            }
+        },
+        "models.GradingScale": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "author": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "date": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "max": {
+                    "type": "number",
+                    "example": 7
+                },
+                "min": {
+                    "type": "number",
+                    "example": 1
+                },
+                "module": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "pass_grade": {
+                    "type": "number",
+                    "x-omitempty": true,
+                    "example": 4
+                },
+                "semester": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "table": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GradingScaleRange"
+                    },
+                    "x-omitempty": true
+                },
+                "threshold": {
+                    "type": "number",
+                    "x-omitempty": true,
+                    "example": 60
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "linear",
+                        "threshold",
+                        "table"
+                    ],
+                    "example": "threshold"
+                }
+            }
+        },
+        "models.GradingScaleRange": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "number",
+                    "example": 60
+                },
+                "grade": {
+                    "type": "number",
+                    "example": 4
+                }
+            }
+        },
+        "models.GroupAdjustment": {
+            "type": "object",
+            "properties": {
+                "adjustment": {
+                    "type": "number",
+                    "example": -0.5
+                },
+                "comment": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "No participó en la presentación"
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.ItemQuestion": {
+            "description": "Points is null if (father)form.has_points = false Answers is null if question.type=written || numeric Correct is null if question.type!=alternatives_correct \u0026\u0026 true_false Corrects is null if question.type!=multiple_select \u0026\u0026 matching \u0026\u0026 ordering Options is null if question.type!=matching Number and Tolerance are null if question.type!=numeric Bank is null if the question is not imported by reference from the question bank",
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "6376c8283cc695e19d785b08"
+                },
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-omitempty": true,
+                    "example": [
+                        "a",
+                        " b"
+                    ]
+                },
+                "bank": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "6376c8283cc695e19d785b08"
+                },
+                "correct": {
+                    "type": "integer",
+                    "x-omitempty": true,
+                    "example": 2
+                },
+                "corrects": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "x-omitempty": true,
+                    "example": [
+                        0,
+                        2
+                    ]
+                },
+                "number": {
+                    "type": "number",
+                    "x-omitempty": true,
+                    "example": 3.14
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-omitempty": true,
+                    "example": [
+                        "a",
+                        " b"
+                    ]
+                },
+                "points": {
+                    "type": "integer",
+                    "x-omitempty": true,
+                    "example": 25
+                },
+                "question": {
+                    "type": "string",
+                    "example": "Whats your name?"
+                },
+                "tolerance": {
+                    "type": "number",
+                    "x-omitempty": true,
+                    "example": 0.01
+                },
+                "type": {
+                    "type": "string",
+                    "example": "alternatives_correct"
+                }
+            }
+        },
+        "models.ModuleWithLookup": {
+            "type": "object",
+            "properties": {
+                "__v": {
+                    "type": "integer"
+                },
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "section": {
+                    "$ref": "#/definitions/models.Section"
+                },
+                "semester": {
+                    "$ref": "#/definitions/models.Semester"
+                },
+                "status": {
+                    "type": "boolean"
+                },
+                "students": {
+                    "description": "If parent - Student Assigned",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SimpleUser"
+                    }
+                },
+                "sub_sections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SubSection"
+                    }
+                },
+                "subject": {
+                    "$ref": "#/definitions/models.Subject"
+                },
+                "works": {
+                    "description": "Works",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Work"
+                    },
+                    "x-omitempty": true
+                }
+            }
+        },
+        "models.PeerReview": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_review": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "evaluate": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EvaluatedFiles"
+                    },
+                    "x-omitempty": true
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "reviewer": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "submission": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "work": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.RegisteredCalendarBlock": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "block": {
+                    "$ref": "#/definitions/models.CalendarBlock"
+                },
+                "day": {
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Rubric": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "author": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "criteria": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkPattern"
+                    }
+                },
+                "description": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "This is a description"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Rúbrica de ensayo"
+                },
+                "update_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "upload_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                }
+            }
+        },
+        "models.Section": {
+            "type": "object",
+            "properties": {
+                "__v": {
+                    "type": "integer"
+                },
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "course": {
+                    "$ref": "#/definitions/models.Course"
+                },
+                "file": {
+                    "$ref": "#/definitions/models.File"
+                },
+                "section": {
+                    "type": "string",
+                    "example": "A"
+                }
+            }
+        },
+        "models.Semester": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "semester": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "year": {
+                    "type": "integer",
+                    "example": 2022
+                }
+            }
+        },
+        "models.SemesterClose": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "attempts": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "averages": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "date_finish": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_start": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_update": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "error": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "no se pudo calcular los promedios"
+                },
+                "modules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SemesterCloseModule"
+                    }
+                },
+                "semester": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "running",
+                        "completed",
+                        "failed"
+                    ],
+                    "example": "running"
+                }
+            }
+        },
+        "models.SemesterCloseModule": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "error": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "no se pudo obtener los estudiantes"
+                },
+                "filled": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "completed",
+                        "failed"
+                    ],
+                    "example": "completed"
+                }
+            }
+        },
+        "models.SessionWLookup": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "block": {
+                    "$ref": "#/definitions/models.RegisteredCalendarBlock"
+                },
+                "date": {
+                    "type": "integer"
+                },
+                "file": {
+                    "type": "string"
+                },
+                "in_date": {
+                    "type": "integer"
+                },
+                "pregrade": {
+                    "type": "number"
+                },
+                "student": {
+                    "type": "string"
+                },
+                "work": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SimilarityOmitted": {
+            "type": "object",
+            "properties": {
+                "file": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "tipo de archivo no soportado"
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.SimilarityPair": {
+            "type": "object",
+            "properties": {
+                "question": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "similarity": {
+                    "type": "number",
+                    "example": 0.82
+                },
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "written",
+                        "files"
+                    ],
+                    "example": "written"
+                },
+                "student_a": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "student_b": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.SimpleUser": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "63785424db1efbc237faecca"
+                },
+                "first_lastname": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "FirstLastname"
+                },
+                "name": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "Name"
+                },
+                "rut": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "12345678-9"
+                },
+                "second_lastname": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "SecondLastname"
+                }
+            }
+        },
+        "models.SubSection": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Math - Geo"
+                }
+            }
+        },
+        "models.Subject": {
+            "type": "object",
+            "properties": {
+                "__v": {
+                    "type": "integer"
+                },
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "subject": {
+                    "type": "string",
+                    "example": "Math"
+                }
+            }
+        },
+        "models.TemplateAcumulative": {
+            "type": "object",
+            "properties": {
+                "number": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 20
+                }
+            }
+        },
+        "models.TemplateProgram": {
+            "type": "object",
+            "properties": {
+                "acumulative": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TemplateAcumulative"
+                    },
+                    "x-omitempty": true
+                },
+                "is_acumulative": {
+                    "type": "boolean"
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 25
+                }
+            }
+        },
+        "models.Work": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "acumulative": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "attached": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Attached"
+                    },
+                    "x-omitempty": true
+                },
+                "attempts": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkAttempts"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "author": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "date_limit": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_publish": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_start": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_update": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_upload": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "description": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "This is a description"
+                },
+                "draft": {
+                    "type": "boolean",
+                    "x-omitempty": true
+                },
+                "form": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "form_access": {
+                    "type": "string",
+                    "enum": [
+                        "default",
+                        "wtime"
+                    ],
+                    "x-omitempty": true,
+                    "example": "default"
+                },
+                "form_shuffle": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkFormShuffle"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "grade": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "groups": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkGroups"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "is_qualified": {
+                    "type": "boolean"
+                },
+                "is_revised": {
+                    "type": "boolean"
+                },
+                "late_policy": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkLatePolicy"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "pattern": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkPattern"
+                    },
+                    "x-omitempty": true
+                },
+                "peer_review": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkPeerReview"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "removed_questions": {
+                    "description": "Questions out of the grade after the item analysis",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-omitempty": true,
+                    "example": [
+                        "637d5de216f58bc8ec7f7f51"
+                    ]
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkSession"
+                    }
+                },
+                "time_access": {
+                    "type": "integer",
+                    "x-omitempty": true,
+                    "example": 2
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Work!"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "files",
+                        "form"
+                    ],
+                    "example": "form"
+                },
+                "virtual": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.WorkAttempts": {
+            "type": "object",
+            "properties": {
+                "quantity": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "scoring": {
+                    "type": "string",
+                    "enum": [
+                        "best",
+                        "last",
+                        "average"
+                    ],
+                    "example": "best"
+                }
+            }
+        },
+        "models.WorkExtensionWLookup": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "author": {
+                    "$ref": "#/definitions/models.SimpleUser"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_limit": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "reason": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "Licencia médica"
+                },
+                "student": {
+                    "$ref": "#/definitions/models.SimpleUser"
+                },
+                "time_access": {
+                    "type": "integer",
+                    "x-omitempty": true,
+                    "example": 3600
+                },
+                "work": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.WorkFeedback": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "comment": {
+                    "type": "string",
+                    "example": "Buen desarrollo, revisa la conclusión"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "evaluator": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "file": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "question": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "update_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "work": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.WorkFormDraw": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "models.WorkFormShuffle": {
+            "type": "object",
+            "properties": {
+                "answers": {
+                    "type": "boolean"
+                },
+                "draws": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkFormDraw"
+                    },
+                    "x-omitempty": true
+                },
+                "items": {
+                    "type": "boolean"
+                },
+                "questions": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.WorkGroup": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "adjustments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GroupAdjustment"
+                    },
+                    "x-omitempty": true
+                },
+                "author": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_update": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "637d5de216f58bc8ec7f7f51"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Grupo 1"
+                },
+                "work": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.WorkGroupWLookup": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "adjustments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GroupAdjustment"
+                    },
+                    "x-omitempty": true
+                },
+                "author": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_update": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SimpleUser"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Grupo 1"
+                },
+                "work": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.WorkGroups": {
+            "type": "object",
+            "properties": {
+                "max_size": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "min_size": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "teacher",
+                        "self"
+                    ],
+                    "example": "self"
+                }
+            }
+        },
+        "models.WorkLatePolicy": {
+            "type": "object",
+            "properties": {
+                "cap": {
+                    "type": "number",
+                    "example": 20
+                },
+                "penalty": {
+                    "type": "number",
+                    "example": 5
+                },
+                "unit": {
+                    "type": "string",
+                    "enum": [
+                        "day",
+                        "hour"
+                    ],
+                    "example": "day"
+                },
+                "window": {
+                    "type": "integer",
+                    "example": 48
+                }
+            }
+        },
+        "models.WorkPattern": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "This is a description"
+                },
+                "levels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkPatternLevel"
+                    },
+                    "x-omitempty": true
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Pattern!"
+                }
+            }
+        },
+        "models.WorkPatternLevel": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Explica el fenómeno con ejemplos"
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Logrado"
+                }
+            }
+        },
+        "models.WorkPeerReview": {
+            "type": "object",
+            "properties": {
+                "assigned": {
+                    "type": "boolean"
+                },
+                "date_limit": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "reviewers": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "models.WorkSession": {
+            "type": "object",
+            "properties": {
+                "block": {
+                    "type": "string"
+                },
+                "dates": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "models.WorkSimilarity": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "author": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_finish": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "documents": {
+                    "type": "integer",
+                    "example": 60
+                },
+                "error": {
+                    "type": "string",
+                    "x-omitempty": true
+                },
+                "omitted": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SimilarityOmitted"
+                    },
+                    "x-omitempty": true
+                },
+                "pairs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SimilarityPair"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "running",
+                        "completed",
+                        "failed"
+                    ],
+                    "example": "completed"
+                },
+                "threshold": {
+                    "type": "number",
+                    "example": 0.5
+                },
+                "work": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.WorkWLookup": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "acumulative": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "attached": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Attached"
+                    }
+                },
+                "attempts": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkAttempts"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "author": {
+                    "$ref": "#/definitions/models.SimpleUser"
+                },
+                "blocks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RegisteredCalendarBlock"
+                    }
+                },
+                "date_limit": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_publish": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_start": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_update": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_upload": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "description": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "This is a description"
+                },
+                "draft": {
+                    "type": "boolean",
+                    "x-omitempty": true
+                },
+                "form": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "form_access": {
+                    "type": "string",
+                    "enum": [
+                        "default",
+                        "wtime"
+                    ],
+                    "x-omitempty": true,
+                    "example": "default"
+                },
+                "form_shuffle": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkFormShuffle"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "grade": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.GradesProgram"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "groups": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkGroups"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "is_qualified": {
+                    "type": "boolean"
+                },
+                "is_revised": {
+                    "type": "boolean"
+                },
+                "late_policy": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkLatePolicy"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "pattern": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkPattern"
+                    },
+                    "x-omitempty": true
+                },
+                "peer_review": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkPeerReview"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkSession"
+                    }
+                },
+                "time_access": {
+                    "type": "integer",
+                    "x-omitempty": true,
+                    "example": 2
+                },
+                "title": {
+                    "type": "string",
+                    "example": "This is a title"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "files",
+                        "form"
+                    ],
+                    "example": "files"
+                },
+                "virtual": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "res.Response": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "message": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "services.AlternativeAnalysis": {
+            "type": "object",
+            "properties": {
+                "answer": {
+                    "type": "string",
+                    "example": "Santiago"
+                },
+                "correct": {
+                    "type": "boolean"
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 40
+                }
+            }
+        },
+        "services.AnswerRes": {
+            "type": "object",
+            "properties": {
+                "answer": {
+                    "$ref": "#/definitions/models.Answer"
+                },
+                "evaluate": {
+                    "x-omitempty": true
+                },
+                "feedback": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkFeedback"
+                        }
+                    ],
+                    "x-omitempty": true
+                }
+            }
+        },
+        "services.AppliedTemplate": {
+            "type": "object",
+            "properties": {
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "programs": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "template": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "services.ApplyTemplatesRes": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.AppliedTemplate"
+                    }
+                },
+                "skipped": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.SkippedTemplate"
+                    }
+                }
+            }
+        },
+        "services.AtRiskStudent": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number",
+                    "example": 3.6
+                },
+                "missing": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "student": {
+                    "$ref": "#/definitions/models.SimpleUser"
+                }
+            }
+        },
+        "services.AttachedRes": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "file": {
+                    "$ref": "#/definitions/models.File"
+                },
+                "link": {
+                    "type": "string",
+                    "example": "https://example.com"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "This is a title"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "link",
+                        "file"
+                    ],
+                    "example": "link"
+                }
+            }
+        },
+        "services.ClonedWork": {
+            "type": "object",
+            "properties": {
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "work": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "services.CloseModulePreview": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "no se pudo obtener los estudiantes"
+                },
+                "grades": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.MissingGrade"
+                    }
+                },
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "services.CloseSemesterPreview": {
+            "type": "object",
+            "properties": {
+                "modules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.CloseModulePreview"
+                    }
+                },
+                "semester": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 25
+                }
+            }
+        },
+        "services.GradesAnalyticsRes": {
+            "type": "object",
+            "properties": {
+                "module": {
+                    "$ref": "#/definitions/services.ModuleAnalytics"
+                },
+                "sections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.SectionAnalytics"
+                    }
+                }
+            }
+        },
+        "services.GradesStats": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "histogram": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.HistogramBin"
+                    }
+                },
+                "max": {
+                    "type": "number",
+                    "example": 7
+                },
+                "mean": {
+                    "type": "number",
+                    "example": 5.4
+                },
+                "median": {
+                    "type": "number",
+                    "example": 5.5
+                },
+                "min": {
+                    "type": "number",
+                    "example": 2.9
+                },
+                "pass_rate": {
+                    "type": "number",
+                    "example": 90
+                },
+                "passed": {
+                    "type": "integer",
+                    "example": 27
+                },
+                "std_dev": {
+                    "type": "number",
+                    "example": 0.8
+                }
+            }
+        },
+        "services.HistogramBin": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "from": {
+                    "type": "number",
+                    "example": 4
+                },
+                "to": {
+                    "type": "number",
+                    "example": 5
+                }
+            }
+        },
+        "services.ImportGradeError": {
+            "type": "object",
+            "properties": {
+                "column": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "B"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "calificación inválida. Mín: 1. Máx: 7"
+                },
+                "row": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "rut": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "12345678-9"
+                }
+            }
+        },
+        "services.ImportGradesRes": {
+            "type": "object",
+            "properties": {
+                "committed": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.ImportGradeError"
+                    }
+                },
+                "inserted": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "rows": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "unchanged": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "updated": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "services.ItemAnalysisRes": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.QuestionAnalysis"
+                    }
+                },
+                "students": {
+                    "type": "integer",
+                    "example": 30
+                }
+            }
+        },
+        "services.MissingGrade": {
+            "type": "object",
+            "properties": {
+                "acumulative": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "grade": {
+                    "type": "number",
+                    "example": 1
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "program": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "services.ModuleAnalytics": {
+            "type": "object",
+            "properties": {
+                "at_risk": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.AtRiskStudent"
+                    }
+                },
+                "averages": {
+                    "$ref": "#/definitions/services.GradesStats"
+                },
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "pass_grade": {
+                    "type": "number",
+                    "example": 4
+                },
+                "programs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.ProgramAnalytics"
+                    }
+                },
+                "section": {
+                    "type": "string",
+                    "example": "Primero A"
+                },
+                "students": {
+                    "type": "integer",
+                    "example": 30
+                }
+            }
+        },
+        "services.ModuleAverageRes": {
+            "description": "Average is null if the student has no grades in the module",
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number",
+                    "example": 5.8
+                },
+                "complete": {
+                    "type": "boolean"
+                },
+                "is_final": {
+                    "type": "boolean"
+                },
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "subject": {
+                    "type": "string",
+                    "example": "Matemáticas"
+                }
+            }
+        },
+        "services.PeerReviewMeanRes": {
+            "type": "object",
+            "properties": {
+                "pattern": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "points": {
+                    "type": "number",
+                    "example": 18.5
+                }
+            }
+        },
+        "services.ProgramAnalytics": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "is_acumulative": {
+                    "type": "boolean"
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 25
+                },
+                "stats": {
+                    "$ref": "#/definitions/services.GradesStats"
+                }
+            }
+        },
+        "services.PublicationsRes": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "attached": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.AttachedRes"
+                    }
+                },
+                "content": {
+                    "type": "string",
+                    "example": "Content..."
+                },
+                "update_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "upload_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                }
+            }
+        },
+        "services.QuestionAnalysis": {
+            "description": "Discrimination is null if there are not enough students to compare the groups Alternatives is null if question.type!=alternatives_correct \u0026\u0026 true_false",
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "all_missed": {
+                    "type": "boolean"
+                },
+                "alternatives": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.AlternativeAnalysis"
+                    },
+                    "x-omitempty": true
+                },
+                "correct": {
+                    "type": "number",
+                    "example": 63.3
+                },
+                "discrimination": {
+                    "type": "number",
+                    "example": 0.42
+                },
+                "omitted": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "question": {
+                    "type": "string",
+                    "example": "¿Capital de Chile?"
+                },
+                "removed": {
+                    "type": "boolean"
+                },
+                "students": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "type": {
+                    "type": "string",
+                    "example": "alternatives_correct"
+                }
+            }
+        },
+        "services.ReviewerPeerReviewRes": {
+            "description": "The reviewer doesn't know the student of the submission",
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "date_review": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "evaluate": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EvaluatedFiles"
+                    },
+                    "x-omitempty": true
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 40
+                }
+            }
+        },
+        "services.ReviewerPeerReviewsRes": {
+            "type": "object",
+            "properties": {
+                "date_limit": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "pattern": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkPattern"
+                    }
+                },
+                "reviews": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.ReviewerPeerReviewRes"
+                    }
+                }
+            }
+        },
+        "services.SectionAnalytics": {
+            "type": "object",
+            "properties": {
+                "at_risk": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "averages": {
+                    "$ref": "#/definitions/services.GradesStats"
+                },
+                "current": {
+                    "type": "boolean"
+                },
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "section": {
+                    "type": "string",
+                    "example": "Primero B"
+                },
+                "students": {
+                    "type": "integer",
+                    "example": 28
+                }
+            }
+        },
+        "services.SimilarityReportRes": {
+            "description": "Students are the users of the flagged pairs",
+            "type": "object",
+            "properties": {
+                "report": {
+                    "$ref": "#/definitions/models.WorkSimilarity"
+                },
+                "students": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SimpleUser"
+                    }
+                }
+            }
+        },
+        "services.SkippedTemplate": {
+            "type": "object",
+            "properties": {
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "el módulo ya tiene calificaciones programadas"
+                }
+            }
+        },
+        "services.Student": {
+            "type": "object",
+            "properties": {
+                "__v": {
+                    "type": "integer"
+                },
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "access": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.FormAccess"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "course": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "evaluate": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    },
+                    "x-omitempty": true
+                },
+                "files_uploaded": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.FileUploadedClassroomWLookup"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "group": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkGroup"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "late": {
+                    "type": "boolean"
+                },
+                "registration_number": {
+                    "type": "string",
+                    "example": "MDVCW45"
+                },
+                "session": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.SessionWLookup"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "user": {
+                    "$ref": "#/definitions/models.SimpleUser"
+                }
+            }
+        },
+        "services.StudentAveragesRes": {
+            "description": "IsFinal is true when the semester was closed, else the averages are partial",
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number",
+                    "example": 5.6
+                },
+                "is_final": {
+                    "type": "boolean"
+                },
+                "modules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.ModuleAverageRes"
+                    }
+                },
+                "semester": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "student": {
+                    "$ref": "#/definitions/models.SimpleUser"
+                }
+            }
+        },
+        "services.StudentPeerReviewRes": {
+            "type": "object",
+            "properties": {
+                "review": {
+                    "$ref": "#/definitions/models.PeerReview"
+                },
+                "reviewer": {
+                    "$ref": "#/definitions/models.SimpleUser"
+                }
+            }
+        },
+        "services.StudentPeerReviewsRes": {
+            "description": "Points is the sum of the means of the reviewed items",
+            "type": "object",
+            "properties": {
+                "means": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.PeerReviewMeanRes"
+                    }
+                },
+                "points": {
+                    "type": "number",
+                    "example": 42.5
+                },
+                "reviews": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.StudentPeerReviewRes"
+                    }
+                }
+            }
+        },
+        "services.WorkStatus": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "date_limit": {
+                    "type": "string"
+                },
+                "date_start": {
+                    "type": "string"
+                },
+                "date_upload": {
+                    "type": "string"
+                },
+                "is_qualified": {
+                    "type": "boolean"
+                },
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "This is a title"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "files",
+                        "form"
+                    ],
+                    "example": "files"
+                }
+            }
+        },
+        "smaps.ApplyTemplatesMap": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "$ref": "#/definitions/services.ApplyTemplatesRes"
+                }
+            }
+        },
+        "smaps.BankQuestionsMap": {
+            "type": "object",
+            "properties": {
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BankQuestionWLookup"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "smaps.CloseSemesterPreviewMap": {
+            "type": "object",
+            "properties": {
+                "preview": {
+                    "$ref": "#/definitions/services.CloseSemesterPreview"
+                }
+            }
+        },
+        "smaps.FormMap": {
+            "type": "object",
+            "properties": {
+                "form": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FormWLookup"
+                    }
+                }
+            }
+        },
+        "smaps.FormWorkMap": {
+            "type": "object",
+            "properties": {
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.AnswerRes"
+                    }
+                },
+                "feedback": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkFeedback"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "form": {
+                    "$ref": "#/definitions/models.FormWLookup"
+                },
+                "work": {
+                    "type": "object",
+                    "properties": {
+                        "attempt": {
+                            "type": "integer",
+                            "x-omitempty": true
+                        },
+                        "attempts": {
+                            "type": "integer",
+                            "x-omitempty": true
+                        },
+                        "date_limit": {
+                            "type": "string"
+                        },
+                        "points": {
+                            "type": "object",
+                            "properties": {
+                                "max_points": {
+                                    "type": "integer"
+                                },
+                                "total_points": {
+                                    "type": "integer"
+                                }
+                            }
+                        },
+                        "status": {
+                            "type": "string"
+                        },
+                        "wtime": {
+                            "type": "boolean"
+                        }
+                    }
+                }
+            }
+        },
+        "smaps.FormsMap": {
+            "type": "object",
+            "properties": {
+                "forms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Form"
+                    }
+                }
+            }
+        },
+        "smaps.GradeHistoryMap": {
+            "type": "object",
+            "properties": {
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GradeHistoryWLookup"
+                    }
+                }
+            }
+        },
+        "smaps.GradesAnalyticsMap": {
+            "type": "object",
+            "properties": {
+                "analytics": {
+                    "$ref": "#/definitions/services.GradesAnalyticsRes"
+                }
+            }
+        },
+        "smaps.GradingScaleMap": {
+            "type": "object",
+            "properties": {
+                "scale": {
+                    "$ref": "#/definitions/models.GradingScale"
+                }
+            }
+        },
+        "smaps.IdInsertedMap": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "smaps.ImportGradesMap": {
+            "type": "object",
+            "properties": {
+                "report": {
+                    "$ref": "#/definitions/services.ImportGradesRes"
+                }
+            }
+        },
+        "smaps.InsertedIdMap": {
+            "type": "object",
+            "properties": {
+                "inserted_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "smaps.ItemAnalysisMap": {
+            "type": "object",
+            "properties": {
+                "analysis": {
+                    "$ref": "#/definitions/services.ItemAnalysisRes"
+                }
+            }
+        },
+        "smaps.ModuleMap": {
+            "type": "object",
+            "properties": {
+                "module": {
+                    "$ref": "#/definitions/models.ModuleWithLookup"
+                }
+            }
+        },
+        "smaps.ModulesMap": {
+            "type": "object",
+            "properties": {
+                "modules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ModuleWithLookup"
+                    }
+                }
+            }
+        },
+        "smaps.ModulesWorksMap": {
+            "type": "object",
+            "properties": {
+                "works": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.WorkStatus"
+                    }
+                }
+            }
+        },
+        "smaps.NewPublicationMap": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "attached_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "smaps.PeerReviewsMap": {
+            "type": "object",
+            "properties": {
+                "peer_reviews": {
+                    "$ref": "#/definitions/services.ReviewerPeerReviewsRes"
+                }
+            }
+        },
+        "smaps.ProgramGradeMap": {
+            "type": "object",
+            "properties": {
+                "programs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GradesProgram"
+                    }
+                }
+            }
+        },
+        "smaps.ProgramTemplatesMap": {
+            "type": "object",
+            "properties": {
+                "templates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GradeProgramTemplate"
+                    }
+                }
+            }
+        },
+        "smaps.PublicationMap": {
+            "type": "object",
+            "properties": {
+                "publication": {
+                    "$ref": "#/definitions/services.PublicationsRes"
+                }
+            }
+        },
+        "smaps.PublicationsMap": {
+            "type": "object",
+            "properties": {
+                "publications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.PublicationsRes"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "smaps.RubricsMap": {
+            "type": "object",
+            "properties": {
+                "rubrics": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Rubric"
+                    }
+                }
+            }
+        },
+        "smaps.SearchHitsMap": {
+            "type": "object",
+            "properties": {
+                "hits": {}
+            }
+        },
+        "smaps.SemesterCloseMap": {
+            "type": "object",
+            "properties": {
+                "close": {
+                    "$ref": "#/definitions/models.SemesterClose"
+                }
+            }
+        },
+        "smaps.SimilarityReportMap": {
+            "type": "object",
+            "properties": {
+                "similarity": {
+                    "$ref": "#/definitions/services.SimilarityReportRes"
+                }
+            }
+        },
+        "smaps.StudentAveragesMap": {
+            "type": "object",
+            "properties": {
+                "averages": {
+                    "$ref": "#/definitions/services.StudentAveragesRes"
+                }
+            }
+        },
+        "smaps.StudentPeerReviewsMap": {
+            "type": "object",
+            "properties": {
+                "peer_reviews": {
+                    "$ref": "#/definitions/services.StudentPeerReviewsRes"
+                }
+            }
+        },
+        "smaps.StudentsStatusMap": {
+            "type": "object",
+            "properties": {
+                "students": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.Student"
+                    }
+                },
+                "total_points": {
+                    "type": "integer"
+                }
+            }
+        },
+        "smaps.TokenMap": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "smaps.WorkClonesMap": {
+            "type": "object",
+            "properties": {
+                "works": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.ClonedWork"
+                    }
+                }
+            }
+        },
+        "smaps.WorkExtensionsMap": {
+            "type": "object",
+            "properties": {
+                "extensions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkExtensionWLookup"
+                    }
+                }
+            }
+        },
+        "smaps.WorkFeedbackMap": {
+            "type": "object",
+            "properties": {
+                "feedback": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkFeedback"
+                    }
+                }
+            }
+        },
+        "smaps.WorkGroupsMap": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkGroupWLookup"
+                    }
+                }
+            }
+        },
+        "smaps.WorksMap": {
+            "type": "object",
+            "properties": {
+                "works": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkWLookup"
+                    }
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "description": "BearerJWTToken in Authorization Header",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
+    },
+    "tags": [
+        {
+            "description": "Service of classroom",
+            "name": "classroom"
+        }
+    ]
+}`
+
+// SwaggerInfo holds exported Swagger Info so clients can modify it
+var SwaggerInfo = &swag.Spec{
+	Version:          "1.0",
+	Host:             "localhost:8080",
+	BasePath:         "/api/c/classroom",
+	Schemes:          []string{},
+	Title:            "Classroom Feed API",
+	Description:      "API Server For feed requests of classroom service",
+	InfoInstanceName: "swagger",
+	SwaggerTemplate:  docTemplate,
+}
+
+func init() {
+	swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
+}
@@ -151,6 +151,16 @@ func Init() {
 		middlewares.JWTMiddleware(),
 		middlewares.RolesMiddleware(teacherRol),
 	)
+	gradingScale := router.Group(
+		"/api/c/classroom/grading_scales",
+		middlewares.JWTMiddleware(),
+		middlewares.RolesMiddleware([]string{models.DIRECTOR, models.DIRECTIVE}),
+	)
+	programTemplate := router.Group(
+		"/api/c/classroom/program_templates",
+		middlewares.JWTMiddleware(),
+		middlewares.RolesMiddleware([]string{models.DIRECTOR, models.DIRECTIVE}),
+	)
 	work := router.Group(
 		"/api/c/classroom/works",
 		middlewares.JWTMiddleware(),
@@ -161,8 +171,11 @@ func Init() {
 		publicationController := new(controllers_feed.PublicationController)
 		moduleController := new(controllers_feed.ModulesController)
 		formController := new(controllers_feed.FormController)
+		questionBankController := new(controllers_feed.QuestionBankController)
 		gradesController := new(controllers_feed.GradesController)
 		worksController := new(controllers_feed.WorkController)
+		rubricController := new(controllers_feed.RubricController)
+		gradingScaleController := new(controllers_feed.GradingScaleController)
 		// Define routes
 		// Module
 		module.POST(
@@ -191,6 +204,10 @@ func Init() {
 		form.POST("/upload_form", formController.UploadForm)
 		form.PUT("/update_form/:idForm", formController.UpdateForm)
 		form.DELETE("/delete_form/:idForm", formController.DeleteForm)
+		// Question bank
+		form.POST("/upload_bank_question", questionBankController.UploadBankQuestion)
+		form.PUT("/update_bank_question/:idQuestion", questionBankController.UpdateBankQuestion)
+		form.DELETE("/delete_bank_question/:idQuestion", questionBankController.DeleteBankQuestion)
 		// Grades
 		grade.POST(
 			"/upload_program/:idModule",
@@ -212,6 +229,35 @@ func Init() {
 			middlewares.AuthorizedRouteModule(),
 			gradesController.DeleteGradeProgram,
 		)
+		grade.POST(
+			"/import_grades/:idModule",
+			middlewares.MaxSizePerFile(MAX_FILE_SIZE, MAX_FILE_SIZE_STR, 1, "file"),
+			middlewares.AuthorizedRouteModule(),
+			gradesController.ImportGrades,
+		)
+		grade.POST(
+			"/release_program/:idModule/:idProgram",
+			middlewares.AuthorizedRouteModule(),
+			gradesController.ReleaseProgram,
+		)
+		// Grading scales
+		grade.POST(
+			"/upload_grading_scale/:idModule",
+			middlewares.AuthorizedRouteModule(),
+			gradingScaleController.UploadModuleScale,
+		)
+		grade.DELETE(
+			"/delete_grading_scale/:idModule",
+			middlewares.AuthorizedRouteModule(),
+			gradingScaleController.DeleteModuleScale,
+		)
+		gradingScale.POST("/upload_semester_scale", gradingScaleController.UploadSemesterScale)
+		gradingScale.DELETE("/delete_semester_scale", gradingScaleController.DeleteSemesterScale)
+		// Program templates
+		programTemplate.POST("/upload_template", gradesController.UploadProgramTemplate)
+		programTemplate.PUT("/update_template/:idTemplate", gradesController.UpdateProgramTemplate)
+		programTemplate.DELETE("/delete_template/:idTemplate", gradesController.DeleteProgramTemplate)
+		programTemplate.POST("/apply_templates", gradesController.ApplyProgramTemplates)
 		// Works
 		work.POST(
 			"/upload_work/:idModule",
@@ -237,6 +283,12 @@ func Init() {
 			middlewares.AuthorizedRouteModule(),
 			worksController.FinishForm,
 		)
+		work.POST(
+			"/new_attempt/:idWork",
+			middlewares.RolesMiddleware(studentRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.NewAttempt,
+		)
 		work.POST(
 			"/upload_points_question/:idWork/:idQuestion/:idStudent",
 			middlewares.RolesMiddleware(teacherRol),
@@ -261,6 +313,24 @@ func Init() {
 			middlewares.AuthorizedRouteModule(),
 			worksController.GradeInperson,
 		)
+		work.POST(
+			"/release_grades/:idWork",
+			middlewares.RolesMiddleware(teacherRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.ReleaseWorkGrades,
+		)
+		work.POST(
+			"/analyze_similarity/:idWork",
+			middlewares.RolesMiddleware(teacherRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.AnalyzeSimilarity,
+		)
+		work.POST(
+			"/remove_question/:idWork/:idQuestion",
+			middlewares.RolesMiddleware(teacherRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.RemoveQuestion,
+		)
 		work.POST(
 			"/upload_evaluate_files/:idWork/:idStudent",
 			middlewares.RolesMiddleware(teacherRol),
@@ -273,6 +343,18 @@ func Init() {
 			middlewares.AuthorizedRouteModule(),
 			worksController.UploadReEvaluateFiles,
 		)
+		work.POST(
+			"/upload_peer_review/:idWork/:idReview",
+			middlewares.RolesMiddleware(studentRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.UploadPeerReview,
+		)
+		work.POST(
+			"/resolve_peer_review/:idWork/:idStudent",
+			middlewares.RolesMiddleware(teacherRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.ResolvePeerReview,
+		)
 		work.POST(
 			"/upload_evaluate_inperson/:idWork/:idStudent",
 			middlewares.RolesMiddleware(teacherRol),
@@ -315,6 +397,124 @@ func Init() {
 			middlewares.AuthorizedRouteModule(),
 			worksController.DeleteItemPattern,
 		)
+		work.GET(
+			"/get_extensions/:idWork",
+			middlewares.RolesMiddleware(teacherRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.GetExtensions,
+		)
+		work.POST(
+			"/upload_extension/:idWork",
+			middlewares.RolesMiddleware(teacherRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.UploadExtension,
+		)
+		work.DELETE(
+			"/delete_extension/:idWork/:idExtension",
+			middlewares.RolesMiddleware(teacherRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.DeleteExtension,
+		)
+		work.POST(
+			"/publish_work/:idWork",
+			middlewares.RolesMiddleware(teacherRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.PublishWork,
+		)
+		work.POST(
+			"/clone_work/:idWork",
+			middlewares.RolesMiddleware(teacherRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.CloneWork,
+		)
+		work.POST(
+			"/upload_group/:idWork",
+			middlewares.RolesMiddleware(teacherRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.UploadGroup,
+		)
+		work.PUT(
+			"/update_group/:idWork/:idGroup",
+			middlewares.RolesMiddleware(teacherRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.UpdateGroup,
+		)
+		work.DELETE(
+			"/delete_group/:idWork/:idGroup",
+			middlewares.RolesMiddleware(teacherRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.DeleteGroup,
+		)
+		work.POST(
+			"/adjust_group_member/:idWork/:idGroup/:idStudent",
+			middlewares.RolesMiddleware(teacherRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.AdjustGroupMember,
+		)
+		work.POST(
+			"/create_group/:idWork",
+			middlewares.RolesMiddleware(studentRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.CreateGroup,
+		)
+		work.POST(
+			"/join_group/:idWork/:idGroup",
+			middlewares.RolesMiddleware(studentRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.JoinGroup,
+		)
+		work.POST(
+			"/leave_group/:idWork",
+			middlewares.RolesMiddleware(studentRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.LeaveGroup,
+		)
+		work.GET(
+			"/get_feedback/:idWork/:idStudent",
+			middlewares.RolesMiddleware(teacherRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.GetFeedback,
+		)
+		work.POST(
+			"/upload_feedback/:idWork/:idStudent",
+			middlewares.RolesMiddleware(teacherRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.UploadFeedback,
+		)
+		work.DELETE(
+			"/delete_feedback/:idWork/:idFeedback",
+			middlewares.RolesMiddleware(teacherRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.DeleteFeedback,
+		)
+		work.POST(
+			"/upload_appeal/:idWork",
+			middlewares.RolesMiddleware(append(studentRol, models.ATTORNEY)),
+			middlewares.AuthorizedRouteModule(),
+			worksController.UploadAppeal,
+		)
+		work.PUT(
+			"/answer_appeal/:idWork/:idAppeal",
+			middlewares.RolesMiddleware(teacherRol),
+			middlewares.AuthorizedRouteModule(),
+			worksController.AnswerAppeal,
+		)
+		// Rubrics
+		work.POST(
+			"/upload_rubric",
+			middlewares.RolesMiddleware(teacherRol),
+			rubricController.UploadRubric,
+		)
+		work.PUT(
+			"/update_rubric/:idRubric",
+			middlewares.RolesMiddleware(teacherRol),
+			rubricController.UpdateRubric,
+		)
+		work.DELETE(
+			"/delete_rubric/:idRubric",
+			middlewares.RolesMiddleware(teacherRol),
+			rubricController.DeleteRubric,
+		)
 	}
 	// Route healthz
 	router.GET("/api/c/classroom/healthz", func(ctx *gin.Context) {
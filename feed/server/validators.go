@@ -13,5 +13,12 @@ func InitValidators() {
 		v.RegisterValidation("workType", forms.WorkType)
 		v.RegisterValidation("formAccessType", forms.FormAccessType)
 		v.RegisterValidation("formAccessTypeUp", forms.FormAccessTypeUpdate)
+		v.RegisterValidation("latePolicyUnit", forms.LatePolicyUnit)
+		v.RegisterValidation("bankMode", forms.BankMode)
+		v.RegisterValidation("difficulty", forms.Difficulty)
+		v.RegisterValidation("attemptsScoring", forms.AttemptsScoring)
+		v.RegisterValidation("peerReviewMode", forms.PeerReviewMode)
+		v.RegisterValidation("groupsMode", forms.GroupsMode)
+		v.RegisterValidation("gradingScaleType", forms.GradingScaleType)
 	}
 }
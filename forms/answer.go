@@ -1,14 +1,19 @@
 package forms
 
+// @Description answers: multiple_select => selected answers, matching => option of each answer, ordering => answers in order
 type AnswerForm struct {
-	Answer   *int   `json:"answer" example:"1" validate:"optional"`
-	Response string `json:"response" example:"Response..." validate:"optional"`
+	Answer   *int     `json:"answer" example:"1" validate:"optional"`
+	Answers  []int    `json:"answers" example:"0,2" validate:"optional"`
+	Number   *float64 `json:"number" example:"3.14" validate:"optional"`
+	Response string   `json:"response" example:"Response..." validate:"optional"`
 }
 
 type Answer struct {
-	Question string `json:"question" binding:"required" validate:"required"`
-	Answer   *int   `json:"answer" example:"1" validate:"optional"`
-	Response string `json:"response" example:"Response..." validate:"optional"`
+	Question string   `json:"question" binding:"required" validate:"required"`
+	Answer   *int     `json:"answer" example:"1" validate:"optional"`
+	Answers  []int    `json:"answers" example:"0,2" validate:"optional"`
+	Number   *float64 `json:"number" example:"3.14" validate:"optional"`
+	Response string   `json:"response" example:"Response..." validate:"optional"`
 }
 
 type AnswersForm struct {
@@ -4,9 +4,12 @@ type EvaluateQuestion struct {
 	Points *int `json:"points" binding:"required" validate:"required" example:"25"`
 }
 
+// @Desc level required instead of points if the item has levels
 type EvaluateFilesForm struct {
 	Pattern string `json:"pattern" binding:"required" validate:"required" example:"Pattern"`
-	Points  *int   `json:"points" binding:"required,min=0" validate:"required" example:"25" minimum:"0"`
+	Points  *int   `json:"points,omitempty" binding:"required_without=Level,omitempty,min=0" example:"25" minimum:"0"`
+	Level   string `json:"level,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Comment string `json:"comment,omitempty" binding:"max=500" maximum:"500" example:"Buen análisis, faltan fuentes"`
 }
 
 type EvaluateInperson struct {
@@ -4,15 +4,26 @@ import (
 	"github.com/go-playground/validator/v10"
 )
 
-// @Description correct required if type == "alternatives_correct"
+// @Description correct required if type == "alternatives_correct" || "true_false" (0 = true, 1 = false)
+// @Description corrects required if type == "multiple_select" || "matching" || "ordering"
+// @Description corrects: multiple_select => correct answers, matching => option of each answer, ordering => answers in order
+// @Description options required if type == "matching"
+// @Description number required if type == "numeric"
 // @Description points required if (father)item.type != "equal"
+// @Description question and type are taken from the question bank if bank is sent
 type QuestionForm struct {
-	ID       string   `json:"_id" validate:"optional" example:"637846a8bc4ea33de990c098"`
-	Question string   `json:"question" binding:"required,min=3" validate:"required" minimum:"3" example:"Who is...?"`
-	Type     string   `json:"type" binding:"required,questionType" validate:"required" enums:"alternatives,alternatives_correct,written" example:"alternatives"`
-	Answers  []string `json:"answers" binding:"dive,min=1,max=100" minimum:"3" maximum:"100" example:"a, b, c"`
-	Correct  *int     `json:"correct" binding:"required_if=Type alternatives_correct" example:"0"`
-	Points   int      `json:"points" binding:"numeric" example:"21"`
+	ID        string   `json:"_id" validate:"optional" example:"637846a8bc4ea33de990c098"`
+	Bank      string   `json:"bank" validate:"optional" example:"637846a8bc4ea33de990c098"`
+	BankMode  string   `json:"bank_mode" binding:"required_with=Bank,omitempty,bankMode" validate:"optional" enums:"copy,reference" example:"reference"`
+	Question  string   `json:"question" binding:"required_without=Bank,omitempty,min=3" validate:"required" minimum:"3" example:"Who is...?"`
+	Type      string   `json:"type" binding:"required_without=Bank,omitempty,questionType" validate:"required" enums:"alternatives,alternatives_correct,written,multiple_select,numeric,true_false,matching,ordering" example:"alternatives"`
+	Answers   []string `json:"answers" binding:"dive,min=1,max=100" minimum:"3" maximum:"100" example:"a, b, c"`
+	Options   []string `json:"options" binding:"required_if=Type matching,dive,min=1,max=100" minimum:"1" maximum:"100" example:"a, b, c"`
+	Correct   *int     `json:"correct" binding:"required_if=Type alternatives_correct,required_if=Type true_false" example:"0"`
+	Corrects  []int    `json:"corrects" binding:"required_if=Type multiple_select,required_if=Type matching,required_if=Type ordering,dive,min=0" example:"0,2"`
+	Number    *float64 `json:"number" binding:"required_if=Type numeric" example:"3.14"`
+	Tolerance float64  `json:"tolerance" binding:"min=0" example:"0.01"`
+	Points    int      `json:"points" binding:"numeric" example:"21"`
 }
 
 // @Description points required if type == "equal"
@@ -40,6 +51,31 @@ var QuestionType validator.Func = func(fl validator.FieldLevel) bool {
 	if fl.Field().Interface() == "written" {
 		return true
 	}
+	if fl.Field().Interface() == "multiple_select" {
+		return true
+	}
+	if fl.Field().Interface() == "numeric" {
+		return true
+	}
+	if fl.Field().Interface() == "true_false" {
+		return true
+	}
+	if fl.Field().Interface() == "matching" {
+		return true
+	}
+	if fl.Field().Interface() == "ordering" {
+		return true
+	}
+	return false
+}
+
+var BankMode validator.Func = func(fl validator.FieldLevel) bool {
+	if fl.Field().Interface() == "copy" {
+		return true
+	}
+	if fl.Field().Interface() == "reference" {
+		return true
+	}
 	return false
 }
 
@@ -13,12 +13,34 @@ type GradeProgramForm struct {
 	Acumulative   []AcumulativeForm `json:"acumulative" binding:"dive" validate:"optional"`
 }
 
+// @Desc without subject and course the template applies to all the modules.
+// @Desc the percentages of the programs must add up to 100
+type GradeProgramTemplateForm struct {
+	Name     string             `json:"name" binding:"required,max=100" validate:"required" maximum:"100" example:"Plantilla Matemáticas"`
+	Subject  string             `json:"subject,omitempty" validate:"optional" example:"637ab12ae976057567a4d67d"`
+	Course   string             `json:"course,omitempty" validate:"optional" example:"637ab12ae976057567a4d67d"`
+	Programs []GradeProgramForm `json:"programs" binding:"required,min=1,max=30,dive" validate:"required" minimum:"1" maximum:"30"`
+}
+
+// @Desc replace the programs of the modules without grades registered
+type ApplyProgramTemplatesForm struct {
+	Replace bool `json:"replace,omitempty" validate:"optional" example:"false"`
+}
+
 type GradeForm struct {
 	Grade       *float64 `json:"grade" binding:"required" validate:"required" example:"70"`
 	Program     string   `json:"program" binding:"required" validate:"required" example:"637ab12ae976057567a4d67d"`
 	Acumulative string   `json:"acumulative" validate:"optional" example:"637ab12ae976057567a4d67d"`
+	Draft       bool     `json:"draft,omitempty" validate:"optional" example:"true"`
 }
 
 type UpdateGradeForm struct {
-	Grade *float64 `json:"grade" binding:"required" validate:"required" example:"55"`
+	Grade  *float64 `json:"grade" binding:"required" validate:"required" example:"55"`
+	Reason string   `json:"reason,omitempty" binding:"max=300" maximum:"300" example:"Error de digitación"`
+}
+
+// @Desc without date the grades are released now
+type ReleaseGradesForm struct {
+	Date        string `json:"date,omitempty" validate:"optional" example:"2006-01-02 15:04"`
+	Acumulative string `json:"acumulative,omitempty" validate:"optional" example:"637ab12ae976057567a4d67d"`
 }
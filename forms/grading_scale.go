@@ -0,0 +1,33 @@
+package forms
+
+import "github.com/go-playground/validator/v10"
+
+// @Desc from is the percentage of points from which the grade applies
+type GradingScaleRange struct {
+	From  *float64 `json:"from" binding:"required,min=0,max=100" validate:"required" minimum:"0" maximum:"100" example:"60"`
+	Grade *float64 `json:"grade" binding:"required" validate:"required" example:"4"`
+}
+
+// @Desc threshold (percentage) and pass_grade required if type == threshold.
+// @Desc table required if type == table, the first range must start from 0
+type GradingScaleForm struct {
+	Type      string              `json:"type" binding:"required,gradingScaleType" validate:"required" enums:"linear,threshold,table" example:"threshold"`
+	Min       *float64            `json:"min" binding:"required" validate:"required" example:"1"`
+	Max       *float64            `json:"max" binding:"required" validate:"required" example:"7"`
+	Threshold float64             `json:"threshold,omitempty" binding:"required_if=Type threshold,omitempty,gt=0,lt=100" example:"60"`
+	PassGrade float64             `json:"pass_grade,omitempty" binding:"required_if=Type threshold" example:"4"`
+	Table     []GradingScaleRange `json:"table,omitempty" binding:"required_if=Type table,omitempty,min=2,max=100,dive"`
+}
+
+var GradingScaleType validator.Func = func(fl validator.FieldLevel) bool {
+	if fl.Field().Interface() == "linear" {
+		return true
+	}
+	if fl.Field().Interface() == "threshold" {
+		return true
+	}
+	if fl.Field().Interface() == "table" {
+		return true
+	}
+	return false
+}
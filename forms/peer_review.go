@@ -0,0 +1,10 @@
+package forms
+
+// @Desc accept takes the mean of the peer scores, blend weights the peer scores with the evaluation of the teacher and override takes the evaluation of the teacher.
+// @Desc peer_weight is the percentage of the peer scores, required if mode == blend.
+// @Desc evaluate required if mode != accept
+type ResolvePeerReviewForm struct {
+	Mode       string              `json:"mode" binding:"required,peerReviewMode" validate:"required" enums:"accept,blend,override" example:"blend"`
+	PeerWeight int                 `json:"peer_weight,omitempty" binding:"required_if=Mode blend,omitempty,min=1,max=99" minimum:"1" maximum:"99" example:"30"`
+	Evaluate   []EvaluateFilesForm `json:"evaluate,omitempty" binding:"required_unless=Mode accept,omitempty,dive"`
+}
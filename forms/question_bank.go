@@ -0,0 +1,23 @@
+package forms
+
+import "github.com/go-playground/validator/v10"
+
+type BankQuestionForm struct {
+	Subject    string       `json:"subject" binding:"required" validate:"required" example:"637846a8bc4ea33de990c098"`
+	Tags       []string     `json:"tags" binding:"max=10,dive,min=1,max=30" maximum:"10" example:"algebra,ecuaciones"`
+	Difficulty string       `json:"difficulty" binding:"required,difficulty" validate:"required" enums:"easy,medium,hard" example:"medium"`
+	Question   QuestionForm `json:"question" validate:"required"`
+}
+
+var Difficulty validator.Func = func(fl validator.FieldLevel) bool {
+	if fl.Field().Interface() == "easy" {
+		return true
+	}
+	if fl.Field().Interface() == "medium" {
+		return true
+	}
+	if fl.Field().Interface() == "hard" {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,7 @@
+package forms
+
+type RubricForm struct {
+	Title       string             `json:"title" binding:"required,min=1,max=100" validate:"required" minimum:"1" maximum:"100" example:"Rúbrica de ensayo"`
+	Description string             `json:"description" binding:"max=300" maximum:"300" example:"This is a description..."`
+	Criteria    []WorkPatternFiles `json:"criteria" binding:"required,min=1,max=20,dive" validate:"required"`
+}
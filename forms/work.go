@@ -5,17 +5,27 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+type WorkPatternLevel struct {
+	Title       string `json:"title" binding:"required,min=1,max=100" validate:"required" minimum:"1" maximum:"100" example:"Logrado"`
+	Description string `json:"description" binding:"max=300" maximum:"300" example:"Explica el fenómeno con ejemplos"`
+	Points      *int   `json:"points" binding:"required,min=0" validate:"required" minimum:"0" example:"25"`
+}
+
+// @Desc points are not required with levels, the item takes the points of the highest level
 type WorkPatternFiles struct {
-	Title       string `json:"title" binding:"required,min=1,max=100" validate:"required" minimum:"1" maximum:"100" example:"Title!"`
-	Description string `json:"description" binding:"required,min=1,max=300" validate:"required" minimum:"1" maximum:"300" example:"This is a description..."`
-	Points      int    `json:"points" binding:"required,min=1" validate:"required" minimum:"1" example:"25"`
+	Title       string             `json:"title" binding:"required,min=1,max=100" validate:"required" minimum:"1" maximum:"100" example:"Title!"`
+	Description string             `json:"description" binding:"required,min=1,max=300" validate:"required" minimum:"1" maximum:"300" example:"This is a description..."`
+	Points      int                `json:"points" binding:"required_without=Levels,omitempty,min=1" minimum:"1" example:"25"`
+	Levels      []WorkPatternLevel `json:"levels,omitempty" binding:"omitempty,min=2,max=10,dive"`
 }
 
+// @Desc points are not required with levels, the item takes the points of the highest level
 type WorkPatternWIDFiles struct {
-	ID          string `json:"_id" example:"637d5de216f58bc8ec7f7f51"`
-	Title       string `json:"title" binding:"required,min=1,max=100" validate:"required" minimum:"1" maximum:"100" example:"Title!"`
-	Description string `json:"description" binding:"required,min=1,max=300" validate:"required" minimum:"1" maximum:"300" example:"This is a description..."`
-	Points      int    `json:"points" binding:"required,min=1" validate:"required" minimum:"1" example:"25"`
+	ID          string             `json:"_id" example:"637d5de216f58bc8ec7f7f51"`
+	Title       string             `json:"title" binding:"required,min=1,max=100" validate:"required" minimum:"1" maximum:"100" example:"Title!"`
+	Description string             `json:"description" binding:"required,min=1,max=300" validate:"required" minimum:"1" maximum:"300" example:"This is a description..."`
+	Points      int                `json:"points" binding:"required_without=Levels,omitempty,min=1" minimum:"1" example:"25"`
+	Levels      []WorkPatternLevel `json:"levels,omitempty" binding:"omitempty,min=2,max=10,dive"`
 }
 
 type WorkSession struct {
@@ -23,11 +33,57 @@ type WorkSession struct {
 	Dates []string `json:"dates" binding:"required,dive"`
 }
 
+// @Desc window in hours after date_limit.
+// @Desc penalty in grade points per unit (day or hour) late.
+// @Desc cap is the max penalty, 0 without cap
+type WorkLatePolicy struct {
+	Window  int      `json:"window" binding:"required,min=1" validate:"required" minimum:"1" example:"48"`
+	Unit    string   `json:"unit" binding:"required,latePolicyUnit" validate:"required" enums:"day,hour" example:"day"`
+	Penalty *float64 `json:"penalty" binding:"required,min=0" validate:"required" minimum:"0" example:"5"`
+	Cap     *float64 `json:"cap" binding:"required,min=0" validate:"required" minimum:"0" example:"20"`
+}
+
+// @Desc draws quantity random questions of the form item per student
+type WorkFormDraw struct {
+	Item     string `json:"item" binding:"required" validate:"required" example:"637d5de216f58bc8ec7f7f51"`
+	Quantity int    `json:"quantity" binding:"required,min=1" validate:"required" minimum:"1" example:"5"`
+}
+
+// @Desc shuffle per student of items, questions and answer options
+type WorkFormShuffle struct {
+	Items     bool           `json:"items" example:"true"`
+	Questions bool           `json:"questions" example:"true"`
+	Answers   bool           `json:"answers" example:"true"`
+	Draws     []WorkFormDraw `json:"draws" binding:"omitempty,dive"`
+}
+
+// @Desc scoring is the attempt score that feeds the grade
+type WorkAttempts struct {
+	Quantity int    `json:"quantity" binding:"required,min=1,max=20" validate:"required" minimum:"1" maximum:"20" example:"3"`
+	Scoring  string `json:"scoring" binding:"required,attemptsScoring" validate:"required" enums:"best,last,average" example:"best"`
+}
+
+// @Desc reviewers are the classmates that review each submission.
+// @Desc date_limit is the end of the review window, after the date limit (and the late window) of the work
+type WorkPeerReview struct {
+	Reviewers int    `json:"reviewers" binding:"required,min=1,max=5" validate:"required" minimum:"1" maximum:"5" example:"2"`
+	DateLimit string `json:"date_limit" binding:"required" validate:"required" example:"2006-01-02 15:04"`
+}
+
+// @Desc mode teacher: the teacher forms the groups, self: the students form them.
+// @Desc min_size and max_size are the limits of members of each group
+type WorkGroups struct {
+	Mode    string `json:"mode" binding:"required,groupsMode" validate:"required" enums:"teacher,self" example:"self"`
+	MinSize int    `json:"min_size" binding:"required,min=1,max=20" validate:"required" minimum:"1" maximum:"20" example:"2"`
+	MaxSize int    `json:"max_size" binding:"required,min=1,max=20,gtefield=MinSize" validate:"required" minimum:"1" maximum:"20" example:"4"`
+}
+
 // @Desc grade required if is_qualified==true.
-// @Desc pattern required if type == files.
+// @Desc pattern or rubric required if type == files.
 // @Desc time_access in seconds.
 // @Desc form_access required if type == form
-// @Desc time_access required if form_access = wtime
+// @Desc time_access required if form_access = wtime.
+// @Desc a draft is hidden to students until it is published, date_publish schedules its publication
 type WorkForm struct {
 	Title          string             `json:"title" binding:"required,min=1,max=100" validate:"required" minimum:"1" maximum:"100" example:"Title!"`
 	Description    string             `json:"description" binding:"max=150" maximum:"150" example:"This is a description..."`
@@ -37,28 +93,49 @@ type WorkForm struct {
 	Virtual        *bool              `json:"virtual" binding:"required" validate:"required"`
 	Type           string             `json:"type" binding:"required,workType" validate:"required" example:"files" enums:"files,form"`
 	Form           string             `json:"form,omitempty" binding:"required_if=Type form" example:"637d5de216f58bc8ec7f7f51"`
-	Pattern        []WorkPatternFiles `json:"pattern,omitempty" binding:"required_if=Type files,dive"`
+	Pattern        []WorkPatternFiles `json:"pattern,omitempty" binding:"omitempty,dive"`
+	Rubric         string             `json:"rubric,omitempty" example:"637d5de216f58bc8ec7f7f51"`
 	DateStart      string             `json:"date_start" binding:"required" example:"2006-01-02 15:04"`
 	DateLimit      string             `json:"date_limit" binding:"required" example:"2006-01-02 15:04"`
 	FormAccess     string             `json:"form_access,omitempty" binding:"required_if=Type form,formAccessType" enums:"default,wtime" example:"wtime"`
 	TimeFormAccess int                `json:"time_access,omitempty" binding:"required_if=FormAccess wtime" example:"3600"` // Seconds
+	LatePolicy     *WorkLatePolicy    `json:"late_policy,omitempty"`
+	FormShuffle    *WorkFormShuffle   `json:"form_shuffle,omitempty"`
+	Attempts       *WorkAttempts      `json:"attempts,omitempty"`
+	PeerReview     *WorkPeerReview    `json:"peer_review,omitempty"`
+	Groups         *WorkGroups        `json:"groups,omitempty"`
 	Attached       []Attached         `json:"attached" binding:"omitempty,dive"`
+	Draft          bool               `json:"draft"`
+	DatePublish    string             `json:"date_publish,omitempty" example:"2006-01-02 15:04"`
 	Acumulative    primitive.ObjectID
 }
 
-// @Desc time_access in seconds
+// @Desc time_access in seconds.
+// @Desc remove_late_policy removes the late policy of the work
 type UpdateWorkForm struct {
-	Title          string                `json:"title" binding:"min=1,max=100" minimum:"1" maximum:"100" example:"Title!"`
-	Description    string                `json:"description" binding:"max=150" maximum:"150" example:"This is a description..."`
-	Grade          string                `json:"grade" example:"637d5de216f58bc8ec7f7f51"`
-	Form           string                `json:"form" example:"637d5de216f58bc8ec7f7f51"`
-	Pattern        []WorkPatternWIDFiles `json:"pattern" binding:"dive"`
-	DateStart      string                `json:"date_start" example:"2006-01-02 15:04"`
-	DateLimit      string                `json:"date_limit" example:"2006-01-02 15:04"`
-	Sessions       []WorkSession         `json:"sessions" binding:"omitempty,dive"`
-	FormAccess     string                `json:"form_access,omitempty" binding:"formAccessTypeUp" enums:"default,wtime"`
-	TimeFormAccess int                   `json:"time_access,omitempty" example:"3600"` // Seconds
-	Attached       []Attached            `json:"attached" binding:"omitempty,dive"`
+	Title            string                `json:"title" binding:"min=1,max=100" minimum:"1" maximum:"100" example:"Title!"`
+	Description      string                `json:"description" binding:"max=150" maximum:"150" example:"This is a description..."`
+	Grade            string                `json:"grade" example:"637d5de216f58bc8ec7f7f51"`
+	Form             string                `json:"form" example:"637d5de216f58bc8ec7f7f51"`
+	Pattern          []WorkPatternWIDFiles `json:"pattern" binding:"dive"`
+	Rubric           string                `json:"rubric,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	DateStart        string                `json:"date_start" example:"2006-01-02 15:04"`
+	DateLimit        string                `json:"date_limit" example:"2006-01-02 15:04"`
+	Sessions         []WorkSession         `json:"sessions" binding:"omitempty,dive"`
+	FormAccess       string                `json:"form_access,omitempty" binding:"formAccessTypeUp" enums:"default,wtime"`
+	TimeFormAccess   int                   `json:"time_access,omitempty" example:"3600"` // Seconds
+	LatePolicy       *WorkLatePolicy       `json:"late_policy,omitempty"`
+	RemoveLatePolicy bool                  `json:"remove_late_policy"`
+	FormShuffle      *WorkFormShuffle      `json:"form_shuffle,omitempty"`
+	Attempts         *WorkAttempts         `json:"attempts,omitempty"`
+	PeerReview       *WorkPeerReview       `json:"peer_review,omitempty"`
+	Groups           *WorkGroups           `json:"groups,omitempty"`
+	Attached         []Attached            `json:"attached" binding:"omitempty,dive"`
+}
+
+// @Desc without date the work is published now
+type PublishWorkForm struct {
+	Date string `json:"date,omitempty" validate:"optional" example:"2006-01-02 15:04"`
 }
 
 var WorkType validator.Func = func(fl validator.FieldLevel) bool {
@@ -100,3 +177,49 @@ var FormAccessTypeUpdate validator.Func = func(fl validator.FieldLevel) bool {
 	}
 	return false
 }
+
+var AttemptsScoring validator.Func = func(fl validator.FieldLevel) bool {
+	if fl.Field().Interface() == "best" {
+		return true
+	}
+	if fl.Field().Interface() == "last" {
+		return true
+	}
+	if fl.Field().Interface() == "average" {
+		return true
+	}
+	return false
+}
+
+var PeerReviewMode validator.Func = func(fl validator.FieldLevel) bool {
+	if fl.Field().Interface() == "accept" {
+		return true
+	}
+	if fl.Field().Interface() == "blend" {
+		return true
+	}
+	if fl.Field().Interface() == "override" {
+		return true
+	}
+	return false
+}
+
+var GroupsMode validator.Func = func(fl validator.FieldLevel) bool {
+	if fl.Field().Interface() == "teacher" {
+		return true
+	}
+	if fl.Field().Interface() == "self" {
+		return true
+	}
+	return false
+}
+
+var LatePolicyUnit validator.Func = func(fl validator.FieldLevel) bool {
+	if fl.Field().Interface() == "day" {
+		return true
+	}
+	if fl.Field().Interface() == "hour" {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,13 @@
+package forms
+
+// @Desc student required if the user is an attorney
+type WorkAppealForm struct {
+	Student       string `json:"student,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Justification string `json:"justification" binding:"required,min=1,max=1000" validate:"required" minimum:"1" maximum:"1000" example:"La pregunta 3 está bien desarrollada"`
+}
+
+// @Desc reason required if accept == false
+type AnswerWorkAppealForm struct {
+	Accept *bool  `json:"accept" binding:"required" validate:"required" example:"false"`
+	Reason string `json:"reason,omitempty" binding:"max=500" maximum:"500" example:"La pauta no considera ese desarrollo"`
+}
@@ -0,0 +1,16 @@
+package forms
+
+// @Desc grade is the program (or acumulative) of the target module, if empty the qualified work is left unlinked
+type WorkCloneTarget struct {
+	Module string `json:"module" binding:"required" validate:"required" example:"637d5de216f58bc8ec7f7f51"`
+	Grade  string `json:"grade,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+}
+
+// @Desc offset in days, shifts all the dates of the work.
+// @Desc the clones are drafts if draft == true, date_publish schedules their publication
+type WorkCloneForm struct {
+	Targets     []WorkCloneTarget `json:"targets" binding:"required,min=1,max=20,dive" validate:"required" minimum:"1" maximum:"20"`
+	Offset      int               `json:"offset" binding:"min=-730,max=730" minimum:"-730" maximum:"730" example:"182"`
+	Draft       bool              `json:"draft"`
+	DatePublish string            `json:"date_publish,omitempty" example:"2006-01-02 15:04"`
+}
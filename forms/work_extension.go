@@ -0,0 +1,10 @@
+package forms
+
+// @Desc date_limit required if time_access is empty.
+// @Desc time_access in seconds, only for forms with form_access == wtime
+type WorkExtensionForm struct {
+	Student        string `json:"student" binding:"required" validate:"required" example:"637d5de216f58bc8ec7f7f51"`
+	DateLimit      string `json:"date_limit,omitempty" binding:"required_without=TimeFormAccess" example:"2006-01-02 15:04"`
+	TimeFormAccess int    `json:"time_access,omitempty" binding:"omitempty,min=1" minimum:"1" example:"3600"` // Seconds
+	Reason         string `json:"reason,omitempty" binding:"max=300" maximum:"300" example:"Licencia médica"`
+}
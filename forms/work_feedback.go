@@ -0,0 +1,9 @@
+package forms
+
+// @Desc question or file to comment a form question or an uploaded file,
+// @Desc without them the comment is about the whole work
+type WorkFeedbackForm struct {
+	Question string `json:"question,omitempty" binding:"excluded_with=File" example:"637d5de216f58bc8ec7f7f51"`
+	File     string `json:"file,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Comment  string `json:"comment" binding:"required,min=1,max=1000" validate:"required" minimum:"1" maximum:"1000" example:"Buen desarrollo, revisa la conclusión"`
+}
@@ -0,0 +1,17 @@
+package forms
+
+// @Desc members are the students of the group
+type WorkGroupForm struct {
+	Name    string   `json:"name" binding:"required,min=1,max=50" validate:"required" minimum:"1" maximum:"50" example:"Grupo 1"`
+	Members []string `json:"members" binding:"required,min=1,dive,required" validate:"required" example:"637d5de216f58bc8ec7f7f51"`
+}
+
+type SelfWorkGroupForm struct {
+	Name string `json:"name" binding:"required,min=1,max=50" validate:"required" minimum:"1" maximum:"50" example:"Grupo 1"`
+}
+
+// @Desc adjustment is added to the grade of the group for the member, zero removes it
+type GroupAdjustmentForm struct {
+	Adjustment *float64 `json:"adjustment" binding:"required" validate:"required" example:"-0.5"`
+	Comment    string   `json:"comment" binding:"max=150" maximum:"150" example:"No participó en la presentación"`
+}
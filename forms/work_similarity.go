@@ -0,0 +1,6 @@
+package forms
+
+// @Desc threshold is the min similarity (0.1 - 1) to flag a pair of students, 0.5 by default
+type WorkSimilarityForm struct {
+	Threshold float64 `json:"threshold,omitempty" binding:"omitempty,min=0.1,max=1" validate:"optional" minimum:"0.1" maximum:"1" example:"0.5"`
+}
@@ -21,6 +21,8 @@ type Answer struct {
 	Work     primitive.ObjectID `bson:"work" example:"637d5de216f58bc8ec7f7f51"`
 	Question primitive.ObjectID `json:"question" bson:"question" example:"637d5de216f58bc8ec7f7f51"`
 	Answer   int                `json:"answer" bson:"answer" example:"1"`
+	Answers  []int              `json:"answers,omitempty" bson:"answers,omitempty" example:"0,2" extensions:"x-omitempty"`
+	Number   *float64           `json:"number,omitempty" bson:"number,omitempty" example:"3.14" extensions:"x-omitempty"`
 	Response string             `json:"response" bson:"response,omitempty" example:"Response..."`
 	Date     primitive.DateTime `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
 }
@@ -53,6 +55,13 @@ func init() {
 			"question": bson.M{"bsonType": "objectId"},
 			"date":     bson.M{"bsonType": "date"},
 			"answer":   bson.M{"bsonType": "int"},
+			"answers": bson.M{
+				"bsonType": bson.A{"array"},
+				"items": bson.M{
+					"bsonType": "int",
+				},
+			},
+			"number":   bson.M{"bsonType": "double"},
 			"response": bson.M{"bsonType": "string"},
 		},
 	}
@@ -77,6 +86,10 @@ func NewModelAnswer(answer *forms.AnswerForm, student, work, question primitive.
 	}
 	if answer.Answer != nil {
 		modelAnswer.Answer = *answer.Answer
+	} else if answer.Answers != nil {
+		modelAnswer.Answers = answer.Answers
+	} else if answer.Number != nil {
+		modelAnswer.Number = answer.Number
 	} else {
 		modelAnswer.Response = answer.Response
 	}
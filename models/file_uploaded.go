@@ -18,6 +18,8 @@ type EvaluatedFiles struct {
 	ID      primitive.ObjectID `json:"_id" bson:"_id" example:"637d5de216f58bc8ec7f7f51"`
 	Pattern primitive.ObjectID `json:"pattern" bson:"pattern" example:"637d5de216f58bc8ec7f7f51"`
 	Points  int                `json:"points" bson:"points" example:"25"`
+	Level   primitive.ObjectID `json:"level,omitempty" bson:"level,omitempty" example:"637d5de216f58bc8ec7f7f51" extensions:"x-omitempty"`
+	Comment string             `json:"comment,omitempty" bson:"comment,omitempty" example:"Buen análisis, faltan fuentes" extensions:"x-omitempty"`
 }
 
 type FileUploadedClassroom struct {
@@ -27,6 +29,7 @@ type FileUploadedClassroom struct {
 	FilesUploaded []primitive.ObjectID `json:"files_uploaded" bson:"files_uploaded"`
 	Evaluate      []EvaluatedFiles     `json:"evaluate,omitempty" bson:"evaluate,omitempty"`
 	Date          primitive.DateTime   `json:"date" bson:"date"`
+	DateUpdate    primitive.DateTime   `json:"date_update,omitempty" bson:"date_update,omitempty"`
 }
 
 type FileUploadedClassroomWLookup struct {
@@ -36,6 +39,7 @@ type FileUploadedClassroomWLookup struct {
 	FilesUploaded []File             `json:"files_uploaded" bson:"files_uploaded"`
 	Evaluate      []EvaluatedFiles   `json:"evaluate,omitempty" bson:"evaluate,omitempty" extensions:"x-omitempty"`
 	Date          primitive.DateTime `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	DateUpdate    primitive.DateTime `json:"date_update,omitempty" bson:"date_update,omitempty" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00" extensions:"x-omitempty"`
 }
 
 type FileUploadedClassroomModel struct {
@@ -107,9 +111,10 @@ func init() {
 			"files_uploaded",
 		},
 		"properties": bson.M{
-			"work":    bson.M{"bsonType": "objectId"},
-			"student": bson.M{"bsonType": "objectId"},
-			"date":    bson.M{"bsonType": "date"},
+			"work":        bson.M{"bsonType": "objectId"},
+			"student":     bson.M{"bsonType": "objectId"},
+			"date":        bson.M{"bsonType": "date"},
+			"date_update": bson.M{"bsonType": "date"},
 			"files_uploaded": bson.M{
 				"bsonType": bson.A{"array"},
 				"items": bson.M{
@@ -129,6 +134,11 @@ func init() {
 						"_id":     bson.M{"bsonType": "objectId"},
 						"points":  bson.M{"bsonType": "int"},
 						"pattern": bson.M{"bsonType": "objectId"},
+						"level":   bson.M{"bsonType": "objectId"},
+						"comment": bson.M{
+							"bsonType":  "string",
+							"maxLength": 500,
+						},
 					},
 				},
 			},
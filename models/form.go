@@ -19,15 +19,24 @@ var formQuestionModel *FormQuestionModel
 
 // Types
 // @Description Points is null if (father)form.has_points = false
-// @Description Answers is null if question.type=written
-// @Description Correct is null if question.type=written || alternatives
+// @Description Answers is null if question.type=written || numeric
+// @Description Correct is null if question.type!=alternatives_correct && true_false
+// @Description Corrects is null if question.type!=multiple_select && matching && ordering
+// @Description Options is null if question.type!=matching
+// @Description Number and Tolerance are null if question.type!=numeric
+// @Description Bank is null if the question is not imported by reference from the question bank
 type ItemQuestion struct {
-	ID       primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"6376c8283cc695e19d785b08"`
-	Type     string             `json:"type" bson:"type" example:"alternatives_correct"`
-	Question string             `json:"question" bson:"question" example:"Whats your name?"`
-	Answers  []string           `json:"answers" bson:"answers,omitempty" example:"a, b" extensions:"x-omitempty"`
-	Points   int                `json:"points,omitempty" bson:"points,omitempty" example:"25" extensions:"x-omitempty"`
-	Correct  int                `json:"correct" bson:"correct,omitempty" example:"2" extensions:"x-omitempty"`
+	ID        primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"6376c8283cc695e19d785b08"`
+	Type      string             `json:"type" bson:"type" example:"alternatives_correct"`
+	Question  string             `json:"question" bson:"question" example:"Whats your name?"`
+	Answers   []string           `json:"answers" bson:"answers,omitempty" example:"a, b" extensions:"x-omitempty"`
+	Options   []string           `json:"options,omitempty" bson:"options,omitempty" example:"a, b" extensions:"x-omitempty"`
+	Points    int                `json:"points,omitempty" bson:"points,omitempty" example:"25" extensions:"x-omitempty"`
+	Correct   int                `json:"correct" bson:"correct,omitempty" example:"2" extensions:"x-omitempty"`
+	Corrects  []int              `json:"corrects,omitempty" bson:"corrects,omitempty" example:"0,2" extensions:"x-omitempty"`
+	Number    *float64           `json:"number,omitempty" bson:"number,omitempty" example:"3.14" extensions:"x-omitempty"`
+	Tolerance float64            `json:"tolerance,omitempty" bson:"tolerance,omitempty" example:"0.01" extensions:"x-omitempty"`
+	Bank      primitive.ObjectID `json:"bank,omitempty" bson:"bank,omitempty" example:"6376c8283cc695e19d785b08" extensions:"x-omitempty"`
 }
 
 type FormItem struct {
@@ -147,6 +156,11 @@ func initQuestions(collections []string) {
 					"alternatives",
 					"alternatives_correct",
 					"written",
+					"multiple_select",
+					"numeric",
+					"true_false",
+					"matching",
+					"ordering",
 				},
 			},
 			"question": bson.M{"bsonType": "string"},
@@ -156,8 +170,23 @@ func initQuestions(collections []string) {
 					"bsonType": "string",
 				},
 			},
+			"options": bson.M{
+				"bsonType": bson.A{"array"},
+				"items": bson.M{
+					"bsonType": "string",
+				},
+			},
 			"points":  bson.M{"bsonType": "int"},
 			"correct": bson.M{"bsonType": "int"},
+			"corrects": bson.M{
+				"bsonType": bson.A{"array"},
+				"items": bson.M{
+					"bsonType": "int",
+				},
+			},
+			"number":    bson.M{"bsonType": "double"},
+			"tolerance": bson.M{"bsonType": "double", "minimum": 0},
+			"bank":      bson.M{"bsonType": "objectId"},
 		},
 	}
 	var validators = bson.M{
@@ -266,10 +295,27 @@ func NewModelsFormQuestion(
 	if question.Type != "alternatives" {
 		questionData.Points = points
 	}
-	if question.Type == "alternatives_correct" {
+	if question.Type == "alternatives_correct" || question.Type == "true_false" {
 		questionData.Correct = *question.Correct
 	}
+	if question.Type == "multiple_select" || question.Type == "matching" || question.Type == "ordering" {
+		questionData.Corrects = question.Corrects
+	}
+	if question.Type == "matching" {
+		questionData.Options = question.Options
+	}
+	if question.Type == "numeric" {
+		questionData.Number = question.Number
+		questionData.Tolerance = question.Tolerance
+	}
 	questionData.Answers = question.Answers
+	if question.Type == "true_false" {
+		questionData.Answers = []string{"Verdadero", "Falso"}
+	}
+	if question.BankMode == "reference" {
+		idObjBank, _ := primitive.ObjectIDFromHex(question.Bank)
+		questionData.Bank = idObjBank
+	}
 
 	return &questionData
 }
@@ -14,12 +14,31 @@ const FORM_ACCESS_COLLECTION = "students_access_form"
 
 var formAcessModel *FormAccessModel
 
+type FormAccessItem struct {
+	Item      primitive.ObjectID   `json:"item" bson:"item" example:"637d5de216f58bc8ec7f7f51"`
+	Questions []primitive.ObjectID `json:"questions" bson:"questions" example:"637d5de216f58bc8ec7f7f51"`
+}
+
+// @Description order[display index] = canonical index of the answer (option if type=matching)
+type FormAccessAnswers struct {
+	Question primitive.ObjectID `json:"question" bson:"question" example:"637d5de216f58bc8ec7f7f51"`
+	Order    []int              `json:"order" bson:"order" example:"2,0,1"`
+}
+
+type FormPermutation struct {
+	Items   []FormAccessItem    `json:"items" bson:"items"`
+	Answers []FormAccessAnswers `json:"answers,omitempty" bson:"answers,omitempty" extensions:"x-omitempty"`
+}
+
 type FormAccess struct {
-	ID      primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
-	Student primitive.ObjectID `json:"student" bson:"student" example:"637d5de216f58bc8ec7f7f51"`
-	Work    primitive.ObjectID `json:"work" bson:"work" example:"637d5de216f58bc8ec7f7f51"`
-	Date    primitive.DateTime `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
-	Status  string             `json:"status" bson:"status" enums:"opened,finished,revised" example:"opened"`
+	ID          primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Student     primitive.ObjectID `json:"student" bson:"student" example:"637d5de216f58bc8ec7f7f51"`
+	Work        primitive.ObjectID `json:"work" bson:"work" example:"637d5de216f58bc8ec7f7f51"`
+	Date        primitive.DateTime `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	DateFinish  primitive.DateTime `json:"date_finish,omitempty" bson:"date_finish,omitempty" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00" extensions:"x-omitempty"`
+	Status      string             `json:"status" bson:"status" enums:"opened,finished,revised" example:"opened"`
+	Attempt     int                `json:"attempt,omitempty" bson:"attempt,omitempty" example:"2" extensions:"x-omitempty"`
+	Permutation *FormPermutation   `json:"-" bson:"permutation,omitempty"`
 }
 
 type FormAccessModel struct {
@@ -91,10 +110,46 @@ func init() {
 			"status",
 		},
 		"properties": bson.M{
-			"student": bson.M{"bsonType": "objectId"},
-			"work":    bson.M{"bsonType": "objectId"},
-			"date":    bson.M{"bsonType": "date"},
-			"status":  bson.M{"enum": bson.A{"opened", "finished", "revised"}},
+			"student":     bson.M{"bsonType": "objectId"},
+			"work":        bson.M{"bsonType": "objectId"},
+			"date":        bson.M{"bsonType": "date"},
+			"date_finish": bson.M{"bsonType": "date"},
+			"status":      bson.M{"enum": bson.A{"opened", "finished", "revised"}},
+			"attempt":     bson.M{"bsonType": "int", "minimum": 1},
+			"permutation": bson.M{
+				"bsonType": "object",
+				"required": bson.A{"items"},
+				"properties": bson.M{
+					"items": bson.M{
+						"bsonType": bson.A{"array"},
+						"items": bson.M{
+							"bsonType": "object",
+							"required": bson.A{"item", "questions"},
+							"properties": bson.M{
+								"item": bson.M{"bsonType": "objectId"},
+								"questions": bson.M{
+									"bsonType": bson.A{"array"},
+									"items":    bson.M{"bsonType": "objectId"},
+								},
+							},
+						},
+					},
+					"answers": bson.M{
+						"bsonType": bson.A{"array"},
+						"items": bson.M{
+							"bsonType": "object",
+							"required": bson.A{"question", "order"},
+							"properties": bson.M{
+								"question": bson.M{"bsonType": "objectId"},
+								"order": bson.M{
+									"bsonType": bson.A{"array"},
+									"items":    bson.M{"bsonType": "int"},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 	var validators = bson.M{
@@ -0,0 +1,159 @@
+package models
+
+import (
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const FORM_ATTEMPTS_COLLECTION = "form_attempts"
+
+var formAttemptsModel *FormAttemptsModel
+
+// Finished attempt of a form work, the current attempt lives in the form access
+type FormAttempt struct {
+	ID          primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Student     primitive.ObjectID `json:"student" bson:"student" example:"637d5de216f58bc8ec7f7f51"`
+	Work        primitive.ObjectID `json:"work" bson:"work" example:"637d5de216f58bc8ec7f7f51"`
+	Attempt     int                `json:"attempt" bson:"attempt" example:"1"`
+	Answers     []Answer           `json:"answers" bson:"answers"`
+	Points      int                `json:"points" bson:"points" example:"20"`
+	MaxPoints   int                `json:"max_points" bson:"max_points" example:"25"`
+	Permutation *FormPermutation   `json:"-" bson:"permutation,omitempty"`
+	DateStart   primitive.DateTime `json:"date_start" bson:"date_start" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	DateFinish  primitive.DateTime `json:"date_finish,omitempty" bson:"date_finish,omitempty" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00" extensions:"x-omitempty"`
+	Date        primitive.DateTime `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+}
+
+type FormAttemptsModel struct {
+	CollectionName string
+}
+
+func NewModelFormAttempt(
+	access *FormAccess,
+	answers []Answer,
+	points,
+	maxPoints int,
+) FormAttempt {
+	attempt := access.Attempt
+	if attempt == 0 {
+		attempt = 1
+	}
+	if answers == nil {
+		answers = []Answer{}
+	}
+	return FormAttempt{
+		Student:     access.Student,
+		Work:        access.Work,
+		Attempt:     attempt,
+		Answers:     answers,
+		Points:      points,
+		MaxPoints:   maxPoints,
+		Permutation: access.Permutation,
+		DateStart:   access.Date,
+		DateFinish:  access.DateFinish,
+		Date:        primitive.NewDateTimeFromTime(time.Now()),
+	}
+}
+
+func (a *FormAttemptsModel) Use() *mongo.Collection {
+	return DbConnect.GetCollection(a.CollectionName)
+}
+
+func (a *FormAttemptsModel) GetByID(id primitive.ObjectID) *mongo.SingleResult {
+	cursor := a.Use().FindOne(db.Ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: id,
+		},
+	})
+	return cursor
+}
+
+func (a *FormAttemptsModel) GetOne(filter bson.D) *mongo.SingleResult {
+	cursor := a.Use().FindOne(db.Ctx, filter)
+	return cursor
+}
+
+func (a *FormAttemptsModel) GetAll(filter bson.D, options *options.FindOptions) (*mongo.Cursor, error) {
+	cursor, err := a.Use().Find(db.Ctx, filter, options)
+	return cursor, err
+}
+
+func (a *FormAttemptsModel) Aggreagate(pipeline mongo.Pipeline) (*mongo.Cursor, error) {
+	cursor, err := a.Use().Aggregate(db.Ctx, pipeline)
+	return cursor, err
+}
+
+func (a *FormAttemptsModel) NewDocument(data interface{}) (*mongo.InsertOneResult, error) {
+	result, err := a.Use().InsertOne(db.Ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func init() {
+	collections, err := DbConnect.GetCollections()
+	if err != nil {
+		panic(err)
+	}
+	for _, collection := range collections {
+		if collection == FORM_ATTEMPTS_COLLECTION {
+			return
+		}
+	}
+	var jsonSchema = bson.M{
+		"bsonType": "object",
+		"required": []string{
+			"student",
+			"work",
+			"attempt",
+			"answers",
+			"points",
+			"max_points",
+			"date_start",
+			"date",
+		},
+		"properties": bson.M{
+			"student": bson.M{"bsonType": "objectId"},
+			"work":    bson.M{"bsonType": "objectId"},
+			"attempt": bson.M{"bsonType": "int", "minimum": 1},
+			"answers": bson.M{
+				"bsonType": bson.A{"array"},
+				"items": bson.M{
+					"bsonType": "object",
+				},
+			},
+			"points":      bson.M{"bsonType": "int", "minimum": 0},
+			"max_points":  bson.M{"bsonType": "int", "minimum": 0},
+			"permutation": bson.M{"bsonType": "object"},
+			"date_start":  bson.M{"bsonType": "date"},
+			"date_finish": bson.M{"bsonType": "date"},
+			"date":        bson.M{"bsonType": "date"},
+		},
+	}
+	var validators = bson.M{
+		"$jsonSchema": jsonSchema,
+	}
+	opts := &options.CreateCollectionOptions{
+		Validator: validators,
+	}
+	err = DbConnect.CreateCollection(FORM_ATTEMPTS_COLLECTION, opts)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func NewFormAttemptsModel() Collection {
+	if formAttemptsModel == nil {
+		formAttemptsModel = &FormAttemptsModel{
+			CollectionName: FORM_ATTEMPTS_COLLECTION,
+		}
+	}
+	return formAttemptsModel
+}
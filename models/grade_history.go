@@ -0,0 +1,189 @@
+package models
+
+import (
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const GRADE_HISTORY_COLLECTION = "grade_history"
+
+// Sources of a grade change
+const (
+	GRADE_SOURCE_MANUAL         = "manual"
+	GRADE_SOURCE_EVALUATION     = "evaluation"
+	GRADE_SOURCE_REEVALUATION   = "reevaluation"
+	GRADE_SOURCE_CLOSE_SEMESTER = "close_grades_semester"
+)
+
+var gradeHistoryModel *GradeHistoryModel
+
+// Append-only entry of a change in grades or work_grades, old_grade
+// is omitted when the grade is created. Without actor the change was
+// made by the system
+type GradeHistory struct {
+	ID         primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Grade      primitive.ObjectID `json:"grade" bson:"grade" example:"637d5de216f58bc8ec7f7f51"`
+	Collection string             `json:"collection" bson:"collection" enums:"grades,work_grades" example:"grades"`
+	Module     primitive.ObjectID `json:"module" bson:"module" example:"637d5de216f58bc8ec7f7f51"`
+	Student    primitive.ObjectID `json:"student" bson:"student" example:"637d5de216f58bc8ec7f7f51"`
+	OldGrade   *float64           `json:"old_grade,omitempty" bson:"old_grade,omitempty" example:"4.5" extensions:"x-omitempty"`
+	NewGrade   float64            `json:"new_grade" bson:"new_grade" example:"5.2"`
+	Actor      primitive.ObjectID `json:"actor,omitempty" bson:"actor,omitempty" example:"637d5de216f58bc8ec7f7f51" extensions:"x-omitempty"`
+	Source     string             `json:"source" bson:"source" enums:"manual,evaluation,reevaluation,close_grades_semester" example:"manual"`
+	Reason     string             `json:"reason,omitempty" bson:"reason,omitempty" example:"Error de digitación" extensions:"x-omitempty"`
+	Date       primitive.DateTime `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+}
+
+type GradeHistoryWLookup struct {
+	ID         primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Grade      primitive.ObjectID `json:"grade" bson:"grade" example:"637d5de216f58bc8ec7f7f51"`
+	Collection string             `json:"collection" bson:"collection" enums:"grades,work_grades" example:"grades"`
+	Module     primitive.ObjectID `json:"module" bson:"module" example:"637d5de216f58bc8ec7f7f51"`
+	Student    primitive.ObjectID `json:"student" bson:"student" example:"637d5de216f58bc8ec7f7f51"`
+	OldGrade   *float64           `json:"old_grade,omitempty" bson:"old_grade,omitempty" example:"4.5" extensions:"x-omitempty"`
+	NewGrade   float64            `json:"new_grade" bson:"new_grade" example:"5.2"`
+	Actor      *SimpleUser        `json:"actor,omitempty" bson:"actor,omitempty" extensions:"x-omitempty"`
+	Source     string             `json:"source" bson:"source" enums:"manual,evaluation,reevaluation,close_grades_semester" example:"manual"`
+	Reason     string             `json:"reason,omitempty" bson:"reason,omitempty" example:"Error de digitación" extensions:"x-omitempty"`
+	Date       primitive.DateTime `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+}
+
+type GradeHistoryModel struct {
+	CollectionName string
+}
+
+func NewModelGradeHistory(
+	grade primitive.ObjectID,
+	collection string,
+	module,
+	student primitive.ObjectID,
+	oldGrade *float64,
+	newGrade float64,
+	actor primitive.ObjectID,
+	source,
+	reason string,
+) GradeHistory {
+	return GradeHistory{
+		Grade:      grade,
+		Collection: collection,
+		Module:     module,
+		Student:    student,
+		OldGrade:   oldGrade,
+		NewGrade:   newGrade,
+		Actor:      actor,
+		Source:     source,
+		Reason:     reason,
+		Date:       primitive.NewDateTimeFromTime(time.Now()),
+	}
+}
+
+func (h *GradeHistoryModel) Use() *mongo.Collection {
+	return DbConnect.GetCollection(h.CollectionName)
+}
+
+func (h *GradeHistoryModel) GetByID(id primitive.ObjectID) *mongo.SingleResult {
+	cursor := h.Use().FindOne(db.Ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: id,
+		},
+	})
+	return cursor
+}
+
+func (h *GradeHistoryModel) GetOne(filter bson.D) *mongo.SingleResult {
+	cursor := h.Use().FindOne(db.Ctx, filter)
+	return cursor
+}
+
+func (h *GradeHistoryModel) GetAll(filter bson.D, options *options.FindOptions) (*mongo.Cursor, error) {
+	cursor, err := h.Use().Find(db.Ctx, filter, options)
+	return cursor, err
+}
+
+func (h *GradeHistoryModel) Aggreagate(pipeline mongo.Pipeline) (*mongo.Cursor, error) {
+	cursor, err := h.Use().Aggregate(db.Ctx, pipeline)
+	return cursor, err
+}
+
+func (h *GradeHistoryModel) NewDocument(data interface{}) (*mongo.InsertOneResult, error) {
+	result, err := h.Use().InsertOne(db.Ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func init() {
+	collections, err := DbConnect.GetCollections()
+	if err != nil {
+		panic(err)
+	}
+	for _, collection := range collections {
+		if collection == GRADE_HISTORY_COLLECTION {
+			return
+		}
+	}
+	var jsonSchema = bson.M{
+		"bsonType": "object",
+		"required": []string{
+			"grade",
+			"collection",
+			"module",
+			"student",
+			"new_grade",
+			"source",
+			"date",
+		},
+		"properties": bson.M{
+			"grade": bson.M{"bsonType": "objectId"},
+			"collection": bson.M{
+				"bsonType": "string",
+				"enum":     bson.A{GRADES_COLLECTION, WORK_GRADES_COLLECTION},
+			},
+			"module":    bson.M{"bsonType": "objectId"},
+			"student":   bson.M{"bsonType": "objectId"},
+			"old_grade": bson.M{"bsonType": "double"},
+			"new_grade": bson.M{"bsonType": "double"},
+			"actor":     bson.M{"bsonType": "objectId"},
+			"source": bson.M{
+				"bsonType": "string",
+				"enum": bson.A{
+					GRADE_SOURCE_MANUAL,
+					GRADE_SOURCE_EVALUATION,
+					GRADE_SOURCE_REEVALUATION,
+					GRADE_SOURCE_CLOSE_SEMESTER,
+				},
+			},
+			"reason": bson.M{
+				"bsonType":  "string",
+				"maxLength": 300,
+			},
+			"date": bson.M{"bsonType": "date"},
+		},
+	}
+	var validators = bson.M{
+		"$jsonSchema": jsonSchema,
+	}
+	opts := &options.CreateCollectionOptions{
+		Validator: validators,
+	}
+	err = DbConnect.CreateCollection(GRADE_HISTORY_COLLECTION, opts)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func NewGradeHistoryModel() Collection {
+	if gradeHistoryModel == nil {
+		gradeHistoryModel = &GradeHistoryModel{
+			CollectionName: GRADE_HISTORY_COLLECTION,
+		}
+	}
+	return gradeHistoryModel
+}
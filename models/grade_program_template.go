@@ -0,0 +1,214 @@
+package models
+
+import (
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const GRADE_PROGRAM_TEMPLATES_COLLECTION = "grade_program_templates"
+
+var gradeProgramTemplatesModel *GradeProgramTemplatesModel
+
+type TemplateAcumulative struct {
+	Number     int     `json:"number" bson:"number" example:"1"`
+	Percentage float32 `json:"percentage" bson:"percentage" example:"20"`
+}
+
+type TemplateProgram struct {
+	Number        int                   `json:"number" bson:"number" example:"3"`
+	Percentage    float32               `json:"percentage" bson:"percentage" example:"25"`
+	IsAcumulative bool                  `json:"is_acumulative" bson:"is_acumulative"`
+	Acumulative   []TemplateAcumulative `json:"acumulative,omitempty" bson:"acumulative,omitempty" extensions:"x-omitempty"`
+}
+
+// Grade programs of the modules of a subject and/or course. The more
+// specific template takes precedence
+type GradeProgramTemplate struct {
+	ID         primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Name       string             `json:"name" bson:"name" example:"Plantilla Matemáticas"`
+	Subject    primitive.ObjectID `json:"subject,omitempty" bson:"subject,omitempty" example:"637d5de216f58bc8ec7f7f51" extensions:"x-omitempty"`
+	Course     primitive.ObjectID `json:"course,omitempty" bson:"course,omitempty" example:"637d5de216f58bc8ec7f7f51" extensions:"x-omitempty"`
+	Programs   []TemplateProgram  `json:"programs" bson:"programs"`
+	Author     primitive.ObjectID `json:"author" bson:"author" example:"637d5de216f58bc8ec7f7f51"`
+	Date       primitive.DateTime `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	UpdateDate primitive.DateTime `json:"update_date" bson:"update_date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+}
+
+type GradeProgramTemplatesModel struct {
+	CollectionName string
+}
+
+func NewModelGradeProgramTemplate(
+	template *forms.GradeProgramTemplateForm,
+	subject,
+	course,
+	author primitive.ObjectID,
+) GradeProgramTemplate {
+	now := primitive.NewDateTimeFromTime(time.Now())
+	modelTemplate := GradeProgramTemplate{
+		Name:       template.Name,
+		Subject:    subject,
+		Course:     course,
+		Author:     author,
+		Date:       now,
+		UpdateDate: now,
+	}
+	for _, program := range template.Programs {
+		templateProgram := TemplateProgram{
+			Number:        program.Number,
+			Percentage:    program.Percentage,
+			IsAcumulative: *program.IsAcumulative,
+		}
+		if *program.IsAcumulative {
+			for _, acumulative := range program.Acumulative {
+				templateProgram.Acumulative = append(templateProgram.Acumulative, TemplateAcumulative{
+					Number:     acumulative.Number,
+					Percentage: acumulative.Percentage,
+				})
+			}
+		}
+		modelTemplate.Programs = append(modelTemplate.Programs, templateProgram)
+	}
+	return modelTemplate
+}
+
+func NewModelGradesProgramFromTemplate(
+	program TemplateProgram,
+	idModule primitive.ObjectID,
+) GradesProgram {
+	modelProgram := GradesProgram{
+		Module:        idModule,
+		Number:        program.Number,
+		Percentage:    program.Percentage,
+		IsAcumulative: program.IsAcumulative,
+	}
+	for _, acumulative := range program.Acumulative {
+		modelProgram.Acumulative = append(modelProgram.Acumulative, Acumulative{
+			ID:         primitive.NewObjectID(),
+			Number:     acumulative.Number,
+			Percentage: acumulative.Percentage,
+		})
+	}
+	return modelProgram
+}
+
+func (g *GradeProgramTemplatesModel) Use() *mongo.Collection {
+	return DbConnect.GetCollection(g.CollectionName)
+}
+
+func (g *GradeProgramTemplatesModel) GetByID(id primitive.ObjectID) *mongo.SingleResult {
+	cursor := g.Use().FindOne(db.Ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: id,
+		},
+	})
+	return cursor
+}
+
+func (g *GradeProgramTemplatesModel) GetOne(filter bson.D) *mongo.SingleResult {
+	cursor := g.Use().FindOne(db.Ctx, filter)
+	return cursor
+}
+
+func (g *GradeProgramTemplatesModel) GetAll(filter bson.D, options *options.FindOptions) (*mongo.Cursor, error) {
+	cursor, err := g.Use().Find(db.Ctx, filter, options)
+	return cursor, err
+}
+
+func (g *GradeProgramTemplatesModel) Aggreagate(pipeline mongo.Pipeline) (*mongo.Cursor, error) {
+	cursor, err := g.Use().Aggregate(db.Ctx, pipeline)
+	return cursor, err
+}
+
+func (g *GradeProgramTemplatesModel) NewDocument(data interface{}) (*mongo.InsertOneResult, error) {
+	result, err := g.Use().InsertOne(db.Ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func init() {
+	collections, err := DbConnect.GetCollections()
+	if err != nil {
+		panic(err)
+	}
+	for _, collection := range collections {
+		if collection == GRADE_PROGRAM_TEMPLATES_COLLECTION {
+			return
+		}
+	}
+	var jsonSchema = bson.M{
+		"bsonType": "object",
+		"required": []string{
+			"name",
+			"programs",
+			"author",
+			"date",
+			"update_date",
+		},
+		"properties": bson.M{
+			"name":    bson.M{"bsonType": "string", "maxLength": 100},
+			"subject": bson.M{"bsonType": "objectId"},
+			"course":  bson.M{"bsonType": "objectId"},
+			"programs": bson.M{
+				"bsonType": bson.A{"array"},
+				"minItems": 1,
+				"maxItems": 30,
+				"items": bson.M{
+					"bsonType": "object",
+					"required": bson.A{
+						"number",
+						"percentage",
+						"is_acumulative",
+					},
+					"properties": bson.M{
+						"number":         bson.M{"bsonType": "int", "minimum": 1, "maximum": 30},
+						"percentage":     bson.M{"bsonType": "double", "maximum": 100},
+						"is_acumulative": bson.M{"bsonType": "bool"},
+						"acumulative": bson.M{
+							"bsonType": bson.A{"array"},
+							"items": bson.M{
+								"bsonType": "object",
+								"required": bson.A{"number", "percentage"},
+								"properties": bson.M{
+									"number":     bson.M{"bsonType": "int", "minimum": 1},
+									"percentage": bson.M{"bsonType": "double", "maximum": 100},
+								},
+							},
+						},
+					},
+				},
+			},
+			"author":      bson.M{"bsonType": "objectId"},
+			"date":        bson.M{"bsonType": "date"},
+			"update_date": bson.M{"bsonType": "date"},
+		},
+	}
+	var validators = bson.M{
+		"$jsonSchema": jsonSchema,
+	}
+	opts := &options.CreateCollectionOptions{
+		Validator: validators,
+	}
+	err = DbConnect.CreateCollection(GRADE_PROGRAM_TEMPLATES_COLLECTION, opts)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func NewGradeProgramTemplatesModel() Collection {
+	if gradeProgramTemplatesModel == nil {
+		gradeProgramTemplatesModel = &GradeProgramTemplatesModel{
+			CollectionName: GRADE_PROGRAM_TEMPLATES_COLLECTION,
+		}
+	}
+	return gradeProgramTemplatesModel
+}
@@ -41,6 +41,9 @@ type Grade struct {
 	IsAcumulative bool               `json:"is_acumulative" bson:"is_acumulative"`
 	Evaluator     primitive.ObjectID `json:"evaluator" bson:"evaluator,omitempty"`
 	Grade         float64            `json:"grade" bson:"grade"`
+	LatePenalty   float64            `json:"late_penalty,omitempty" bson:"late_penalty,omitempty"`
+	Draft         bool               `json:"draft,omitempty" bson:"draft,omitempty"`
+	ReleaseDate   primitive.DateTime `json:"release_date,omitempty" bson:"release_date,omitempty"`
 	Date          primitive.DateTime `json:"date" bson:"date"`
 }
 
@@ -52,6 +55,9 @@ type GradeWLookup struct {
 	Acumulative primitive.ObjectID `json:"acumulative,omitempty" bson:"acumulative,omitempty" example:"637d5de216f58bc8ec7f7f51" extensions:"x-omitempty"`
 	Evaluator   SimpleUser         `json:"evaluator" bson:"evaluator,omitempty" extensions:"x-omitempty"`
 	Grade       float64            `json:"grade" bson:"grade" example:"30"`
+	LatePenalty float64            `json:"late_penalty,omitempty" bson:"late_penalty,omitempty" example:"5" extensions:"x-omitempty"`
+	Draft       bool               `json:"draft,omitempty" bson:"draft,omitempty" extensions:"x-omitempty"`
+	ReleaseDate primitive.DateTime `json:"release_date,omitempty" bson:"release_date,omitempty" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00" extensions:"x-omitempty"`
 	Date        primitive.DateTime `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
 }
 
@@ -255,7 +261,10 @@ func initGrades(collections []string) error {
 			"acumulative":    bson.M{"bsonType": "objectId"},
 			"evaluator":      bson.M{"bsonType": "objectId"},
 			"grade":          bson.M{"bsonType": "double"},
+			"late_penalty":   bson.M{"bsonType": "double", "minimum": 0},
 			"is_acumulative": bson.M{"bsonType": "bool"},
+			"draft":          bson.M{"bsonType": "bool"},
+			"release_date":   bson.M{"bsonType": "date"},
 			"date":           bson.M{"bsonType": "date"},
 		},
 	}
@@ -0,0 +1,176 @@
+package models
+
+import (
+	"sort"
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const GRADING_SCALES_COLLECTION = "grading_scales"
+
+var gradingScalesModel *GradingScalesModel
+
+type GradingScaleRange struct {
+	From  float64 `json:"from" bson:"from" example:"60"`
+	Grade float64 `json:"grade" bson:"grade" example:"4"`
+}
+
+// Scale of a module or a semester, the module scale takes precedence
+type GradingScale struct {
+	ID        primitive.ObjectID  `json:"_id,omitempty" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51" extensions:"x-omitempty"`
+	Module    primitive.ObjectID  `json:"module,omitempty" bson:"module,omitempty" example:"637d5de216f58bc8ec7f7f51" extensions:"x-omitempty"`
+	Semester  primitive.ObjectID  `json:"semester,omitempty" bson:"semester,omitempty" example:"637d5de216f58bc8ec7f7f51" extensions:"x-omitempty"`
+	Type      string              `json:"type" bson:"type" enums:"linear,threshold,table" example:"threshold"`
+	Min       float64             `json:"min" bson:"min" example:"1"`
+	Max       float64             `json:"max" bson:"max" example:"7"`
+	Threshold float64             `json:"threshold,omitempty" bson:"threshold,omitempty" example:"60" extensions:"x-omitempty"`
+	PassGrade float64             `json:"pass_grade,omitempty" bson:"pass_grade,omitempty" example:"4" extensions:"x-omitempty"`
+	Table     []GradingScaleRange `json:"table,omitempty" bson:"table,omitempty" extensions:"x-omitempty"`
+	Author    primitive.ObjectID  `json:"author,omitempty" bson:"author,omitempty" example:"637d5de216f58bc8ec7f7f51" extensions:"x-omitempty"`
+	Date      primitive.DateTime  `json:"date,omitempty" bson:"date,omitempty" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00" extensions:"x-omitempty"`
+}
+
+type GradingScalesModel struct {
+	CollectionName string
+}
+
+func NewModelGradingScale(
+	scale *forms.GradingScaleForm,
+	module,
+	semester,
+	author primitive.ObjectID,
+) GradingScale {
+	modelScale := GradingScale{
+		Module:   module,
+		Semester: semester,
+		Type:     scale.Type,
+		Min:      *scale.Min,
+		Max:      *scale.Max,
+		Author:   author,
+		Date:     primitive.NewDateTimeFromTime(time.Now()),
+	}
+	if scale.Type == "threshold" {
+		modelScale.Threshold = scale.Threshold
+		modelScale.PassGrade = scale.PassGrade
+	} else if scale.Type == "table" {
+		for _, r := range scale.Table {
+			modelScale.Table = append(modelScale.Table, GradingScaleRange{
+				From:  *r.From,
+				Grade: *r.Grade,
+			})
+		}
+		sort.Slice(modelScale.Table, func(i, j int) bool {
+			return modelScale.Table[i].From < modelScale.Table[j].From
+		})
+	}
+	return modelScale
+}
+
+func (g *GradingScalesModel) Use() *mongo.Collection {
+	return DbConnect.GetCollection(g.CollectionName)
+}
+
+func (g *GradingScalesModel) GetByID(id primitive.ObjectID) *mongo.SingleResult {
+	cursor := g.Use().FindOne(db.Ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: id,
+		},
+	})
+	return cursor
+}
+
+func (g *GradingScalesModel) GetOne(filter bson.D) *mongo.SingleResult {
+	cursor := g.Use().FindOne(db.Ctx, filter)
+	return cursor
+}
+
+func (g *GradingScalesModel) GetAll(filter bson.D, options *options.FindOptions) (*mongo.Cursor, error) {
+	cursor, err := g.Use().Find(db.Ctx, filter, options)
+	return cursor, err
+}
+
+func (g *GradingScalesModel) Aggreagate(pipeline mongo.Pipeline) (*mongo.Cursor, error) {
+	cursor, err := g.Use().Aggregate(db.Ctx, pipeline)
+	return cursor, err
+}
+
+func (g *GradingScalesModel) NewDocument(data interface{}) (*mongo.InsertOneResult, error) {
+	result, err := g.Use().InsertOne(db.Ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func init() {
+	collections, err := DbConnect.GetCollections()
+	if err != nil {
+		panic(err)
+	}
+	for _, collection := range collections {
+		if collection == GRADING_SCALES_COLLECTION {
+			return
+		}
+	}
+	var jsonSchema = bson.M{
+		"bsonType": "object",
+		"required": []string{
+			"type",
+			"min",
+			"max",
+			"author",
+			"date",
+		},
+		"properties": bson.M{
+			"module":   bson.M{"bsonType": "objectId"},
+			"semester": bson.M{"bsonType": "objectId"},
+			"type": bson.M{
+				"bsonType": "string",
+				"enum":     bson.A{"linear", "threshold", "table"},
+			},
+			"min":        bson.M{"bsonType": "double"},
+			"max":        bson.M{"bsonType": "double"},
+			"threshold":  bson.M{"bsonType": "double"},
+			"pass_grade": bson.M{"bsonType": "double"},
+			"table": bson.M{
+				"bsonType": bson.A{"array"},
+				"items": bson.M{
+					"bsonType": "object",
+					"required": bson.A{"from", "grade"},
+					"properties": bson.M{
+						"from":  bson.M{"bsonType": "double"},
+						"grade": bson.M{"bsonType": "double"},
+					},
+				},
+			},
+			"author": bson.M{"bsonType": "objectId"},
+			"date":   bson.M{"bsonType": "date"},
+		},
+	}
+	var validators = bson.M{
+		"$jsonSchema": jsonSchema,
+	}
+	opts := &options.CreateCollectionOptions{
+		Validator: validators,
+	}
+	err = DbConnect.CreateCollection(GRADING_SCALES_COLLECTION, opts)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func NewGradingScalesModel() Collection {
+	if gradingScalesModel == nil {
+		gradingScalesModel = &GradingScalesModel{
+			CollectionName: GRADING_SCALES_COLLECTION,
+		}
+	}
+	return gradingScalesModel
+}
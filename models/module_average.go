@@ -0,0 +1,135 @@
+package models
+
+import (
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const MODULE_AVERAGES_COLLECTION = "module_averages"
+
+var moduleAverageModel *ModuleAverageModel
+
+// Weighted average of the student in a module, registered when the
+// semester is closed
+type ModuleAverage struct {
+	ID       primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Module   primitive.ObjectID `json:"module" bson:"module" example:"637d5de216f58bc8ec7f7f51"`
+	Semester primitive.ObjectID `json:"semester" bson:"semester" example:"637d5de216f58bc8ec7f7f51"`
+	Student  primitive.ObjectID `json:"student" bson:"student" example:"637d5de216f58bc8ec7f7f51"`
+	Average  float64            `json:"average" bson:"average" example:"5.8"`
+	Complete bool               `json:"complete" bson:"complete"`
+	Date     primitive.DateTime `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+}
+
+type ModuleAverageModel struct {
+	CollectionName string
+}
+
+func NewModelModuleAverage(
+	module,
+	semester,
+	student primitive.ObjectID,
+	average float64,
+	complete bool,
+) ModuleAverage {
+	return ModuleAverage{
+		Module:   module,
+		Semester: semester,
+		Student:  student,
+		Average:  average,
+		Complete: complete,
+		Date:     primitive.NewDateTimeFromTime(time.Now()),
+	}
+}
+
+func (m *ModuleAverageModel) Use() *mongo.Collection {
+	return DbConnect.GetCollection(m.CollectionName)
+}
+
+func (m *ModuleAverageModel) GetByID(id primitive.ObjectID) *mongo.SingleResult {
+	cursor := m.Use().FindOne(db.Ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: id,
+		},
+	})
+	return cursor
+}
+
+func (m *ModuleAverageModel) GetOne(filter bson.D) *mongo.SingleResult {
+	cursor := m.Use().FindOne(db.Ctx, filter)
+	return cursor
+}
+
+func (m *ModuleAverageModel) GetAll(filter bson.D, options *options.FindOptions) (*mongo.Cursor, error) {
+	cursor, err := m.Use().Find(db.Ctx, filter, options)
+	return cursor, err
+}
+
+func (m *ModuleAverageModel) Aggreagate(pipeline mongo.Pipeline) (*mongo.Cursor, error) {
+	cursor, err := m.Use().Aggregate(db.Ctx, pipeline)
+	return cursor, err
+}
+
+func (m *ModuleAverageModel) NewDocument(data interface{}) (*mongo.InsertOneResult, error) {
+	result, err := m.Use().InsertOne(db.Ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func init() {
+	collections, err := DbConnect.GetCollections()
+	if err != nil {
+		panic(err)
+	}
+	for _, collection := range collections {
+		if collection == MODULE_AVERAGES_COLLECTION {
+			return
+		}
+	}
+	var jsonSchema = bson.M{
+		"bsonType": "object",
+		"required": []string{
+			"module",
+			"semester",
+			"student",
+			"average",
+			"complete",
+			"date",
+		},
+		"properties": bson.M{
+			"module":   bson.M{"bsonType": "objectId"},
+			"semester": bson.M{"bsonType": "objectId"},
+			"student":  bson.M{"bsonType": "objectId"},
+			"average":  bson.M{"bsonType": "double"},
+			"complete": bson.M{"bsonType": "bool"},
+			"date":     bson.M{"bsonType": "date"},
+		},
+	}
+	var validators = bson.M{
+		"$jsonSchema": jsonSchema,
+	}
+	opts := &options.CreateCollectionOptions{
+		Validator: validators,
+	}
+	err = DbConnect.CreateCollection(MODULE_AVERAGES_COLLECTION, opts)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func NewModuleAveragesModel() Collection {
+	if moduleAverageModel == nil {
+		moduleAverageModel = &ModuleAverageModel{
+			CollectionName: MODULE_AVERAGES_COLLECTION,
+		}
+	}
+	return moduleAverageModel
+}
@@ -0,0 +1,145 @@
+package models
+
+import (
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const PEER_REVIEWS_COLLECTION = "peer_reviews"
+
+var peerReviewsModel *PeerReviewsModel
+
+// Review of the submission of a student of a files work by a classmate,
+// the reviewer doesn't know the student of the submission
+type PeerReview struct {
+	ID         primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Work       primitive.ObjectID `json:"work" bson:"work" example:"637d5de216f58bc8ec7f7f51"`
+	Submission primitive.ObjectID `json:"submission" bson:"submission" example:"637d5de216f58bc8ec7f7f51"`
+	Student    primitive.ObjectID `json:"student" bson:"student" example:"637d5de216f58bc8ec7f7f51"`
+	Reviewer   primitive.ObjectID `json:"reviewer" bson:"reviewer" example:"637d5de216f58bc8ec7f7f51"`
+	Evaluate   []EvaluatedFiles   `json:"evaluate,omitempty" bson:"evaluate,omitempty" extensions:"x-omitempty"`
+	Points     int                `json:"points" bson:"points" example:"40"`
+	Date       primitive.DateTime `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	DateReview primitive.DateTime `json:"date_review,omitempty" bson:"date_review,omitempty" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00" extensions:"x-omitempty"`
+}
+
+type PeerReviewsModel struct {
+	CollectionName string
+}
+
+func NewModelPeerReview(work, submission, student, reviewer primitive.ObjectID) PeerReview {
+	return PeerReview{
+		Work:       work,
+		Submission: submission,
+		Student:    student,
+		Reviewer:   reviewer,
+		Date:       primitive.NewDateTimeFromTime(time.Now()),
+	}
+}
+
+func (p *PeerReviewsModel) Use() *mongo.Collection {
+	return DbConnect.GetCollection(p.CollectionName)
+}
+
+func (p *PeerReviewsModel) GetByID(id primitive.ObjectID) *mongo.SingleResult {
+	cursor := p.Use().FindOne(db.Ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: id,
+		},
+	})
+	return cursor
+}
+
+func (p *PeerReviewsModel) GetOne(filter bson.D) *mongo.SingleResult {
+	cursor := p.Use().FindOne(db.Ctx, filter)
+	return cursor
+}
+
+func (p *PeerReviewsModel) GetAll(filter bson.D, options *options.FindOptions) (*mongo.Cursor, error) {
+	cursor, err := p.Use().Find(db.Ctx, filter, options)
+	return cursor, err
+}
+
+func (p *PeerReviewsModel) Aggreagate(pipeline mongo.Pipeline) (*mongo.Cursor, error) {
+	cursor, err := p.Use().Aggregate(db.Ctx, pipeline)
+	return cursor, err
+}
+
+func (p *PeerReviewsModel) NewDocument(data interface{}) (*mongo.InsertOneResult, error) {
+	result, err := p.Use().InsertOne(db.Ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func init() {
+	collections, err := DbConnect.GetCollections()
+	if err != nil {
+		panic(err)
+	}
+	for _, collection := range collections {
+		if collection == PEER_REVIEWS_COLLECTION {
+			return
+		}
+	}
+	var jsonSchema = bson.M{
+		"bsonType": "object",
+		"required": []string{
+			"work",
+			"submission",
+			"student",
+			"reviewer",
+			"points",
+			"date",
+		},
+		"properties": bson.M{
+			"work":       bson.M{"bsonType": "objectId"},
+			"submission": bson.M{"bsonType": "objectId"},
+			"student":    bson.M{"bsonType": "objectId"},
+			"reviewer":   bson.M{"bsonType": "objectId"},
+			"evaluate": bson.M{
+				"bsonType": bson.A{"array"},
+				"items": bson.M{
+					"bsonType": "object",
+					"required": bson.A{"_id", "pattern", "points"},
+					"properties": bson.M{
+						"_id":     bson.M{"bsonType": "objectId"},
+						"pattern": bson.M{"bsonType": "objectId"},
+						"points":  bson.M{"bsonType": "int", "minimum": 0},
+						"level":   bson.M{"bsonType": "objectId"},
+						"comment": bson.M{"bsonType": "string", "maxLength": 500},
+					},
+				},
+			},
+			"points":      bson.M{"bsonType": "int", "minimum": 0},
+			"date":        bson.M{"bsonType": "date"},
+			"date_review": bson.M{"bsonType": "date"},
+		},
+	}
+	var validators = bson.M{
+		"$jsonSchema": jsonSchema,
+	}
+	opts := &options.CreateCollectionOptions{
+		Validator: validators,
+	}
+	err = DbConnect.CreateCollection(PEER_REVIEWS_COLLECTION, opts)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func NewPeerReviewsModel() Collection {
+	if peerReviewsModel == nil {
+		peerReviewsModel = &PeerReviewsModel{
+			CollectionName: PEER_REVIEWS_COLLECTION,
+		}
+	}
+	return peerReviewsModel
+}
@@ -0,0 +1,173 @@
+package models
+
+import (
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const QUESTION_BANK_COLLECTION = "question_bank"
+
+var questionBankModel *QuestionBankModel
+
+type BankQuestion struct {
+	ID         primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Author     primitive.ObjectID `json:"author" bson:"author" example:"637d5de216f58bc8ec7f7f51"`
+	Subject    primitive.ObjectID `json:"subject" bson:"subject" example:"637d5de216f58bc8ec7f7f51"`
+	Tags       []string           `json:"tags" bson:"tags" example:"algebra,ecuaciones"`
+	Difficulty string             `json:"difficulty" bson:"difficulty" example:"medium" enums:"easy,medium,hard"`
+	Question   ItemQuestion       `json:"question" bson:"question"`
+	UploadDate primitive.DateTime `json:"upload_date" bson:"upload_date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	UpdateDate primitive.DateTime `json:"update_date" bson:"update_date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	Status     bool               `json:"-" bson:"status"`
+}
+
+type BankQuestionWLookup struct {
+	ID         primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Author     SimpleUser         `json:"author" bson:"author"`
+	Subject    Subject            `json:"subject" bson:"subject"`
+	Tags       []string           `json:"tags" bson:"tags" example:"algebra,ecuaciones"`
+	Difficulty string             `json:"difficulty" bson:"difficulty" example:"medium" enums:"easy,medium,hard"`
+	Question   ItemQuestion       `json:"question" bson:"question"`
+	UploadDate primitive.DateTime `json:"upload_date" bson:"upload_date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	UpdateDate primitive.DateTime `json:"update_date" bson:"update_date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+}
+
+type QuestionBankModel struct {
+	CollectionName string
+}
+
+func NewModelBankQuestion(
+	question *forms.BankQuestionForm,
+	author,
+	subject primitive.ObjectID,
+) BankQuestion {
+	now := primitive.NewDateTimeFromTime(time.Now())
+	tags := question.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return BankQuestion{
+		Author:     author,
+		Subject:    subject,
+		Tags:       tags,
+		Difficulty: question.Difficulty,
+		Question:   *NewModelsFormQuestion(&question.Question, nil, question.Question.Points),
+		UploadDate: now,
+		UpdateDate: now,
+		Status:     true,
+	}
+}
+
+func (b *QuestionBankModel) Use() *mongo.Collection {
+	return DbConnect.GetCollection(b.CollectionName)
+}
+
+func (b *QuestionBankModel) GetByID(id primitive.ObjectID) *mongo.SingleResult {
+	cursor := b.Use().FindOne(db.Ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: id,
+		},
+	})
+	return cursor
+}
+
+func (b *QuestionBankModel) GetOne(filter bson.D) *mongo.SingleResult {
+	cursor := b.Use().FindOne(db.Ctx, filter)
+	return cursor
+}
+
+func (b *QuestionBankModel) GetAll(filter bson.D, options *options.FindOptions) (*mongo.Cursor, error) {
+	cursor, err := b.Use().Find(db.Ctx, filter, options)
+	return cursor, err
+}
+
+func (b *QuestionBankModel) Aggreagate(pipeline mongo.Pipeline) (*mongo.Cursor, error) {
+	cursor, err := b.Use().Aggregate(db.Ctx, pipeline)
+	return cursor, err
+}
+
+func (b *QuestionBankModel) NewDocument(data interface{}) (*mongo.InsertOneResult, error) {
+	result, err := b.Use().InsertOne(db.Ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func init() {
+	collections, err := DbConnect.GetCollections()
+	if err != nil {
+		panic(err)
+	}
+	for _, collection := range collections {
+		if collection == QUESTION_BANK_COLLECTION {
+			return
+		}
+	}
+	var jsonSchema = bson.M{
+		"bsonType": "object",
+		"required": []string{
+			"author",
+			"subject",
+			"tags",
+			"difficulty",
+			"question",
+			"upload_date",
+			"update_date",
+			"status",
+		},
+		"properties": bson.M{
+			"author":  bson.M{"bsonType": "objectId"},
+			"subject": bson.M{"bsonType": "objectId"},
+			"tags": bson.M{
+				"bsonType": bson.A{"array"},
+				"items": bson.M{
+					"bsonType": "string",
+				},
+			},
+			"difficulty": bson.M{
+				"enum": bson.A{
+					"easy",
+					"medium",
+					"hard",
+				},
+			},
+			"question": bson.M{
+				"bsonType": "object",
+				"required": []string{
+					"type",
+					"question",
+				},
+			},
+			"upload_date": bson.M{"bsonType": "date"},
+			"update_date": bson.M{"bsonType": "date"},
+			"status":      bson.M{"bsonType": "bool"},
+		},
+	}
+	var validators = bson.M{
+		"$jsonSchema": jsonSchema,
+	}
+	opts := &options.CreateCollectionOptions{
+		Validator: validators,
+	}
+	err = DbConnect.CreateCollection(QUESTION_BANK_COLLECTION, opts)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func NewQuestionBankModel() Collection {
+	if questionBankModel == nil {
+		questionBankModel = &QuestionBankModel{
+			CollectionName: QUESTION_BANK_COLLECTION,
+		}
+	}
+	return questionBankModel
+}
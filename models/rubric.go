@@ -0,0 +1,163 @@
+package models
+
+import (
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const RUBRICS_COLLECTION = "rubrics"
+
+var rubricsModel *RubricsModel
+
+type Rubric struct {
+	ID          primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Author      primitive.ObjectID `json:"author" bson:"author" example:"637d5de216f58bc8ec7f7f51"`
+	Title       string             `json:"title" bson:"title" example:"Rúbrica de ensayo"`
+	Description string             `json:"description,omitempty" bson:"description,omitempty" example:"This is a description" extensions:"x-omitempty"`
+	Criteria    []WorkPattern      `json:"criteria" bson:"criteria"`
+	UploadDate  primitive.DateTime `json:"upload_date" bson:"upload_date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	UpdateDate  primitive.DateTime `json:"update_date" bson:"update_date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	Status      bool               `json:"-" bson:"status"`
+}
+
+type RubricsModel struct {
+	CollectionName string
+}
+
+func NewModelRubricCriteria(criteria []forms.WorkPatternFiles) []WorkPattern {
+	var pattern []WorkPattern
+	for _, item := range criteria {
+		pattern = append(pattern, NewModelWorkPattern(
+			item.Title,
+			item.Description,
+			item.Points,
+			item.Levels,
+		))
+	}
+	return pattern
+}
+
+func NewModelRubric(rubric *forms.RubricForm, author primitive.ObjectID) Rubric {
+	now := primitive.NewDateTimeFromTime(time.Now())
+	return Rubric{
+		Author:      author,
+		Title:       rubric.Title,
+		Description: rubric.Description,
+		Criteria:    NewModelRubricCriteria(rubric.Criteria),
+		UploadDate:  now,
+		UpdateDate:  now,
+		Status:      true,
+	}
+}
+
+func (r *RubricsModel) Use() *mongo.Collection {
+	return DbConnect.GetCollection(r.CollectionName)
+}
+
+func (r *RubricsModel) GetByID(id primitive.ObjectID) *mongo.SingleResult {
+	cursor := r.Use().FindOne(db.Ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: id,
+		},
+	})
+	return cursor
+}
+
+func (r *RubricsModel) GetOne(filter bson.D) *mongo.SingleResult {
+	cursor := r.Use().FindOne(db.Ctx, filter)
+	return cursor
+}
+
+func (r *RubricsModel) GetAll(filter bson.D, options *options.FindOptions) (*mongo.Cursor, error) {
+	cursor, err := r.Use().Find(db.Ctx, filter, options)
+	return cursor, err
+}
+
+func (r *RubricsModel) Aggreagate(pipeline mongo.Pipeline) (*mongo.Cursor, error) {
+	cursor, err := r.Use().Aggregate(db.Ctx, pipeline)
+	return cursor, err
+}
+
+func (r *RubricsModel) NewDocument(data interface{}) (*mongo.InsertOneResult, error) {
+	result, err := r.Use().InsertOne(db.Ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func init() {
+	collections, err := DbConnect.GetCollections()
+	if err != nil {
+		panic(err)
+	}
+	for _, collection := range collections {
+		if collection == RUBRICS_COLLECTION {
+			return
+		}
+	}
+	var jsonSchema = bson.M{
+		"bsonType": "object",
+		"required": []string{
+			"author",
+			"title",
+			"criteria",
+			"upload_date",
+			"update_date",
+			"status",
+		},
+		"properties": bson.M{
+			"author": bson.M{"bsonType": "objectId"},
+			"title": bson.M{
+				"bsonType":  "string",
+				"maxLength": 100,
+			},
+			"description": bson.M{
+				"bsonType":  "string",
+				"maxLength": 300,
+			},
+			"criteria": bson.M{
+				"bsonType": bson.A{"array"},
+				"minItems": 1,
+				"items": bson.M{
+					"bsonType": "object",
+					"required": bson.A{
+						"_id",
+						"title",
+						"description",
+						"points",
+					},
+				},
+			},
+			"upload_date": bson.M{"bsonType": "date"},
+			"update_date": bson.M{"bsonType": "date"},
+			"status":      bson.M{"bsonType": "bool"},
+		},
+	}
+	var validators = bson.M{
+		"$jsonSchema": jsonSchema,
+	}
+	opts := &options.CreateCollectionOptions{
+		Validator: validators,
+	}
+	err = DbConnect.CreateCollection(RUBRICS_COLLECTION, opts)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func NewRubricsModel() Collection {
+	if rubricsModel == nil {
+		rubricsModel = &RubricsModel{
+			CollectionName: RUBRICS_COLLECTION,
+		}
+	}
+	return rubricsModel
+}
@@ -0,0 +1,180 @@
+package models
+
+import (
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const SEMESTER_CLOSES_COLLECTION = "semester_closes"
+
+// Status of the close of a semester and of each module
+const (
+	CLOSE_PENDING   = "pending"
+	CLOSE_RUNNING   = "running"
+	CLOSE_COMPLETED = "completed"
+	CLOSE_FAILED    = "failed"
+)
+
+var semesterClosesModel *SemesterClosesModel
+
+type SemesterCloseModule struct {
+	Module primitive.ObjectID `json:"module" bson:"module" example:"637d5de216f58bc8ec7f7f51"`
+	Status string             `json:"status" bson:"status" enums:"pending,completed,failed" example:"completed"`
+	Filled int                `json:"filled" bson:"filled" example:"12"`
+	Error  string             `json:"error,omitempty" bson:"error,omitempty" example:"no se pudo obtener los estudiantes" extensions:"x-omitempty"`
+	Date   primitive.DateTime `json:"date,omitempty" bson:"date,omitempty" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00" extensions:"x-omitempty"`
+}
+
+// Job of the close of a semester, one per semester. A failed or
+// interrupted close is resumed from the modules not completed
+type SemesterClose struct {
+	ID         primitive.ObjectID    `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Semester   primitive.ObjectID    `json:"semester" bson:"semester" example:"637d5de216f58bc8ec7f7f51"`
+	Status     string                `json:"status" bson:"status" enums:"running,completed,failed" example:"running"`
+	Modules    []SemesterCloseModule `json:"modules" bson:"modules"`
+	Averages   int                   `json:"averages" bson:"averages" example:"120"`
+	Error      string                `json:"error,omitempty" bson:"error,omitempty" example:"no se pudo calcular los promedios" extensions:"x-omitempty"`
+	Attempts   int                   `json:"attempts" bson:"attempts" example:"1"`
+	DateStart  primitive.DateTime    `json:"date_start" bson:"date_start" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	DateUpdate primitive.DateTime    `json:"date_update" bson:"date_update" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	DateFinish primitive.DateTime    `json:"date_finish,omitempty" bson:"date_finish,omitempty" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00" extensions:"x-omitempty"`
+}
+
+type SemesterClosesModel struct {
+	CollectionName string
+}
+
+func NewModelSemesterClose(semester primitive.ObjectID, modules []Module) SemesterClose {
+	now := primitive.NewDateTimeFromTime(time.Now())
+	closeModules := make([]SemesterCloseModule, len(modules))
+	for i, module := range modules {
+		closeModules[i] = SemesterCloseModule{
+			Module: module.ID,
+			Status: CLOSE_PENDING,
+		}
+	}
+	return SemesterClose{
+		Semester:   semester,
+		Status:     CLOSE_RUNNING,
+		Modules:    closeModules,
+		Attempts:   1,
+		DateStart:  now,
+		DateUpdate: now,
+	}
+}
+
+func (s *SemesterClosesModel) Use() *mongo.Collection {
+	return DbConnect.GetCollection(s.CollectionName)
+}
+
+func (s *SemesterClosesModel) GetByID(id primitive.ObjectID) *mongo.SingleResult {
+	cursor := s.Use().FindOne(db.Ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: id,
+		},
+	})
+	return cursor
+}
+
+func (s *SemesterClosesModel) GetOne(filter bson.D) *mongo.SingleResult {
+	cursor := s.Use().FindOne(db.Ctx, filter)
+	return cursor
+}
+
+func (s *SemesterClosesModel) GetAll(filter bson.D, options *options.FindOptions) (*mongo.Cursor, error) {
+	cursor, err := s.Use().Find(db.Ctx, filter, options)
+	return cursor, err
+}
+
+func (s *SemesterClosesModel) Aggreagate(pipeline mongo.Pipeline) (*mongo.Cursor, error) {
+	cursor, err := s.Use().Aggregate(db.Ctx, pipeline)
+	return cursor, err
+}
+
+func (s *SemesterClosesModel) NewDocument(data interface{}) (*mongo.InsertOneResult, error) {
+	result, err := s.Use().InsertOne(db.Ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func init() {
+	collections, err := DbConnect.GetCollections()
+	if err != nil {
+		panic(err)
+	}
+	for _, collection := range collections {
+		if collection == SEMESTER_CLOSES_COLLECTION {
+			return
+		}
+	}
+	var jsonSchema = bson.M{
+		"bsonType": "object",
+		"required": []string{
+			"semester",
+			"status",
+			"modules",
+			"attempts",
+			"date_start",
+			"date_update",
+		},
+		"properties": bson.M{
+			"semester": bson.M{"bsonType": "objectId"},
+			"status": bson.M{
+				"enum": bson.A{CLOSE_RUNNING, CLOSE_COMPLETED, CLOSE_FAILED},
+			},
+			"modules": bson.M{
+				"bsonType": bson.A{"array"},
+				"items": bson.M{
+					"bsonType": "object",
+					"required": bson.A{
+						"module",
+						"status",
+						"filled",
+					},
+					"properties": bson.M{
+						"module": bson.M{"bsonType": "objectId"},
+						"status": bson.M{
+							"enum": bson.A{CLOSE_PENDING, CLOSE_COMPLETED, CLOSE_FAILED},
+						},
+						"filled": bson.M{"bsonType": "int", "minimum": 0},
+						"error":  bson.M{"bsonType": "string"},
+						"date":   bson.M{"bsonType": "date"},
+					},
+				},
+			},
+			"averages":    bson.M{"bsonType": "int", "minimum": 0},
+			"error":       bson.M{"bsonType": "string"},
+			"attempts":    bson.M{"bsonType": "int", "minimum": 1},
+			"date_start":  bson.M{"bsonType": "date"},
+			"date_update": bson.M{"bsonType": "date"},
+			"date_finish": bson.M{"bsonType": "date"},
+		},
+	}
+	var validators = bson.M{
+		"$jsonSchema": jsonSchema,
+	}
+	opts := &options.CreateCollectionOptions{
+		Validator: validators,
+	}
+	err = DbConnect.CreateCollection(SEMESTER_CLOSES_COLLECTION, opts)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func NewSemesterClosesModel() Collection {
+	if semesterClosesModel == nil {
+		semesterClosesModel = &SemesterClosesModel{
+			CollectionName: SEMESTER_CLOSES_COLLECTION,
+		}
+	}
+	return semesterClosesModel
+}
@@ -22,11 +22,57 @@ type WorkSession struct {
 	Dates []primitive.DateTime `json:"dates" bson:"dates"`
 }
 
+type WorkPatternLevel struct {
+	ID          primitive.ObjectID `json:"_id" bson:"_id" example:"637d5de216f58bc8ec7f7f51"`
+	Title       string             `json:"title" bson:"title" example:"Logrado"`
+	Description string             `json:"description" bson:"description" example:"Explica el fenómeno con ejemplos"`
+	Points      int                `json:"points" bson:"points" example:"25"`
+}
+
 type WorkPattern struct {
 	ID          primitive.ObjectID `json:"_id" bson:"_id" example:"637d5de216f58bc8ec7f7f51"`
 	Title       string             `json:"title" bson:"title" example:"Pattern!"`
 	Description string             `json:"description" bson:"description" example:"This is a description"`
 	Points      int                `json:"points" bson:"points" example:"25"`
+	Levels      []WorkPatternLevel `json:"levels,omitempty" bson:"levels,omitempty" extensions:"x-omitempty"`
+}
+
+type WorkLatePolicy struct {
+	Window  int     `json:"window" bson:"window" example:"48"`
+	Unit    string  `json:"unit" bson:"unit" example:"day" enums:"day,hour"`
+	Penalty float64 `json:"penalty" bson:"penalty" example:"5"`
+	Cap     float64 `json:"cap" bson:"cap" example:"20"`
+}
+
+type WorkFormDraw struct {
+	Item     primitive.ObjectID `json:"item" bson:"item" example:"637d5de216f58bc8ec7f7f51"`
+	Quantity int                `json:"quantity" bson:"quantity" example:"5"`
+}
+
+type WorkFormShuffle struct {
+	Items     bool           `json:"items" bson:"items"`
+	Questions bool           `json:"questions" bson:"questions"`
+	Answers   bool           `json:"answers" bson:"answers"`
+	Draws     []WorkFormDraw `json:"draws,omitempty" bson:"draws,omitempty" extensions:"x-omitempty"`
+}
+
+type WorkAttempts struct {
+	Quantity int    `json:"quantity" bson:"quantity" example:"3"`
+	Scoring  string `json:"scoring" bson:"scoring" example:"best" enums:"best,last,average"`
+}
+
+// Assigned is true when the submissions were assigned to the reviewers
+type WorkPeerReview struct {
+	Reviewers int                `json:"reviewers" bson:"reviewers" example:"2"`
+	DateLimit primitive.DateTime `json:"date_limit" bson:"date_limit" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	Assigned  bool               `json:"assigned" bson:"assigned"`
+}
+
+// Mode teacher: the teacher forms the groups, self: the students form them
+type WorkGroups struct {
+	Mode    string `json:"mode" bson:"mode" example:"self" enums:"teacher,self"`
+	MinSize int    `json:"min_size" bson:"min_size" example:"2"`
+	MaxSize int    `json:"max_size" bson:"max_size" example:"4"`
 }
 
 // Mongodb
@@ -46,12 +92,21 @@ type Work struct {
 	DateLimit      primitive.DateTime `json:"date_limit" bson:"date_limit" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
 	FormAccess     string             `json:"form_access,omitempty" bson:"form_access,omitempty" example:"default" enums:"default,wtime" extensions:"x-omitempty"`
 	TimeFormAccess int                `json:"time_access,omitempty" bson:"time_access,omitempty" example:"2" extensions:"x-omitempty"`
-	IsRevised      bool               `json:"is_revised" bson:"is_revised"`
-	Virtual        bool               `json:"virtual" bson:"virtual"`
-	Sessions       []WorkSession      `json:"sessions" bson:"sessions,omitempty"`
-	Attached       []Attached         `json:"attached,omitempty" bson:"attached,omitempty" extensions:"x-omitempty"`
-	DateUpload     primitive.DateTime `json:"date_upload" bson:"date_upload" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
-	DateUpdate     primitive.DateTime `json:"date_update" bson:"date_update" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	LatePolicy     *WorkLatePolicy    `json:"late_policy,omitempty" bson:"late_policy,omitempty" extensions:"x-omitempty"`
+	FormShuffle    *WorkFormShuffle   `json:"form_shuffle,omitempty" bson:"form_shuffle,omitempty" extensions:"x-omitempty"`
+	Attempts       *WorkAttempts      `json:"attempts,omitempty" bson:"attempts,omitempty" extensions:"x-omitempty"`
+	PeerReview     *WorkPeerReview    `json:"peer_review,omitempty" bson:"peer_review,omitempty" extensions:"x-omitempty"`
+	Groups         *WorkGroups        `json:"groups,omitempty" bson:"groups,omitempty" extensions:"x-omitempty"`
+	// Questions out of the grade after the item analysis
+	RemovedQuestions []primitive.ObjectID `json:"removed_questions,omitempty" bson:"removed_questions,omitempty" example:"637d5de216f58bc8ec7f7f51" extensions:"x-omitempty"`
+	IsRevised        bool                 `json:"is_revised" bson:"is_revised"`
+	Virtual          bool                 `json:"virtual" bson:"virtual"`
+	Draft            bool                 `json:"draft,omitempty" bson:"draft,omitempty" extensions:"x-omitempty"`
+	DatePublish      primitive.DateTime   `json:"date_publish,omitempty" bson:"date_publish,omitempty" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00" extensions:"x-omitempty"`
+	Sessions         []WorkSession        `json:"sessions" bson:"sessions,omitempty"`
+	Attached         []Attached           `json:"attached,omitempty" bson:"attached,omitempty" extensions:"x-omitempty"`
+	DateUpload       primitive.DateTime   `json:"date_upload" bson:"date_upload" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	DateUpdate       primitive.DateTime   `json:"date_update" bson:"date_update" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
 }
 
 type WorkWLookup struct {
@@ -74,6 +129,13 @@ type WorkWLookup struct {
 	Sessions       []WorkSession             `json:"sessions" bson:"sessions,omitempty"`
 	Blocks         []RegisteredCalendarBlock `json:"blocks,omitempty" bson:"blocks,omitempty"`
 	TimeFormAccess int                       `json:"time_access,omitempty" bson:"time_access,omitempty" example:"2" extensions:"x-omitempty"`
+	LatePolicy     *WorkLatePolicy           `json:"late_policy,omitempty" bson:"late_policy,omitempty" extensions:"x-omitempty"`
+	FormShuffle    *WorkFormShuffle          `json:"form_shuffle,omitempty" bson:"form_shuffle,omitempty" extensions:"x-omitempty"`
+	Attempts       *WorkAttempts             `json:"attempts,omitempty" bson:"attempts,omitempty" extensions:"x-omitempty"`
+	PeerReview     *WorkPeerReview           `json:"peer_review,omitempty" bson:"peer_review,omitempty" extensions:"x-omitempty"`
+	Groups         *WorkGroups               `json:"groups,omitempty" bson:"groups,omitempty" extensions:"x-omitempty"`
+	Draft          bool                      `json:"draft,omitempty" bson:"draft,omitempty" extensions:"x-omitempty"`
+	DatePublish    primitive.DateTime        `json:"date_publish,omitempty" bson:"date_publish,omitempty" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00" extensions:"x-omitempty"`
 	Attached       []Attached                `json:"attached,omitempty" bson:"attached,omitempty"`
 	DateUpload     primitive.DateTime        `json:"date_upload" bson:"date_upload" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
 	DateUpdate     primitive.DateTime        `json:"date_update" bson:"date_update" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
@@ -104,6 +166,13 @@ type WorkWLookupNFiles struct {
 	IsRevised      bool                      `json:"is_revised" bson:"is_revised"`
 	FormAccess     string                    `json:"form_access,omitempty" bson:"form_access,omitempty" example:"default" extensions:"x-omitempty" enums:"default,wtime"`
 	TimeFormAccess int                       `json:"time_access,omitempty" bson:"time_access,omitempty" extensions:"x-omitempty"`
+	LatePolicy     *WorkLatePolicy           `json:"late_policy,omitempty" bson:"late_policy,omitempty" extensions:"x-omitempty"`
+	FormShuffle    *WorkFormShuffle          `json:"form_shuffle,omitempty" bson:"form_shuffle,omitempty" extensions:"x-omitempty"`
+	Attempts       *WorkAttempts             `json:"attempts,omitempty" bson:"attempts,omitempty" extensions:"x-omitempty"`
+	PeerReview     *WorkPeerReview           `json:"peer_review,omitempty" bson:"peer_review,omitempty" extensions:"x-omitempty"`
+	Groups         *WorkGroups               `json:"groups,omitempty" bson:"groups,omitempty" extensions:"x-omitempty"`
+	Draft          bool                      `json:"draft,omitempty" bson:"draft,omitempty" extensions:"x-omitempty"`
+	DatePublish    primitive.DateTime        `json:"date_publish,omitempty" bson:"date_publish,omitempty" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00" extensions:"x-omitempty"`
 	Virtual        bool                      `json:"virtual" bson:"virtual"`
 	Sessions       []WorkSession             `json:"sessions" bson:"sessions,omitempty"`
 	Blocks         []RegisteredCalendarBlock `json:"blocks" bson:"blocks,omitempty"`
@@ -165,19 +234,32 @@ func NewModelWork(
 		modelWork.Form = idObjForm
 		modelWork.FormAccess = work.FormAccess
 		modelWork.TimeFormAccess = work.TimeFormAccess
+		if work.FormShuffle != nil {
+			modelWork.FormShuffle = NewModelWorkFormShuffle(work.FormShuffle)
+		}
+		if work.Attempts != nil {
+			modelWork.Attempts = NewModelWorkAttempts(work.Attempts)
+		}
 	}
 	if work.Type == "files" {
 		var pattern []WorkPattern
 		for _, item := range work.Pattern {
-			pattern = append(pattern, WorkPattern{
-				ID:          primitive.NewObjectID(),
-				Title:       item.Title,
-				Description: item.Description,
-				Points:      item.Points,
-			})
+			pattern = append(pattern, NewModelWorkPattern(
+				item.Title,
+				item.Description,
+				item.Points,
+				item.Levels,
+			))
 		}
 
 		modelWork.Pattern = pattern
+		if work.PeerReview != nil {
+			peerReview, err := NewModelWorkPeerReview(work.PeerReview)
+			if err != nil {
+				return nil, err
+			}
+			modelWork.PeerReview = peerReview
+		}
 	}
 	if work.Type == "in-person" {
 		var sessions []WorkSession
@@ -205,6 +287,14 @@ func NewModelWork(
 
 		modelWork.Sessions = sessions
 	}
+	// Groups
+	if work.Groups != nil {
+		modelWork.Groups = NewModelWorkGroups(work.Groups)
+	}
+	// Late policy
+	if work.LatePolicy != nil {
+		modelWork.LatePolicy = NewModelWorkLatePolicy(work.LatePolicy)
+	}
 	// Attached
 	if len(work.Attached) > 0 {
 		var attacheds []Attached
@@ -230,6 +320,87 @@ func NewModelWork(
 	return modelWork, nil
 }
 
+func NewModelWorkLatePolicy(latePolicy *forms.WorkLatePolicy) *WorkLatePolicy {
+	return &WorkLatePolicy{
+		Window:  latePolicy.Window,
+		Unit:    latePolicy.Unit,
+		Penalty: *latePolicy.Penalty,
+		Cap:     *latePolicy.Cap,
+	}
+}
+
+func NewModelWorkFormShuffle(formShuffle *forms.WorkFormShuffle) *WorkFormShuffle {
+	modelShuffle := &WorkFormShuffle{
+		Items:     formShuffle.Items,
+		Questions: formShuffle.Questions,
+		Answers:   formShuffle.Answers,
+	}
+	for _, draw := range formShuffle.Draws {
+		idObjItem, _ := primitive.ObjectIDFromHex(draw.Item)
+		modelShuffle.Draws = append(modelShuffle.Draws, WorkFormDraw{
+			Item:     idObjItem,
+			Quantity: draw.Quantity,
+		})
+	}
+	return modelShuffle
+}
+
+// Points of the item are the points of the highest level if it has levels
+func NewModelWorkPattern(
+	title,
+	description string,
+	points int,
+	levels []forms.WorkPatternLevel,
+) WorkPattern {
+	pattern := WorkPattern{
+		ID:          primitive.NewObjectID(),
+		Title:       title,
+		Description: description,
+		Points:      points,
+	}
+	if len(levels) > 0 {
+		pattern.Points = 0
+		for _, level := range levels {
+			pattern.Levels = append(pattern.Levels, WorkPatternLevel{
+				ID:          primitive.NewObjectID(),
+				Title:       level.Title,
+				Description: level.Description,
+				Points:      *level.Points,
+			})
+			if *level.Points > pattern.Points {
+				pattern.Points = *level.Points
+			}
+		}
+	}
+	return pattern
+}
+
+func NewModelWorkPeerReview(peerReview *forms.WorkPeerReview) (*WorkPeerReview, error) {
+	dateLimit, err := time.Parse("2006-01-02 15:04", peerReview.DateLimit)
+	if err != nil {
+		return nil, err
+	}
+	return &WorkPeerReview{
+		Reviewers: peerReview.Reviewers,
+		DateLimit: primitive.NewDateTimeFromTime(dateLimit),
+	}, nil
+}
+
+func NewModelWorkGroups(groups *forms.WorkGroups) *WorkGroups {
+	return &WorkGroups{
+		Mode:    groups.Mode,
+		MinSize: groups.MinSize,
+		MaxSize: groups.MaxSize,
+	}
+}
+
+func NewModelWorkAttempts(attempts *forms.WorkAttempts) *WorkAttempts {
+	return &WorkAttempts{
+		Quantity: attempts.Quantity,
+		Scoring:  attempts.Scoring,
+	}
+}
+
 func (work *WorkModel) Use() *mongo.Collection {
 	return DbConnect.GetCollection(work.CollectionName)
 }
@@ -308,6 +479,8 @@ func init() {
 			"date_upload":  bson.M{"bsonType": "date"},
 			"date_update":  bson.M{"bsonType": "date"},
 			"virtual":      bson.M{"bsonType": "bool"},
+			"draft":        bson.M{"bsonType": "bool"},
+			"date_publish": bson.M{"bsonType": "date"},
 			"sessions": bson.M{
 				"bsonType": bson.A{"array"},
 				"items": bson.M{
@@ -329,8 +502,88 @@ func init() {
 					},
 				},
 			},
+			"removed_questions": bson.M{
+				"bsonType": bson.A{"array"},
+				"items": bson.M{
+					"bsonType": "objectId",
+				},
+			},
 			"form_access": bson.M{"enum": bson.A{"default", "wtime"}},
 			"time_access": bson.M{"bsonType": "int", "minimum": 1},
+			"late_policy": bson.M{
+				"bsonType": "object",
+				"required": bson.A{
+					"window",
+					"unit",
+					"penalty",
+					"cap",
+				},
+				"properties": bson.M{
+					"window":  bson.M{"bsonType": "int", "minimum": 1},
+					"unit":    bson.M{"enum": bson.A{"day", "hour"}},
+					"penalty": bson.M{"bsonType": "double", "minimum": 0},
+					"cap":     bson.M{"bsonType": "double", "minimum": 0},
+				},
+			},
+			"attempts": bson.M{
+				"bsonType": "object",
+				"required": bson.A{
+					"quantity",
+					"scoring",
+				},
+				"properties": bson.M{
+					"quantity": bson.M{"bsonType": "int", "minimum": 1},
+					"scoring":  bson.M{"enum": bson.A{"best", "last", "average"}},
+				},
+			},
+			"peer_review": bson.M{
+				"bsonType": "object",
+				"required": bson.A{
+					"reviewers",
+					"date_limit",
+					"assigned",
+				},
+				"properties": bson.M{
+					"reviewers":  bson.M{"bsonType": "int", "minimum": 1},
+					"date_limit": bson.M{"bsonType": "date"},
+					"assigned":   bson.M{"bsonType": "bool"},
+				},
+			},
+			"groups": bson.M{
+				"bsonType": "object",
+				"required": bson.A{
+					"mode",
+					"min_size",
+					"max_size",
+				},
+				"properties": bson.M{
+					"mode":     bson.M{"enum": bson.A{"teacher", "self"}},
+					"min_size": bson.M{"bsonType": "int", "minimum": 1},
+					"max_size": bson.M{"bsonType": "int", "minimum": 1},
+				},
+			},
+			"form_shuffle": bson.M{
+				"bsonType": "object",
+				"properties": bson.M{
+					"items":     bson.M{"bsonType": "bool"},
+					"questions": bson.M{"bsonType": "bool"},
+					"answers":   bson.M{"bsonType": "bool"},
+					"draws": bson.M{
+						"bsonType": bson.A{"array"},
+						"items": bson.M{
+							"bsonType": "object",
+							"required": bson.A{
+								"item",
+								"quantity",
+							},
+							"properties": bson.M{
+								"item":     bson.M{"bsonType": "objectId"},
+								"quantity": bson.M{"bsonType": "int", "minimum": 1},
+							},
+						},
+					},
+				},
+			},
 			"pattern": bson.M{
 				"bsonType": bson.A{"array"},
 				"items": bson.M{
@@ -353,6 +606,32 @@ func init() {
 							"bsonType": "int",
 							"minimum":  1,
 						},
+						"levels": bson.M{
+							"bsonType": bson.A{"array"},
+							"items": bson.M{
+								"bsonType": "object",
+								"required": bson.A{
+									"_id",
+									"title",
+									"points",
+								},
+								"properties": bson.M{
+									"_id": bson.M{"bsonType": "objectId"},
+									"title": bson.M{
+										"bsonType":  "string",
+										"maxLength": 100,
+									},
+									"description": bson.M{
+										"bsonType":  "string",
+										"maxLength": 300,
+									},
+									"points": bson.M{
+										"bsonType": "int",
+										"minimum":  0,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
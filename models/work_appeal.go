@@ -0,0 +1,171 @@
+package models
+
+import (
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const WORK_APPEALS_COLLECTION = "work_appeals"
+
+// Status of an appeal, an accepted appeal is resolved with the
+// re-evaluation of the student
+const (
+	APPEAL_PENDING  = "pending"
+	APPEAL_ACCEPTED = "accepted"
+	APPEAL_REJECTED = "rejected"
+	APPEAL_RESOLVED = "resolved"
+)
+
+var workAppealsModel *WorkAppealsModel
+
+// Request of a student or attorney to review the grade of a work
+type WorkAppeal struct {
+	ID            primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Work          primitive.ObjectID `json:"work" bson:"work" example:"637d5de216f58bc8ec7f7f51"`
+	Student       primitive.ObjectID `json:"student" bson:"student" example:"637d5de216f58bc8ec7f7f51"`
+	Author        primitive.ObjectID `json:"author" bson:"author" example:"637d5de216f58bc8ec7f7f51"`
+	Justification string             `json:"justification" bson:"justification" example:"La pregunta 3 está bien desarrollada"`
+	Grade         float64            `json:"grade" bson:"grade" example:"4.5"`
+	Status        string             `json:"status" bson:"status" enums:"pending,accepted,rejected,resolved" example:"pending"`
+	Teacher       primitive.ObjectID `json:"teacher,omitempty" bson:"teacher,omitempty" example:"637d5de216f58bc8ec7f7f51" extensions:"x-omitempty"`
+	Reason        string             `json:"reason,omitempty" bson:"reason,omitempty" example:"La pauta no considera ese desarrollo" extensions:"x-omitempty"`
+	NewGrade      *float64           `json:"new_grade,omitempty" bson:"new_grade,omitempty" example:"5.1" extensions:"x-omitempty"`
+	Date          primitive.DateTime `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	AnswerDate    primitive.DateTime `json:"answer_date,omitempty" bson:"answer_date,omitempty" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00" extensions:"x-omitempty"`
+	ResolvedDate  primitive.DateTime `json:"resolved_date,omitempty" bson:"resolved_date,omitempty" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00" extensions:"x-omitempty"`
+}
+
+type WorkAppealsModel struct {
+	CollectionName string
+}
+
+func NewModelWorkAppeal(
+	work,
+	student,
+	author primitive.ObjectID,
+	justification string,
+	grade float64,
+) WorkAppeal {
+	return WorkAppeal{
+		Work:          work,
+		Student:       student,
+		Author:        author,
+		Justification: justification,
+		Grade:         grade,
+		Status:        APPEAL_PENDING,
+		Date:          primitive.NewDateTimeFromTime(time.Now()),
+	}
+}
+
+func (a *WorkAppealsModel) Use() *mongo.Collection {
+	return DbConnect.GetCollection(a.CollectionName)
+}
+
+func (a *WorkAppealsModel) GetByID(id primitive.ObjectID) *mongo.SingleResult {
+	cursor := a.Use().FindOne(db.Ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: id,
+		},
+	})
+	return cursor
+}
+
+func (a *WorkAppealsModel) GetOne(filter bson.D) *mongo.SingleResult {
+	cursor := a.Use().FindOne(db.Ctx, filter)
+	return cursor
+}
+
+func (a *WorkAppealsModel) GetAll(filter bson.D, options *options.FindOptions) (*mongo.Cursor, error) {
+	cursor, err := a.Use().Find(db.Ctx, filter, options)
+	return cursor, err
+}
+
+func (a *WorkAppealsModel) Aggreagate(pipeline mongo.Pipeline) (*mongo.Cursor, error) {
+	cursor, err := a.Use().Aggregate(db.Ctx, pipeline)
+	return cursor, err
+}
+
+func (a *WorkAppealsModel) NewDocument(data interface{}) (*mongo.InsertOneResult, error) {
+	result, err := a.Use().InsertOne(db.Ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func init() {
+	collections, err := DbConnect.GetCollections()
+	if err != nil {
+		panic(err)
+	}
+	for _, collection := range collections {
+		if collection == WORK_APPEALS_COLLECTION {
+			return
+		}
+	}
+	var jsonSchema = bson.M{
+		"bsonType": "object",
+		"required": []string{
+			"work",
+			"student",
+			"author",
+			"justification",
+			"grade",
+			"status",
+			"date",
+		},
+		"properties": bson.M{
+			"work":    bson.M{"bsonType": "objectId"},
+			"student": bson.M{"bsonType": "objectId"},
+			"author":  bson.M{"bsonType": "objectId"},
+			"justification": bson.M{
+				"bsonType":  "string",
+				"maxLength": 1000,
+			},
+			"grade": bson.M{"bsonType": "double"},
+			"status": bson.M{
+				"bsonType": "string",
+				"enum": bson.A{
+					APPEAL_PENDING,
+					APPEAL_ACCEPTED,
+					APPEAL_REJECTED,
+					APPEAL_RESOLVED,
+				},
+			},
+			"teacher": bson.M{"bsonType": "objectId"},
+			"reason": bson.M{
+				"bsonType":  "string",
+				"maxLength": 500,
+			},
+			"new_grade":     bson.M{"bsonType": "double"},
+			"date":          bson.M{"bsonType": "date"},
+			"answer_date":   bson.M{"bsonType": "date"},
+			"resolved_date": bson.M{"bsonType": "date"},
+		},
+	}
+	var validators = bson.M{
+		"$jsonSchema": jsonSchema,
+	}
+	opts := &options.CreateCollectionOptions{
+		Validator: validators,
+	}
+	err = DbConnect.CreateCollection(WORK_APPEALS_COLLECTION, opts)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func NewWorkAppealsModel() Collection {
+	if workAppealsModel == nil {
+		workAppealsModel = &WorkAppealsModel{
+			CollectionName: WORK_APPEALS_COLLECTION,
+		}
+	}
+	return workAppealsModel
+}
@@ -0,0 +1,174 @@
+package models
+
+import (
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const WORK_EXTENSIONS_COLLECTION = "work_extensions"
+
+var workExtensionsModel *WorkExtensionsModel
+
+type WorkExtension struct {
+	ID             primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Work           primitive.ObjectID `json:"work" bson:"work" example:"637d5de216f58bc8ec7f7f51"`
+	Student        primitive.ObjectID `json:"student" bson:"student" example:"637d5de216f58bc8ec7f7f51"`
+	Author         primitive.ObjectID `json:"author" bson:"author" example:"637d5de216f58bc8ec7f7f51"`
+	DateLimit      primitive.DateTime `json:"date_limit,omitempty" bson:"date_limit,omitempty" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00" extensions:"x-omitempty"`
+	TimeFormAccess int                `json:"time_access,omitempty" bson:"time_access,omitempty" example:"3600" extensions:"x-omitempty"`
+	Reason         string             `json:"reason,omitempty" bson:"reason,omitempty" example:"Licencia médica" extensions:"x-omitempty"`
+	Date           primitive.DateTime `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+}
+
+type WorkExtensionWLookup struct {
+	ID             primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Work           primitive.ObjectID `json:"work" bson:"work" example:"637d5de216f58bc8ec7f7f51"`
+	Student        SimpleUser         `json:"student" bson:"student"`
+	Author         SimpleUser         `json:"author" bson:"author"`
+	DateLimit      primitive.DateTime `json:"date_limit,omitempty" bson:"date_limit,omitempty" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00" extensions:"x-omitempty"`
+	TimeFormAccess int                `json:"time_access,omitempty" bson:"time_access,omitempty" example:"3600" extensions:"x-omitempty"`
+	Reason         string             `json:"reason,omitempty" bson:"reason,omitempty" example:"Licencia médica" extensions:"x-omitempty"`
+	Date           primitive.DateTime `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+}
+
+type WorkExtensionsModel struct {
+	CollectionName string
+}
+
+func NewModelWorkExtension(
+	work,
+	student,
+	author primitive.ObjectID,
+	dateLimit time.Time,
+	timeFormAccess int,
+	reason string,
+) WorkExtension {
+	modelExtension := WorkExtension{
+		Work:           work,
+		Student:        student,
+		Author:         author,
+		TimeFormAccess: timeFormAccess,
+		Reason:         reason,
+		Date:           primitive.NewDateTimeFromTime(time.Now()),
+	}
+	if !dateLimit.IsZero() {
+		modelExtension.DateLimit = primitive.NewDateTimeFromTime(dateLimit)
+	}
+	return modelExtension
+}
+
+func (e *WorkExtensionsModel) Use() *mongo.Collection {
+	return DbConnect.GetCollection(e.CollectionName)
+}
+
+func (e *WorkExtensionsModel) GetByID(id primitive.ObjectID) *mongo.SingleResult {
+	cursor := e.Use().FindOne(db.Ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: id,
+		},
+	})
+	return cursor
+}
+
+func (e *WorkExtensionsModel) GetOne(filter bson.D) *mongo.SingleResult {
+	cursor := e.Use().FindOne(db.Ctx, filter)
+	return cursor
+}
+
+func (e *WorkExtensionsModel) GetAll(filter bson.D, options *options.FindOptions) (*mongo.Cursor, error) {
+	cursor, err := e.Use().Find(db.Ctx, filter, options)
+	return cursor, err
+}
+
+func (e *WorkExtensionsModel) Aggreagate(pipeline mongo.Pipeline) (*mongo.Cursor, error) {
+	cursor, err := e.Use().Aggregate(db.Ctx, pipeline)
+	return cursor, err
+}
+
+func (e *WorkExtensionsModel) NewDocument(data interface{}) (*mongo.InsertOneResult, error) {
+	result, err := e.Use().InsertOne(db.Ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func init() {
+	collections, err := DbConnect.GetCollections()
+	if err != nil {
+		panic(err)
+	}
+	for _, collection := range collections {
+		if collection == WORK_EXTENSIONS_COLLECTION {
+			initWorkExtensionsIndex()
+			return
+		}
+	}
+	var jsonSchema = bson.M{
+		"bsonType": "object",
+		"required": []string{
+			"work",
+			"student",
+			"author",
+			"date",
+		},
+		"properties": bson.M{
+			"work":        bson.M{"bsonType": "objectId"},
+			"student":     bson.M{"bsonType": "objectId"},
+			"author":      bson.M{"bsonType": "objectId"},
+			"date_limit":  bson.M{"bsonType": "date"},
+			"time_access": bson.M{"bsonType": "int", "minimum": 1},
+			"reason":      bson.M{"bsonType": "string", "maxLength": 300},
+			"date":        bson.M{"bsonType": "date"},
+		},
+	}
+	var validators = bson.M{
+		"$jsonSchema": jsonSchema,
+	}
+	opts := &options.CreateCollectionOptions{
+		Validator: validators,
+	}
+	err = DbConnect.CreateCollection(WORK_EXTENSIONS_COLLECTION, opts)
+	if err != nil {
+		panic(err)
+	}
+	initWorkExtensionsIndex()
+}
+
+// A student has only one extension by work
+func initWorkExtensionsIndex() {
+	_, err := DbConnect.GetCollection(WORK_EXTENSIONS_COLLECTION).Indexes().CreateOne(
+		db.Ctx,
+		mongo.IndexModel{
+			Keys: bson.D{
+				{
+					Key:   "work",
+					Value: 1,
+				},
+				{
+					Key:   "student",
+					Value: 1,
+				},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func NewWorkExtensionsModel() Collection {
+	if workExtensionsModel == nil {
+		workExtensionsModel = &WorkExtensionsModel{
+			CollectionName: WORK_EXTENSIONS_COLLECTION,
+		}
+	}
+	return workExtensionsModel
+}
@@ -0,0 +1,145 @@
+package models
+
+import (
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const WORK_FEEDBACK_COLLECTION = "work_feedback"
+
+var workFeedbackModel *WorkFeedbackModel
+
+// Feedback of the teacher, without question and file it's about the whole work
+type WorkFeedback struct {
+	ID         primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Work       primitive.ObjectID `json:"work" bson:"work" example:"637d5de216f58bc8ec7f7f51"`
+	Student    primitive.ObjectID `json:"student" bson:"student" example:"637d5de216f58bc8ec7f7f51"`
+	Evaluator  primitive.ObjectID `json:"evaluator" bson:"evaluator" example:"637d5de216f58bc8ec7f7f51"`
+	Question   primitive.ObjectID `json:"question,omitempty" bson:"question,omitempty" example:"637d5de216f58bc8ec7f7f51" extensions:"x-omitempty"`
+	File       primitive.ObjectID `json:"file,omitempty" bson:"file,omitempty" example:"637d5de216f58bc8ec7f7f51" extensions:"x-omitempty"`
+	Comment    string             `json:"comment" bson:"comment" example:"Buen desarrollo, revisa la conclusión"`
+	Date       primitive.DateTime `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	UpdateDate primitive.DateTime `json:"update_date" bson:"update_date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+}
+
+type WorkFeedbackModel struct {
+	CollectionName string
+}
+
+func NewModelWorkFeedback(
+	work,
+	student,
+	evaluator,
+	question,
+	file primitive.ObjectID,
+	comment string,
+) WorkFeedback {
+	now := primitive.NewDateTimeFromTime(time.Now())
+	return WorkFeedback{
+		Work:       work,
+		Student:    student,
+		Evaluator:  evaluator,
+		Question:   question,
+		File:       file,
+		Comment:    comment,
+		Date:       now,
+		UpdateDate: now,
+	}
+}
+
+func (f *WorkFeedbackModel) Use() *mongo.Collection {
+	return DbConnect.GetCollection(f.CollectionName)
+}
+
+func (f *WorkFeedbackModel) GetByID(id primitive.ObjectID) *mongo.SingleResult {
+	cursor := f.Use().FindOne(db.Ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: id,
+		},
+	})
+	return cursor
+}
+
+func (f *WorkFeedbackModel) GetOne(filter bson.D) *mongo.SingleResult {
+	cursor := f.Use().FindOne(db.Ctx, filter)
+	return cursor
+}
+
+func (f *WorkFeedbackModel) GetAll(filter bson.D, options *options.FindOptions) (*mongo.Cursor, error) {
+	cursor, err := f.Use().Find(db.Ctx, filter, options)
+	return cursor, err
+}
+
+func (f *WorkFeedbackModel) Aggreagate(pipeline mongo.Pipeline) (*mongo.Cursor, error) {
+	cursor, err := f.Use().Aggregate(db.Ctx, pipeline)
+	return cursor, err
+}
+
+func (f *WorkFeedbackModel) NewDocument(data interface{}) (*mongo.InsertOneResult, error) {
+	result, err := f.Use().InsertOne(db.Ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func init() {
+	collections, err := DbConnect.GetCollections()
+	if err != nil {
+		panic(err)
+	}
+	for _, collection := range collections {
+		if collection == WORK_FEEDBACK_COLLECTION {
+			return
+		}
+	}
+	var jsonSchema = bson.M{
+		"bsonType": "object",
+		"required": []string{
+			"work",
+			"student",
+			"evaluator",
+			"comment",
+			"date",
+			"update_date",
+		},
+		"properties": bson.M{
+			"work":      bson.M{"bsonType": "objectId"},
+			"student":   bson.M{"bsonType": "objectId"},
+			"evaluator": bson.M{"bsonType": "objectId"},
+			"question":  bson.M{"bsonType": "objectId"},
+			"file":      bson.M{"bsonType": "objectId"},
+			"comment": bson.M{
+				"bsonType":  "string",
+				"maxLength": 1000,
+			},
+			"date":        bson.M{"bsonType": "date"},
+			"update_date": bson.M{"bsonType": "date"},
+		},
+	}
+	var validators = bson.M{
+		"$jsonSchema": jsonSchema,
+	}
+	opts := &options.CreateCollectionOptions{
+		Validator: validators,
+	}
+	err = DbConnect.CreateCollection(WORK_FEEDBACK_COLLECTION, opts)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func NewWorkFeedbackModel() Collection {
+	if workFeedbackModel == nil {
+		workFeedbackModel = &WorkFeedbackModel{
+			CollectionName: WORK_FEEDBACK_COLLECTION,
+		}
+	}
+	return workFeedbackModel
+}
@@ -15,23 +15,29 @@ const WORK_GRADES_COLLECTION = "work_grades"
 var workGradesModel *WorkGradesModel
 
 type WorkGrade struct {
-	ID        primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
-	Module    primitive.ObjectID `json:"module" bson:"module"`
-	Student   primitive.ObjectID `json:"student" bson:"student"`
-	Work      primitive.ObjectID `json:"work" bson:"work"`
-	Evaluator primitive.ObjectID `json:"evaluator" bson:"evaluator"`
-	Grade     float64            `json:"grade" bson:"grade"`
-	Date      primitive.DateTime `json:"date" bson:"date"`
+	ID          primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
+	Module      primitive.ObjectID `json:"module" bson:"module"`
+	Student     primitive.ObjectID `json:"student" bson:"student"`
+	Work        primitive.ObjectID `json:"work" bson:"work"`
+	Evaluator   primitive.ObjectID `json:"evaluator" bson:"evaluator"`
+	Grade       float64            `json:"grade" bson:"grade"`
+	LatePenalty float64            `json:"late_penalty,omitempty" bson:"late_penalty,omitempty"`
+	Draft       bool               `json:"draft,omitempty" bson:"draft,omitempty"`
+	ReleaseDate primitive.DateTime `json:"release_date,omitempty" bson:"release_date,omitempty"`
+	Date        primitive.DateTime `json:"date" bson:"date"`
 }
 
 type WorkGradeWLookup struct {
-	ID        primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
-	Module    primitive.ObjectID `json:"module" bson:"module"`
-	Work      primitive.ObjectID `json:"work" bson:"work"`
-	Student   primitive.ObjectID `json:"student" bson:"student"`
-	Evaluator SimpleUser         `json:"evaluator" bson:"evaluator"`
-	Grade     float64            `json:"grade" bson:"grade"`
-	Date      primitive.DateTime `json:"date" bson:"date"`
+	ID          primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
+	Module      primitive.ObjectID `json:"module" bson:"module"`
+	Work        primitive.ObjectID `json:"work" bson:"work"`
+	Student     primitive.ObjectID `json:"student" bson:"student"`
+	Evaluator   SimpleUser         `json:"evaluator" bson:"evaluator"`
+	Grade       float64            `json:"grade" bson:"grade"`
+	LatePenalty float64            `json:"late_penalty,omitempty" bson:"late_penalty,omitempty"`
+	Draft       bool               `json:"draft,omitempty" bson:"draft,omitempty"`
+	ReleaseDate primitive.DateTime `json:"release_date,omitempty" bson:"release_date,omitempty"`
+	Date        primitive.DateTime `json:"date" bson:"date"`
 }
 
 type WorkGradesModel struct {
@@ -112,11 +118,14 @@ func init() {
 			"evaluator",
 		},
 		"properties": bson.M{
-			"student":   bson.M{"bsonType": "objectId"},
-			"work":      bson.M{"bsonType": "objectId"},
-			"module":    bson.M{"bsonType": "objectId"},
-			"evaluator": bson.M{"bsonType": "objectId"},
-			"date":      bson.M{"bsonType": "date"},
+			"student":      bson.M{"bsonType": "objectId"},
+			"work":         bson.M{"bsonType": "objectId"},
+			"module":       bson.M{"bsonType": "objectId"},
+			"evaluator":    bson.M{"bsonType": "objectId"},
+			"late_penalty": bson.M{"bsonType": "double", "minimum": 0},
+			"draft":        bson.M{"bsonType": "bool"},
+			"release_date": bson.M{"bsonType": "date"},
+			"date":         bson.M{"bsonType": "date"},
 		},
 	}
 	var validators = bson.M{
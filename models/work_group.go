@@ -0,0 +1,189 @@
+package models
+
+import (
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const WORK_GROUPS_COLLECTION = "work_groups"
+
+var workGroupsModel *WorkGroupsModel
+
+// Grade added (or subtracted) to the grade of the group for a member
+type GroupAdjustment struct {
+	Student    primitive.ObjectID `json:"student" bson:"student" example:"637d5de216f58bc8ec7f7f51"`
+	Adjustment float64            `json:"adjustment" bson:"adjustment" example:"-0.5"`
+	Comment    string             `json:"comment,omitempty" bson:"comment,omitempty" example:"No participó en la presentación" extensions:"x-omitempty"`
+}
+
+// Group of students of a work. The submission of the group is kept by
+// its first member, so every member shares the same submission
+type WorkGroup struct {
+	ID          primitive.ObjectID   `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Work        primitive.ObjectID   `json:"work" bson:"work" example:"637d5de216f58bc8ec7f7f51"`
+	Name        string               `json:"name" bson:"name" example:"Grupo 1"`
+	Members     []primitive.ObjectID `json:"members" bson:"members" example:"637d5de216f58bc8ec7f7f51"`
+	Adjustments []GroupAdjustment    `json:"adjustments,omitempty" bson:"adjustments,omitempty" extensions:"x-omitempty"`
+	Author      primitive.ObjectID   `json:"author" bson:"author" example:"637d5de216f58bc8ec7f7f51"`
+	Date        primitive.DateTime   `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	DateUpdate  primitive.DateTime   `json:"date_update" bson:"date_update" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+}
+
+type WorkGroupWLookup struct {
+	ID          primitive.ObjectID `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Work        primitive.ObjectID `json:"work" bson:"work" example:"637d5de216f58bc8ec7f7f51"`
+	Name        string             `json:"name" bson:"name" example:"Grupo 1"`
+	Members     []SimpleUser       `json:"members" bson:"members"`
+	Adjustments []GroupAdjustment  `json:"adjustments,omitempty" bson:"adjustments,omitempty" extensions:"x-omitempty"`
+	Author      primitive.ObjectID `json:"author" bson:"author" example:"637d5de216f58bc8ec7f7f51"`
+	Date        primitive.DateTime `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	DateUpdate  primitive.DateTime `json:"date_update" bson:"date_update" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+}
+
+type WorkGroupsModel struct {
+	CollectionName string
+}
+
+func NewModelWorkGroup(
+	work,
+	author primitive.ObjectID,
+	name string,
+	members []primitive.ObjectID,
+) WorkGroup {
+	now := primitive.NewDateTimeFromTime(time.Now())
+	return WorkGroup{
+		Work:       work,
+		Name:       name,
+		Members:    members,
+		Author:     author,
+		Date:       now,
+		DateUpdate: now,
+	}
+}
+
+// Adjustment of the member, zero if it hasn't one
+func (g *WorkGroup) GetAdjustment(student primitive.ObjectID) float64 {
+	for _, adjustment := range g.Adjustments {
+		if adjustment.Student == student {
+			return adjustment.Adjustment
+		}
+	}
+	return 0
+}
+
+func (g *WorkGroup) IsMember(student primitive.ObjectID) bool {
+	for _, member := range g.Members {
+		if member == student {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *WorkGroupsModel) Use() *mongo.Collection {
+	return DbConnect.GetCollection(w.CollectionName)
+}
+
+func (w *WorkGroupsModel) GetByID(id primitive.ObjectID) *mongo.SingleResult {
+	cursor := w.Use().FindOne(db.Ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: id,
+		},
+	})
+	return cursor
+}
+
+func (w *WorkGroupsModel) GetOne(filter bson.D) *mongo.SingleResult {
+	cursor := w.Use().FindOne(db.Ctx, filter)
+	return cursor
+}
+
+func (w *WorkGroupsModel) GetAll(filter bson.D, options *options.FindOptions) (*mongo.Cursor, error) {
+	cursor, err := w.Use().Find(db.Ctx, filter, options)
+	return cursor, err
+}
+
+func (w *WorkGroupsModel) Aggreagate(pipeline mongo.Pipeline) (*mongo.Cursor, error) {
+	cursor, err := w.Use().Aggregate(db.Ctx, pipeline)
+	return cursor, err
+}
+
+func (w *WorkGroupsModel) NewDocument(data interface{}) (*mongo.InsertOneResult, error) {
+	result, err := w.Use().InsertOne(db.Ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func init() {
+	collections, err := DbConnect.GetCollections()
+	if err != nil {
+		panic(err)
+	}
+	for _, collection := range collections {
+		if collection == WORK_GROUPS_COLLECTION {
+			return
+		}
+	}
+	var jsonSchema = bson.M{
+		"bsonType": "object",
+		"required": []string{
+			"work",
+			"name",
+			"members",
+			"author",
+			"date",
+			"date_update",
+		},
+		"properties": bson.M{
+			"work": bson.M{"bsonType": "objectId"},
+			"name": bson.M{"bsonType": "string", "minLength": 1, "maxLength": 50},
+			"members": bson.M{
+				"bsonType": bson.A{"array"},
+				"minItems": 1,
+				"items":    bson.M{"bsonType": "objectId"},
+			},
+			"adjustments": bson.M{
+				"bsonType": bson.A{"array"},
+				"items": bson.M{
+					"bsonType": "object",
+					"required": bson.A{"student", "adjustment"},
+					"properties": bson.M{
+						"student":    bson.M{"bsonType": "objectId"},
+						"adjustment": bson.M{"bsonType": "double"},
+						"comment":    bson.M{"bsonType": "string", "maxLength": 150},
+					},
+				},
+			},
+			"author":      bson.M{"bsonType": "objectId"},
+			"date":        bson.M{"bsonType": "date"},
+			"date_update": bson.M{"bsonType": "date"},
+		},
+	}
+	var validators = bson.M{
+		"$jsonSchema": jsonSchema,
+	}
+	opts := &options.CreateCollectionOptions{
+		Validator: validators,
+	}
+	err = DbConnect.CreateCollection(WORK_GROUPS_COLLECTION, opts)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func NewWorkGroupsModel() Collection {
+	if workGroupsModel == nil {
+		workGroupsModel = &WorkGroupsModel{
+			CollectionName: WORK_GROUPS_COLLECTION,
+		}
+	}
+	return workGroupsModel
+}
@@ -0,0 +1,198 @@
+package models
+
+import (
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const WORK_SIMILARITIES_COLLECTION = "work_similarities"
+
+// Status of the similarity analysis of a work
+const (
+	SIMILARITY_RUNNING   = "running"
+	SIMILARITY_COMPLETED = "completed"
+	SIMILARITY_FAILED    = "failed"
+)
+
+// Sources of the compared texts
+const (
+	SIMILARITY_WRITTEN = "written"
+	SIMILARITY_FILES   = "files"
+)
+
+var workSimilaritiesModel *WorkSimilaritiesModel
+
+type SimilarityPair struct {
+	StudentA   primitive.ObjectID `json:"student_a" bson:"student_a" example:"637d5de216f58bc8ec7f7f51"`
+	StudentB   primitive.ObjectID `json:"student_b" bson:"student_b" example:"637d5de216f58bc8ec7f7f51"`
+	Source     string             `json:"source" bson:"source" enums:"written,files" example:"written"`
+	Question   primitive.ObjectID `json:"question,omitempty" bson:"question,omitempty" example:"637d5de216f58bc8ec7f7f51" extensions:"x-omitempty"`
+	Similarity float64            `json:"similarity" bson:"similarity" example:"0.82"`
+}
+
+// Uploaded file without text to compare
+type SimilarityOmitted struct {
+	Student primitive.ObjectID `json:"student" bson:"student" example:"637d5de216f58bc8ec7f7f51"`
+	File    primitive.ObjectID `json:"file" bson:"file" example:"637d5de216f58bc8ec7f7f51"`
+	Reason  string             `json:"reason" bson:"reason" example:"tipo de archivo no soportado"`
+}
+
+// Last similarity analysis of a work
+type WorkSimilarity struct {
+	ID         primitive.ObjectID  `json:"_id" bson:"_id,omitempty" example:"637d5de216f58bc8ec7f7f51"`
+	Work       primitive.ObjectID  `json:"work" bson:"work" example:"637d5de216f58bc8ec7f7f51"`
+	Status     string              `json:"status" bson:"status" enums:"running,completed,failed" example:"completed"`
+	Threshold  float64             `json:"threshold" bson:"threshold" example:"0.5"`
+	Documents  int                 `json:"documents" bson:"documents" example:"60"`
+	Pairs      []SimilarityPair    `json:"pairs" bson:"pairs"`
+	Omitted    []SimilarityOmitted `json:"omitted,omitempty" bson:"omitted,omitempty" extensions:"x-omitempty"`
+	Error      string              `json:"error,omitempty" bson:"error,omitempty" extensions:"x-omitempty"`
+	Author     primitive.ObjectID  `json:"author" bson:"author" example:"637d5de216f58bc8ec7f7f51"`
+	Date       primitive.DateTime  `json:"date" bson:"date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	DateFinish primitive.DateTime  `json:"date_finish,omitempty" bson:"date_finish,omitempty" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00" extensions:"x-omitempty"`
+}
+
+type WorkSimilaritiesModel struct {
+	CollectionName string
+}
+
+func NewModelWorkSimilarity(work, author primitive.ObjectID, threshold float64) WorkSimilarity {
+	return WorkSimilarity{
+		Work:      work,
+		Status:    SIMILARITY_RUNNING,
+		Threshold: threshold,
+		Pairs:     []SimilarityPair{},
+		Author:    author,
+		Date:      primitive.NewDateTimeFromTime(time.Now()),
+	}
+}
+
+func (w *WorkSimilaritiesModel) Use() *mongo.Collection {
+	return DbConnect.GetCollection(w.CollectionName)
+}
+
+func (w *WorkSimilaritiesModel) GetByID(id primitive.ObjectID) *mongo.SingleResult {
+	cursor := w.Use().FindOne(db.Ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: id,
+		},
+	})
+	return cursor
+}
+
+func (w *WorkSimilaritiesModel) GetOne(filter bson.D) *mongo.SingleResult {
+	cursor := w.Use().FindOne(db.Ctx, filter)
+	return cursor
+}
+
+func (w *WorkSimilaritiesModel) GetAll(filter bson.D, options *options.FindOptions) (*mongo.Cursor, error) {
+	cursor, err := w.Use().Find(db.Ctx, filter, options)
+	return cursor, err
+}
+
+func (w *WorkSimilaritiesModel) Aggreagate(pipeline mongo.Pipeline) (*mongo.Cursor, error) {
+	cursor, err := w.Use().Aggregate(db.Ctx, pipeline)
+	return cursor, err
+}
+
+func (w *WorkSimilaritiesModel) NewDocument(data interface{}) (*mongo.InsertOneResult, error) {
+	result, err := w.Use().InsertOne(db.Ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func init() {
+	collections, err := DbConnect.GetCollections()
+	if err != nil {
+		panic(err)
+	}
+	for _, collection := range collections {
+		if collection == WORK_SIMILARITIES_COLLECTION {
+			return
+		}
+	}
+	var jsonSchema = bson.M{
+		"bsonType": "object",
+		"required": []string{
+			"work",
+			"status",
+			"threshold",
+			"documents",
+			"pairs",
+			"author",
+			"date",
+		},
+		"properties": bson.M{
+			"work": bson.M{"bsonType": "objectId"},
+			"status": bson.M{
+				"enum": bson.A{SIMILARITY_RUNNING, SIMILARITY_COMPLETED, SIMILARITY_FAILED},
+			},
+			"threshold": bson.M{"bsonType": "double", "minimum": 0, "maximum": 1},
+			"documents": bson.M{"bsonType": "int", "minimum": 0},
+			"pairs": bson.M{
+				"bsonType": bson.A{"array"},
+				"items": bson.M{
+					"bsonType": "object",
+					"required": bson.A{
+						"student_a",
+						"student_b",
+						"source",
+						"similarity",
+					},
+					"properties": bson.M{
+						"student_a": bson.M{"bsonType": "objectId"},
+						"student_b": bson.M{"bsonType": "objectId"},
+						"source": bson.M{
+							"enum": bson.A{SIMILARITY_WRITTEN, SIMILARITY_FILES},
+						},
+						"question":   bson.M{"bsonType": "objectId"},
+						"similarity": bson.M{"bsonType": "double", "minimum": 0, "maximum": 1},
+					},
+				},
+			},
+			"omitted": bson.M{
+				"bsonType": bson.A{"array"},
+				"items": bson.M{
+					"bsonType": "object",
+					"required": bson.A{"student", "file", "reason"},
+					"properties": bson.M{
+						"student": bson.M{"bsonType": "objectId"},
+						"file":    bson.M{"bsonType": "objectId"},
+						"reason":  bson.M{"bsonType": "string"},
+					},
+				},
+			},
+			"error":       bson.M{"bsonType": "string"},
+			"author":      bson.M{"bsonType": "objectId"},
+			"date":        bson.M{"bsonType": "date"},
+			"date_finish": bson.M{"bsonType": "date"},
+		},
+	}
+	var validators = bson.M{
+		"$jsonSchema": jsonSchema,
+	}
+	opts := &options.CreateCollectionOptions{
+		Validator: validators,
+	}
+	err = DbConnect.CreateCollection(WORK_SIMILARITIES_COLLECTION, opts)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func NewWorkSimilaritiesModel() Collection {
+	if workSimilaritiesModel == nil {
+		workSimilaritiesModel = &WorkSimilaritiesModel{
+			CollectionName: WORK_SIMILARITIES_COLLECTION,
+		}
+	}
+	return workSimilaritiesModel
+}
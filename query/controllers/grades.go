@@ -13,6 +13,7 @@ import (
 
 // Services
 var gradesService = services.NewGradesService()
+var gradeHistoryService = services.NewGradeHistoryService()
 
 type GradesController struct{}
 
@@ -110,7 +111,7 @@ func (g *GradesController) GetStudentGrades(c *gin.Context) {
 	idModule := c.Param("idModule")
 	claims, _ := services.NewClaimsFromContext(c)
 
-	grades, err := gradesService.GetStudentGrades(idModule, claims.ID)
+	grades, err := gradesService.GetStudentGrades(idModule, claims.ID, true)
 	if err != nil {
 		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
 			Success: false,
@@ -129,7 +130,7 @@ func (g *GradesController) GetStudentGrades(c *gin.Context) {
 
 // ExportGrades godoc
 // @Summary     Export grades
-// @Description Get student grades (in a module) -> export to Excel
+// @Description Get students grades (in a module) -> export to Excel, ODS, CSV or JSON. The Excel and ODS files have weighted formulas and a summary sheet
 // @Tags        grades
 // @Tags        classroom
 // @Tags        roles.teacher
@@ -137,8 +138,13 @@ func (g *GradesController) GetStudentGrades(c *gin.Context) {
 // @Tags        roles.director
 // @Accept      json
 // @Produce     application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
-// @Param       idModule path string true "Mongo ID Form"
-// @Sucess      200 {file} io.Writer "Excel File"
+// @Produce     application/vnd.oasis.opendocument.spreadsheet
+// @Produce     text/csv
+// @Produce     json
+// @Param       idModule path  string true  "Mongo ID Form"
+// @Param       format   query string false "Default: xlsx" Enums(xlsx, ods, csv, json)
+// @Sucess      200 {file} io.Writer "Excel, ODS, CSV or JSON File"
+// @Failure     400 {object} res.Response{} "Formato de exportación inválido"
 // @Failure     401 {object} res.Response{} "Unauthorized"
 // @Failure     401 {object} res.Response{} "Unauthorized role"
 // @Failure     503 {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
@@ -146,32 +152,29 @@ func (g *GradesController) GetStudentGrades(c *gin.Context) {
 // @Router      /grades/export_grades/{idModule} [get]
 func (g *GradesController) ExportGrades(c *gin.Context) {
 	idModule := c.Param("idModule")
+	format := c.DefaultQuery("format", services.EXPORT_XLSX)
 
+	contentType, exists := services.ExportContentTypes[format]
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: "formato de exportación inválido",
+		})
+		return
+	}
+	c.Writer.Header().Set("Content-type", contentType)
 	c.Writer.Header().Set(
-		"Content-type",
-		"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		"Content-Disposition",
+		fmt.Sprintf("attachment; filename=calificaciones.%s", format),
 	)
 
 	c.Stream(func(w io.Writer) bool {
-		file, err := gradesService.ExportGrades(idModule, w)
+		err := gradesService.ExportGrades(idModule, format, w)
 		if err != nil {
 			c.AbortWithStatusJSON(err.StatusCode, &res.Response{
 				Success: false,
 				Message: err.Err.Error(),
 			})
-			return false
-		}
-
-		c.Writer.Header().Set(
-			"Content-Disposition",
-			"attachment; filename='filename.zip'",
-		)
-		if err := file.Close(); err != nil {
-			c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
-				Success: false,
-				Message: err.Error(),
-			})
-			return false
 		}
 		return false
 	})
@@ -179,43 +182,358 @@ func (g *GradesController) ExportGrades(c *gin.Context) {
 
 // ExportGradesStudent godoc
 // @Summary     Export student grades
-// @Description Get student grades (in a semester) -> export to PDF
+// @Description Get the report card of the student (in a semester) -> export to PDF. Attorneys must indicate the student
 // @Tags        grades
 // @Tags        classroom
 // @Tags        roles.student
 // @Tags        roles.student_directive
+// @Tags        roles.attorney
 // @Accept      json
 // @Produce     application/pdf
-// @Param       semester query string true "MongoID"
+// @Param       semester query string false "MongoID"
+// @Param       student  query string false "MongoID. Required if attorney"
 // @Sucess      200 {file} binary "PDF File"
+// @Failure     400 {object} res.Response{} "Se debe indicar el estudiante"
 // @Failure     401 {object} res.Response{} "Unauthorized"
 // @Failure     401 {object} res.Response{} "Unauthorized role"
+// @Failure     401 {object} res.Response{} "No eres apoderado de este estudiante"
 // @Failure     503 {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
 // @Failure     510 {object} res.Response{} "Buffer io.Writter"
 // @Router      /grades/download_grades [get]
 func (g *GradesController) ExportGradesStudent(c *gin.Context) {
 	semester := c.DefaultQuery("semester", "")
+	student := c.DefaultQuery("student", "")
 
 	claims, _ := services.NewClaimsFromContext(c)
 
+	c.Writer.Header().Set(
+		"Content-type",
+		"application/pdf",
+	)
+	c.Writer.Header().Set(
+		"Content-Disposition",
+		"attachment; filename=calificaciones.pdf",
+	)
 	c.Stream(func(w io.Writer) bool {
-		err := gradesService.ExportGradesStudent(claims, semester, w)
+		err := gradesService.ExportGradesStudent(claims, semester, student, w)
 		if err != nil {
 			c.AbortWithStatusJSON(err.StatusCode, &res.Response{
 				Success: false,
 				Message: err.Err.Error(),
 			})
-			return false
 		}
+		return false
+	})
+}
 
-		c.Writer.Header().Set(
-			"Content-type",
-			"application/pdf",
-		)
-		c.Writer.Header().Set(
-			"Content-Disposition",
-			fmt.Sprintf("attachment; filename='%s.zip'", claims.Name),
-		)
+// ExportSectionGrades godoc
+// @Summary     Export section report cards
+// @Description Get the report cards of all the students of a section (in a semester) -> export to a zip with a PDF per student
+// @Tags        grades
+// @Tags        classroom
+// @Tags        roles.director
+// @Tags        roles.directive
+// @Accept      json
+// @Produce     application/zip
+// @Param       idSection path  string true  "Mongo ID Section"
+// @Param       semester  query string false "MongoID"
+// @Sucess      200 {file} binary "Zip File"
+// @Failure     400 {object} res.Response{} "Bad path param"
+// @Failure     401 {object} res.Response{} "Unauthorized"
+// @Failure     401 {object} res.Response{} "Unauthorized role"
+// @Failure     404 {object} res.Response{} "Esta sección no tiene módulos || Esta sección no tiene estudiantes"
+// @Failure     503 {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Failure     510 {object} res.Response{} "Buffer io.Writter"
+// @Router      /grades/download_section_grades/{idSection} [get]
+func (g *GradesController) ExportSectionGrades(c *gin.Context) {
+	idSection := c.Param("idSection")
+	semester := c.DefaultQuery("semester", "")
+
+	c.Writer.Header().Set(
+		"Content-type",
+		"application/zip",
+	)
+	c.Writer.Header().Set(
+		"Content-Disposition",
+		"attachment; filename=calificaciones.zip",
+	)
+	c.Stream(func(w io.Writer) bool {
+		err := gradesService.ExportSectionGrades(idSection, semester, w)
+		if err != nil {
+			c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+				Success: false,
+				Message: err.Err.Error(),
+			})
+		}
 		return false
 	})
 }
+
+// GetGradesAnalytics godoc
+// @Summary     Get grades analytics
+// @Description Get the stats of the grades of a module by program, the students at risk and the comparison with the other sections of the subject in the semester
+// @Tags        grades
+// @Tags        classroom
+// @Tags        roles.teacher
+// @Tags        roles.director
+// @Tags        roles.directive
+// @Accept      json
+// @Produce     json
+// @Param       idModule path     string true "Mongo ID Module"
+// @Success     200      {object} res.Response{body=smaps.GradesAnalyticsMap}
+// @Failure     400      {object} res.Response{} "Bad path param"
+// @Failure     401      {object} res.Response{} "Unauthorized"
+// @Failure     401      {object} res.Response{} "Unauthorized role"
+// @Failure     503      {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /grades/get_grades_analytics/{idModule} [get]
+func (g *GradesController) GetGradesAnalytics(c *gin.Context) {
+	idModule := c.Param("idModule")
+
+	analytics, err := gradesService.GetGradesAnalytics(idModule)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["analytics"] = analytics
+
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// GetStudentAverages godoc
+// @Summary     Get student averages
+// @Description Get the averages of the student by module and the semester average. Until the semester is closed the averages are partial. Attorneys and directives must indicate the student
+// @Tags        grades
+// @Tags        classroom
+// @Tags        roles.student
+// @Tags        roles.student_directive
+// @Tags        roles.attorney
+// @Tags        roles.director
+// @Tags        roles.directive
+// @Accept      json
+// @Produce     json
+// @Param       semester query    string false "MongoID"
+// @Param       student  query    string false "MongoID. Required if attorney, director or directive"
+// @Success     200      {object} res.Response{body=smaps.StudentAveragesMap}
+// @Failure     400      {object} res.Response{} "Se debe indicar el estudiante"
+// @Failure     401      {object} res.Response{} "Unauthorized"
+// @Failure     401      {object} res.Response{} "Unauthorized role"
+// @Failure     401      {object} res.Response{} "No eres apoderado de este estudiante"
+// @Failure     404      {object} res.Response{} "No existe el estudiante"
+// @Failure     503      {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /grades/get_averages [get]
+func (g *GradesController) GetStudentAverages(c *gin.Context) {
+	semester := c.DefaultQuery("semester", "")
+	student := c.DefaultQuery("student", "")
+
+	claims, _ := services.NewClaimsFromContext(c)
+
+	averages, err := gradesService.GetStudentAverages(claims, semester, student)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["averages"] = averages
+
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// GetSemesterClose godoc
+// @Summary     Get semester close
+// @Description Get the state of the close of a semester, with the progress by module. Current semester by default
+// @Tags        grades
+// @Tags        classroom
+// @Tags        roles.director
+// @Tags        roles.directive
+// @Accept      json
+// @Produce     json
+// @Param       semester query    string false "MongoID"
+// @Success     200      {object} res.Response{body=smaps.SemesterCloseMap}
+// @Failure     400      {object} res.Response{} "Bad query param"
+// @Failure     401      {object} res.Response{} "Unauthorized"
+// @Failure     401      {object} res.Response{} "Unauthorized role"
+// @Failure     404      {object} res.Response{} "El semestre no ha sido cerrado"
+// @Failure     503      {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /grades/get_close_semester [get]
+func (g *GradesController) GetSemesterClose(c *gin.Context) {
+	semester := c.DefaultQuery("semester", "")
+
+	semesterClose, err := gradesService.GetSemesterClose(semester)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["close"] = semesterClose
+
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// PreviewCloseSemester godoc
+// @Summary     Preview semester close
+// @Description Get the grades that the close of the current semester would fill with the min grade, nothing is registered
+// @Tags        grades
+// @Tags        classroom
+// @Tags        roles.director
+// @Tags        roles.directive
+// @Accept      json
+// @Produce     json
+// @Success     200 {object} res.Response{body=smaps.CloseSemesterPreviewMap}
+// @Failure     401 {object} res.Response{} "Unauthorized"
+// @Failure     401 {object} res.Response{} "Unauthorized role"
+// @Failure     503 {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /grades/preview_close_semester [get]
+func (g *GradesController) PreviewCloseSemester(c *gin.Context) {
+	preview, err := gradesService.PreviewCloseSemester()
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["preview"] = preview
+
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// GetGradeHistory godoc
+// @Summary     Get grade history
+// @Description Get the changes of a grade or work grade (newest first)
+// @Tags        grades
+// @Tags        classroom
+// @Tags        roles.teacher
+// @Tags        roles.director
+// @Tags        roles.directive
+// @Accept      json
+// @Produce     json
+// @Param       idModule path     string true "Mongo ID Module"
+// @Param       idGrade  path     string true "Mongo ID Grade || Work grade"
+// @Success     200      {object} res.Response{body=smaps.GradeHistoryMap}
+// @Failure     400      {object} res.Response{} "Bad path param"
+// @Failure     401      {object} res.Response{} "Unauthorized"
+// @Failure     401      {object} res.Response{} "Unauthorized role"
+// @Failure     404      {object} res.Response{} "No existe historial para esta calificación"
+// @Failure     503      {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /grades/get_grade_history/{idModule}/{idGrade} [get]
+func (g *GradesController) GetGradeHistory(c *gin.Context) {
+	idModule := c.Param("idModule")
+	idGrade := c.Param("idGrade")
+
+	history, err := gradeHistoryService.GetGradeHistory(idModule, idGrade)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["history"] = history
+
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// GetStudentGradeHistory godoc
+// @Summary     Get student grade history
+// @Description Get the changes of all the grades of a student in a module (newest first)
+// @Tags        grades
+// @Tags        classroom
+// @Tags        roles.teacher
+// @Tags        roles.director
+// @Tags        roles.directive
+// @Accept      json
+// @Produce     json
+// @Param       idModule  path     string true "Mongo ID Module"
+// @Param       idStudent path     string true "Mongo ID Student"
+// @Success     200       {object} res.Response{body=smaps.GradeHistoryMap}
+// @Failure     400       {object} res.Response{} "Bad path param"
+// @Failure     401       {object} res.Response{} "Unauthorized"
+// @Failure     401       {object} res.Response{} "Unauthorized role"
+// @Failure     503       {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /grades/get_student_grade_history/{idModule}/{idStudent} [get]
+func (g *GradesController) GetStudentGradeHistory(c *gin.Context) {
+	idModule := c.Param("idModule")
+	idStudent := c.Param("idStudent")
+
+	history, err := gradeHistoryService.GetStudentGradeHistory(idModule, idStudent)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["history"] = history
+
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// GetProgramTemplates godoc
+// @Summary     Get grade program templates
+// @Description Get the templates of grade programs of the subjects and courses
+// @Tags        grades
+// @Tags        classroom
+// @Tags        roles.director
+// @Tags        roles.directive
+// @Accept      json
+// @Produce     json
+// @Success     200 {object} res.Response{body=smaps.ProgramTemplatesMap}
+// @Failure     401 {object} res.Response{} "Unauthorized"
+// @Failure     401 {object} res.Response{} "Unauthorized role"
+// @Failure     503 {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /program_templates/get_templates [get]
+func (g *GradesController) GetProgramTemplates(c *gin.Context) {
+	templates, err := gradesService.GetProgramTemplates()
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["templates"] = templates
+
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
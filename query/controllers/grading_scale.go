@@ -0,0 +1,46 @@
+package controllers
+
+import (
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"github.com/CPU-commits/Intranet_BClassroom/services"
+	"github.com/gin-gonic/gin"
+)
+
+type GradingScaleController struct{}
+
+// Services
+var gradingScaleService = services.NewGradingScaleService()
+
+// GetGradingScale godoc
+// @Summary     Get grading scale
+// @Description Get the grading scale used by the module: its own scale, else the semester scale, else the linear min-max scale
+// @Tags        grades
+// @Tags        classroom
+// @Accept      json
+// @Produce     json
+// @Param       idModule path     string true "Mongo ID Module"
+// @Success     200      {object} res.Response{body=smaps.GradingScaleMap}
+// @Failure     401      {object} res.Response{} "Unauthorized"
+// @Failure     404      {object} res.Response{} "No existe el módulo"
+// @Failure     503      {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /grades/get_grading_scale/{idModule} [get]
+func (g *GradingScaleController) GetGradingScale(c *gin.Context) {
+	idModule := c.Param("idModule")
+
+	scale, err := gradingScaleService.GetGradingScale(idModule)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["scale"] = scale
+
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
@@ -0,0 +1,97 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"github.com/CPU-commits/Intranet_BClassroom/services"
+	"github.com/gin-gonic/gin"
+)
+
+type QuestionBankController struct{}
+
+// Services
+var questionBankService = services.NewQuestionBankService()
+
+// SearchBankQuestions godoc
+// @Summary     Search question bank, ROLES=[teacher]
+// @Description Search reusable questions by text, subject, difficulty, type and tags
+// @Tags        forms
+// @Tags        classroom
+// @Tags        roles.teacher
+// @Accept      json
+// @Produce     json
+// @Param       search     query    string  false "Search in question"
+// @Param       subject    query    string  false "Mongo ID Subject"
+// @Param       difficulty query    string  false "easy,medium,hard"
+// @Param       type       query    string  false "Question type"
+// @Param       tags       query    string  false "Tags separated by comma"
+// @Param       mine       query    boolean false "Only questions of user?"
+// @Param       total      query    boolean false "Get total?"
+// @Param       limit      query    integer false "Limit"
+// @Param       skip       query    integer false "Skip"
+// @Success     200        {object} res.Response{body=smaps.BankQuestionsMap}
+// @Failure     400        {object} res.Response{} "Bad query param"
+// @Failure     401        {object} res.Response{} "Unauthorized"
+// @Failure     401        {object} res.Response{} "Unauthorized role"
+// @Failure     503        {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /forms/search_bank [get]
+func (b *QuestionBankController) SearchBankQuestions(c *gin.Context) {
+	total := c.DefaultQuery("total", "false")
+	limit := c.DefaultQuery("limit", "20")
+	skip := c.DefaultQuery("skip", "0")
+	tags := c.DefaultQuery("tags", "")
+	claims, _ := services.NewClaimsFromContext(c)
+
+	limitNum, err := strconv.Atoi(limit)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: "Limit must be a number",
+		})
+		return
+	}
+	skipNum, err := strconv.Atoi(skip)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &res.Response{
+			Success: false,
+			Message: "Skip must be a number",
+		})
+		return
+	}
+	search := &services.BankSearch{
+		Search:     c.DefaultQuery("search", ""),
+		Subject:    c.DefaultQuery("subject", ""),
+		Difficulty: c.DefaultQuery("difficulty", ""),
+		Type:       c.DefaultQuery("type", ""),
+		OnlyAuthor: c.DefaultQuery("mine", "false") == "true",
+	}
+	if tags != "" {
+		search.Tags = strings.Split(tags, ",")
+	}
+	// Get
+	questions, totalQuestions, errRes := questionBankService.SearchQuestions(
+		search,
+		claims.ID,
+		limitNum,
+		skipNum,
+		total == "true",
+	)
+	if errRes != nil {
+		c.AbortWithStatusJSON(errRes.StatusCode, &res.Response{
+			Success: false,
+			Message: errRes.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["questions"] = questions
+	response["total"] = totalQuestions
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
@@ -0,0 +1,46 @@
+package controllers
+
+import (
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"github.com/CPU-commits/Intranet_BClassroom/services"
+	"github.com/gin-gonic/gin"
+)
+
+type RubricController struct{}
+
+// Services
+var rubricService = services.NewRubricService()
+
+// GetRubrics godoc
+// @Summary     Get rubric templates, ROLES=[teacher]
+// @Description Get the rubric templates of the user
+// @Tags        works
+// @Tags        classroom
+// @Tags        roles.teacher
+// @Accept      json
+// @Produce     json
+// @Success     200 {object} res.Response{body=smaps.RubricsMap}
+// @Failure     401 {object} res.Response{} "Unauthorized"
+// @Failure     401 {object} res.Response{} "Unauthorized role"
+// @Failure     503 {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router      /works/get_rubrics [get]
+func (r *RubricController) GetRubrics(c *gin.Context) {
+	claims, _ := services.NewClaimsFromContext(c)
+
+	rubrics, err := rubricService.GetRubrics(claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["rubrics"] = rubrics
+
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
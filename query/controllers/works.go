@@ -51,7 +51,7 @@ func (w *WorkController) GetModulesWorks(c *gin.Context) {
 
 // GetWorks godoc
 // @Summary Get works
-// @Desc    Get module works
+// @Desc    Get module works, the drafts are only visible to the teachers
 // @Tags    works
 // @Tags    classroom
 // @Tags    roles.teacher
@@ -67,8 +67,9 @@ func (w *WorkController) GetModulesWorks(c *gin.Context) {
 // @Router  /works/get_works/{idModule} [get]
 func (w *WorkController) GetWorks(c *gin.Context) {
 	idModule := c.Param("idModule")
+	claims, _ := services.NewClaimsFromContext(c)
 	// Get
-	works, err := workService.GetWorks(idModule)
+	works, err := workService.GetWorks(idModule, claims)
 	if err != nil {
 		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
 			Success: false,
@@ -179,7 +180,7 @@ func (w *WorkController) GetFormStudent(c *gin.Context) {
 	idWork := c.Param("idWork")
 	idStudent := c.Param("idStudent")
 	// Get
-	form, answers, err := workService.GetFormStudent(idWork, idStudent)
+	form, answers, attempts, err := workService.GetFormStudent(idWork, idStudent)
 	if err != nil {
 		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
 			Success: false,
@@ -191,6 +192,7 @@ func (w *WorkController) GetFormStudent(c *gin.Context) {
 	response := make(map[string]interface{})
 	response["form"] = form
 	response["answers"] = answers
+	response["attempts"] = attempts
 	c.JSON(200, &res.Response{
 		Success: true,
 		Data:    response,
@@ -244,6 +246,242 @@ func (w *WorkController) GetStudentsStatus(c *gin.Context) {
 	})
 }
 
+// GetSimilarityReport godoc
+// @Summary Get similarity report
+// @Desc    Get the last similarity analysis of the written answers or the uploaded files of a work, with the pairs of students over the threshold
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork path     string true "MongoID"
+// @Success 200    {object} res.Response{body=smaps.SimilarityReportMap}
+// @Failure 400    {object} res.Response{} "Bad path param"
+// @Failure 401    {object} res.Response{} "Unauthorized"
+// @Failure 401    {object} res.Response{} "Unauthorized role"
+// @Failure 404    {object} res.Response{} "Este trabajo no tiene análisis de similitud"
+// @Failure 503    {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/get_similarity_report/{idWork} [get]
+func (w *WorkController) GetSimilarityReport(c *gin.Context) {
+	idWork := c.Param("idWork")
+
+	similarity, err := workService.GetSimilarityReport(idWork)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["similarity"] = similarity
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// GetPeerReviews godoc
+// @Summary Get peer reviews
+// @Desc    Get the anonymous submissions assigned to the student to review. The submissions are assigned after the date limit of the work
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.student
+// @Tags    roles.student_directive
+// @Accept  json
+// @Produce json
+// @Param   idWork path     string true "MongoID"
+// @Success 200    {object} res.Response{body=smaps.PeerReviewsMap}
+// @Failure 400    {object} res.Response{} "Bad path param"
+// @Failure 400    {object} res.Response{} "Este trabajo no tiene revisión de pares"
+// @Failure 401    {object} res.Response{} "Unauthorized"
+// @Failure 401    {object} res.Response{} "Unauthorized role"
+// @Failure 401    {object} res.Response{} "La revisión de pares todavía no comienza"
+// @Failure 503    {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/get_peer_reviews/{idWork} [get]
+func (w *WorkController) GetPeerReviews(c *gin.Context) {
+	idWork := c.Param("idWork")
+
+	claims, _ := services.NewClaimsFromContext(c)
+	peerReviews, err := workService.GetReviewerPeerReviews(idWork, claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["peer_reviews"] = peerReviews
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// GetGroups godoc
+// @Summary Get groups
+// @Desc    Get the groups of the work with their members and adjustments
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Tags    roles.student
+// @Tags    roles.student_directive
+// @Accept  json
+// @Produce json
+// @Param   idWork path     string true "MongoID"
+// @Success 200    {object} res.Response{body=smaps.WorkGroupsMap}
+// @Failure 400    {object} res.Response{} "Bad path param"
+// @Failure 401    {object} res.Response{} "Unauthorized"
+// @Failure 401    {object} res.Response{} "Unauthorized role"
+// @Failure 503    {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/get_groups/{idWork} [get]
+func (w *WorkController) GetGroups(c *gin.Context) {
+	idWork := c.Param("idWork")
+
+	groups, err := workService.GetGroups(idWork)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["groups"] = groups
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// GetStudentPeerReviews godoc
+// @Summary Get student peer reviews
+// @Desc    Get the peer reviews of the submission of a student, with their reviewers and the mean of each item
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork    path     string true "MongoID"
+// @Param   idStudent path     string true "MongoID"
+// @Success 200       {object} res.Response{body=smaps.StudentPeerReviewsMap}
+// @Failure 400       {object} res.Response{} "Bad path param"
+// @Failure 400       {object} res.Response{} "Este trabajo no tiene revisión de pares"
+// @Failure 401       {object} res.Response{} "Unauthorized"
+// @Failure 401       {object} res.Response{} "Unauthorized role"
+// @Failure 401       {object} res.Response{} "La revisión de pares todavía no comienza"
+// @Failure 503       {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/get_student_peer_reviews/{idWork}/{idStudent} [get]
+func (w *WorkController) GetStudentPeerReviews(c *gin.Context) {
+	idWork := c.Param("idWork")
+	idStudent := c.Param("idStudent")
+
+	peerReviews, err := workService.GetStudentPeerReviews(idWork, idStudent)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["peer_reviews"] = peerReviews
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// GetItemAnalysis godoc
+// @Summary Get item analysis
+// @Desc    Get the percentage correct, the discrimination index and the distribution of the alternatives of each question of a revised form work
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.teacher
+// @Accept  json
+// @Produce json
+// @Param   idWork path     string true "MongoID"
+// @Success 200    {object} res.Response{body=smaps.ItemAnalysisMap}
+// @Failure 400    {object} res.Response{} "Bad path param"
+// @Failure 400    {object} res.Response{} "El análisis de preguntas es solo para trabajos de formulario"
+// @Failure 400    {object} res.Response{} "Este trabajo todavía no está evaluado"
+// @Failure 401    {object} res.Response{} "Unauthorized"
+// @Failure 401    {object} res.Response{} "Unauthorized role"
+// @Failure 503    {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/get_item_analysis/{idWork} [get]
+func (w *WorkController) GetItemAnalysis(c *gin.Context) {
+	idWork := c.Param("idWork")
+
+	analysis, err := workService.GetItemAnalysis(idWork)
+	if err != nil {
+		c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+			Success: false,
+			Message: err.Err.Error(),
+		})
+		return
+	}
+	// Response
+	response := make(map[string]interface{})
+	response["analysis"] = analysis
+	c.JSON(200, &res.Response{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// DownloadPeerReviewFiles godoc
+// @Summary Download peer review files
+// @Desc    Download the files of a submission assigned to the student to review, the files are renamed by their number
+// @Tags    works
+// @Tags    classroom
+// @Tags    roles.student
+// @Tags    roles.student_directive
+// @Accept  json
+// @Produce octet-stream
+// @Param   idWork   path     string         true "MongoID"
+// @Param   idReview path     string         true "MongoID"
+// @Success 200      {file}   binary         "Zip file"
+// @Failure 400      {object} res.Response{} "Bad path param"
+// @Failure 401      {object} res.Response{} "Unauthorized"
+// @Failure 401      {object} res.Response{} "Unauthorized role"
+// @Failure 404      {object} res.Response{} "No existe la revisión indicada"
+// @Failure 503      {object} res.Response{} "Service Unavailable - NATS || DB Service Unavailable"
+// @Router  /works/download_peer_review_files/{idWork}/{idReview} [get]
+func (w *WorkController) DownloadPeerReviewFiles(c *gin.Context) {
+	idWork := c.Param("idWork")
+	idReview := c.Param("idReview")
+
+	claims, _ := services.NewClaimsFromContext(c)
+	c.Writer.Header().Set("Content-type", "application/octet-stream")
+	c.Stream(func(w io.Writer) bool {
+		// Download Files
+		ar, err := workService.DownloadPeerReviewFiles(
+			idWork,
+			idReview,
+			claims.ID,
+			w,
+		)
+		if err != nil {
+			c.AbortWithStatusJSON(err.StatusCode, &res.Response{
+				Success: false,
+				Message: err.Err.Error(),
+			})
+			return false
+		}
+		c.Writer.Header().Set(
+			"Content-Disposition",
+			"attachment; filename='filename.zip'",
+		)
+		ar.Close()
+		return false
+	})
+}
+
 // DownloadFilesWorkStudent godoc
 // @Summary Download files work student
 // @Desc    Download files work student
@@ -30,6 +30,64 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/forms/delete_bank_question/{idQuestion}": {
+            "delete": {
+                "description": "Author deletes(soft) a bank question, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classroom",
+                    "forms",
+                    "roles.teacher"
+                ],
+                "summary": "Delete question of bank",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Bank Question",
+                        "name": "idQuestion",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "No estás autorizado a eliminar esta pregunta",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la pregunta en el banco de preguntas",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
         "/forms/delete_form/{idForm}": {
             "delete": {
                 "description": "Form own deletes(soft) a form, ROLS=[teacher]",
@@ -198,9 +256,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/forms/update_form/{idForm}": {
-            "put": {
-                "description": "Update a form to teacher, ROLS=[teacher]",
+        "/forms/search_bank": {
+            "get": {
+                "description": "Search reusable questions by text, subject, difficulty, type and tags",
                 "consumes": [
                     "application/json"
                 ],
@@ -208,38 +266,88 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "classroom",
                     "forms",
+                    "classroom",
                     "roles.teacher"
                 ],
-                "summary": "Update form to user",
+                "summary": "Search question bank, ROLES=[teacher]",
                 "parameters": [
                     {
-                        "description": "Update form",
-                        "name": "form",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/forms.FormForm"
-                        }
+                        "type": "string",
+                        "description": "Search in question",
+                        "name": "search",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Mongo ID Form",
-                        "name": "idForm",
-                        "in": "path",
-                        "required": true
+                        "description": "Mongo ID Subject",
+                        "name": "subject",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "easy,medium,hard",
+                        "name": "difficulty",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Question type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tags separated by comma",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only questions of user?",
+                        "name": "mine",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Get total?",
+                        "name": "total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/res.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.BankQuestionsMap"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Bad request - Bad body",
+                        "description": "Bad query param",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -250,12 +358,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "403": {
-                        "description": "Este formulario está asignado a un trabajo revisado, no se puede editar",
-                        "schema": {
-                            "$ref": "#/definitions/res.Response"
-                        }
-                    },
                     "503": {
                         "description": "Service Unavailable - NATS || DB Service Unavailable",
                         "schema": {
@@ -265,9 +367,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/forms/upload_form": {
-            "post": {
-                "description": "Upload a form to teacher, ROLS=[teacher]",
+        "/forms/update_bank_question/{idQuestion}": {
+            "put": {
+                "description": "Update a bank question, form questions imported by reference and not answered are updated too, ROLS=[teacher]",
                 "consumes": [
                     "application/json"
                 ],
@@ -279,21 +381,28 @@ const docTemplate = `{
                     "forms",
                     "roles.teacher"
                 ],
-                "summary": "Upload form to user",
+                "summary": "Update question of bank",
                 "parameters": [
                     {
-                        "description": "Add form",
-                        "name": "form",
+                        "description": "Update question",
+                        "name": "question",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/forms.FormForm"
+                            "$ref": "#/definitions/forms.BankQuestionForm"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Bank Question",
+                        "name": "idQuestion",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -310,6 +419,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
+                    "404": {
+                        "description": "No existe la pregunta en el banco de preguntas",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
                     "503": {
                         "description": "Service Unavailable - NATS || DB Service Unavailable",
                         "schema": {
@@ -319,30 +434,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/grades/delete_program/{idModule}/{idProgram}": {
-            "delete": {
-                "description": "Delete grade program in module, ROLS=[teacher]",
+        "/forms/update_form/{idForm}": {
+            "put": {
+                "description": "Update a form to teacher, ROLS=[teacher]",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "grades",
                     "classroom",
+                    "forms",
                     "roles.teacher"
                 ],
-                "summary": "Delete grade program",
+                "summary": "Update form to user",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idModule",
-                        "in": "path",
-                        "required": true
+                        "description": "Update form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.FormForm"
+                        }
                     },
                     {
                         "type": "string",
-                        "description": "MongoID",
-                        "name": "idProgram",
+                        "description": "Mongo ID Form",
+                        "name": "idForm",
                         "in": "path",
                         "required": true
                     }
@@ -354,26 +474,26 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized role",
+                    "400": {
+                        "description": "Bad request - Bad body",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "404": {
-                        "description": "No existe la programación de calificación",
+                    "401": {
+                        "description": "Unauthorized role",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "409": {
-                        "description": "Esta programación está en uso en alguna calificación",
+                    "403": {
+                        "description": "Este formulario está asignado a un trabajo revisado, no se puede editar",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
                     "503": {
-                        "description": "Service Unavailable - NATS || DB Service Unavailable\"..",
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -381,46 +501,65 @@ const docTemplate = `{
                 }
             }
         },
-        "/grades/download_grades": {
-            "get": {
-                "description": "Get student grades (in a semester) -\u003e export to PDF",
+        "/forms/upload_bank_question": {
+            "post": {
+                "description": "Upload a reusable question to the question bank, ROLS=[teacher]",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/pdf"
+                    "application/json"
                 ],
                 "tags": [
-                    "grades",
                     "classroom",
-                    "roles.student",
-                    "roles.student_directive"
+                    "forms",
+                    "roles.teacher"
                 ],
-                "summary": "Export student grades",
+                "summary": "Upload question to bank",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "semester",
-                        "in": "query",
-                        "required": true
+                        "description": "Add question",
+                        "name": "question",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.BankQuestionForm"
+                        }
                     }
                 ],
                 "responses": {
-                    "401": {
-                        "description": "Unauthorized role",
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Una pregunta del banco no puede importar otra pregunta del banco",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                    "401": {
+                        "description": "Unauthorized role",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "510": {
-                        "description": "Buffer io.Writter",
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -428,47 +567,53 @@ const docTemplate = `{
                 }
             }
         },
-        "/grades/export_grades/{idModule}": {
-            "get": {
-                "description": "Get student grades (in a module) -\u003e export to Excel",
+        "/forms/upload_form": {
+            "post": {
+                "description": "Upload a form to teacher, ROLS=[teacher]",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                    "application/json"
                 ],
                 "tags": [
-                    "grades",
                     "classroom",
-                    "roles.teacher",
-                    "roles.directive",
-                    "roles.director"
+                    "forms",
+                    "roles.teacher"
                 ],
-                "summary": "Export grades",
+                "summary": "Upload form to user",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Mongo ID Form",
-                        "name": "idModule",
-                        "in": "path",
-                        "required": true
+                        "description": "Add form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.FormForm"
+                        }
                     }
                 ],
                 "responses": {
-                    "401": {
-                        "description": "Unauthorized role",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                    "400": {
+                        "description": "Bad request - Bad body",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "510": {
-                        "description": "Buffer io.Writter",
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -476,9 +621,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/grades/get_grade_programs/{idModule}": {
-            "get": {
-                "description": "Get program grade by MongoId (Sort by program number)",
+        "/grades/delete_grading_scale/{idModule}": {
+            "delete": {
+                "description": "Delete the grading scale of a module, it goes back to the semester scale, ROLS=[teacher]",
                 "consumes": [
                     "application/json"
                 ],
@@ -486,15 +631,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "grades",
                     "classroom",
-                    "roles.all"
+                    "grades",
+                    "roles.teacher"
                 ],
-                "summary": "Get program grade",
+                "summary": "Delete module grading scale",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Mongo ID Form",
+                        "description": "Mongo ID Module",
                         "name": "idModule",
                         "in": "path",
                         "required": true
@@ -504,23 +649,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/res.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "$ref": "#/definitions/smaps.ProgramGradeMap"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized",
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe una escala de calificación configurada",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -534,40 +679,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/grades/get_student_grades/{idModule}": {
-            "get": {
-                "description": "Get student grades (in a module)",
+        "/grades/delete_program/{idModule}/{idProgram}": {
+            "delete": {
+                "description": "Delete grade program in module, ROLS=[teacher]",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
                     "grades",
                     "classroom",
-                    "roles.student",
-                    "roles.student_directive"
+                    "roles.teacher"
                 ],
-                "summary": "Get student grades",
+                "summary": "Delete grade program",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Mongo ID Form",
+                        "description": "MongoID",
                         "name": "idModule",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idProgram",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized role",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
+                    "404": {
+                        "description": "No existe la programación de calificación",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Esta programación está en uso en alguna calificación",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
                     "503": {
-                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "description": "Service Unavailable - NATS || DB Service Unavailable\"..",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -575,35 +741,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/grades/get_students_grades/{idModule}": {
+        "/grades/download_grades": {
             "get": {
-                "description": "Get all students and their grades (in a module)",
+                "description": "Get the report card of the student (in a semester) -\u003e export to PDF. Attorneys must indicate the student",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/pdf"
                 ],
                 "tags": [
                     "grades",
                     "classroom",
-                    "roles.teacher",
-                    "roles.director",
-                    "roles.directive"
+                    "roles.student",
+                    "roles.student_directive",
+                    "roles.attorney"
                 ],
-                "summary": "Get students grades",
+                "summary": "Export student grades",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Mongo ID Form",
-                        "name": "idModule",
-                        "in": "path",
-                        "required": true
+                        "description": "MongoID",
+                        "name": "semester",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID. Required if attorney",
+                        "name": "student",
+                        "in": "query"
                     }
                 ],
                 "responses": {
+                    "400": {
+                        "description": "Se debe indicar el estudiante",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
                     "401": {
-                        "description": "Unauthorized role",
+                        "description": "No eres apoderado de este estudiante",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -613,56 +790,50 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
+                    },
+                    "510": {
+                        "description": "Buffer io.Writter",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
                     }
                 }
             }
         },
-        "/grades/update_grade/{idModule}/{idGrade}": {
-            "put": {
-                "description": "Update grade in module to student, ROLS=[teacher]",
+        "/grades/download_section_grades/{idSection}": {
+            "get": {
+                "description": "Get the report cards of all the students of a section (in a semester) -\u003e export to a zip with a PDF per student",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/zip"
+                ],
                 "tags": [
                     "grades",
                     "classroom",
-                    "roles.teacher"
+                    "roles.director",
+                    "roles.directive"
                 ],
-                "summary": "Update grade",
+                "summary": "Export section report cards",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "MongoID",
-                        "name": "idModule",
+                        "description": "Mongo ID Section",
+                        "name": "idSection",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idGrade",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Desc",
-                        "name": "grade",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/forms.UpdateGradeForm"
-                        }
+                        "name": "semester",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/res.Response"
-                        }
-                    },
                     "400": {
-                        "description": "Calificación inválida. Mín: %v. Máx: %v",
+                        "description": "Bad path param",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -674,19 +845,19 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "No existe la programación de calificación",
+                        "description": "Esta sección no tiene módulos || Esta sección no tiene estudiantes",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "409": {
-                        "description": "Esta calificación no pertenece al módulo",
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable - NATS || DB Service Unavailable\"..",
+                    "510": {
+                        "description": "Buffer io.Writter",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -694,67 +865,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/grades/upload_grade/{idModule}/{idStudent}": {
-            "post": {
-                "description": "Upload grade in module to student, ROLS=[teacher]",
+        "/grades/export_grades/{idModule}": {
+            "get": {
+                "description": "Get students grades (in a module) -\u003e export to Excel, ODS, CSV or JSON. The Excel and ODS files have weighted formulas and a summary sheet",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+                    "application/vnd.oasis.opendocument.spreadsheet",
+                    "text/csv",
                     "application/json"
                 ],
                 "tags": [
                     "grades",
                     "classroom",
-                    "roles.teacher"
+                    "roles.teacher",
+                    "roles.directive",
+                    "roles.director"
                 ],
-                "summary": "Upload grade",
+                "summary": "Export grades",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "MongoID",
+                        "description": "Mongo ID Form",
                         "name": "idModule",
                         "in": "path",
                         "required": true
                     },
                     {
+                        "enum": [
+                            "xlsx",
+                            "ods",
+                            "csv",
+                            "json"
+                        ],
                         "type": "string",
-                        "description": "MongoID",
-                        "name": "idStudent",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Desc",
-                        "name": "grade",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/forms.GradeForm"
-                        }
+                        "description": "Default: xlsx",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/res.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "$ref": "#/definitions/smaps.IdInsertedMap"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
                     "400": {
-                        "description": "Calificación inválida. Mín: %v. Máx: %v",
+                        "description": "Formato de exportación inválido",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -765,14 +919,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "409": {
-                        "description": "No se puede agregar una calificación ya subida",
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                    "510": {
+                        "description": "Buffer io.Writter",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -780,9 +934,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/grades/upload_program/{idModule}": {
-            "post": {
-                "description": "Upload program grade in module, ROLS=[teacher]",
+        "/grades/get_averages": {
+            "get": {
+                "description": "Get the averages of the student by module and the semester average. Until the semester is closed the averages are partial. Attorneys and directives must indicate the student",
                 "consumes": [
                     "application/json"
                 ],
@@ -792,30 +946,30 @@ const docTemplate = `{
                 "tags": [
                     "grades",
                     "classroom",
-                    "roles.teacher"
+                    "roles.student",
+                    "roles.student_directive",
+                    "roles.attorney",
+                    "roles.director",
+                    "roles.directive"
                 ],
-                "summary": "Upload program grade",
+                "summary": "Get student averages",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idModule",
-                        "in": "path",
-                        "required": true
+                        "name": "semester",
+                        "in": "query"
                     },
                     {
-                        "description": "Desc",
-                        "name": "programGrade",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/forms.GradeProgramForm"
-                        }
+                        "type": "string",
+                        "description": "MongoID. Required if attorney, director or directive",
+                        "name": "student",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -825,7 +979,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                            "$ref": "#/definitions/smaps.StudentAveragesMap"
                                         }
                                     }
                                 }
@@ -833,19 +987,19 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "El porcentaje sumatorio de las calificaciones acumulativas debe ser exactamente 100 por cierto",
+                        "description": "Se debe indicar el estudiante",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized role",
+                        "description": "No eres apoderado de este estudiante",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "409": {
-                        "description": "Esta calificación ya está programada",
+                    "404": {
+                        "description": "No existe el estudiante",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -859,9 +1013,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/modules/download_file/{idFile}/{idModule}": {
+        "/grades/get_close_semester": {
             "get": {
-                "description": "Download file of module",
+                "description": "Get the state of the close of a semester, with the progress by module. Current semester by default",
                 "consumes": [
                     "application/json"
                 ],
@@ -869,27 +1023,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "modules",
+                    "grades",
                     "classroom",
-                    "roles.teacher",
-                    "roles.student",
-                    "roles.student_directive"
+                    "roles.director",
+                    "roles.directive"
                 ],
-                "summary": "Download file module",
+                "summary": "Get semester close",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idModule",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idFile",
-                        "in": "path",
-                        "required": true
+                        "name": "semester",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -904,7 +1049,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/smaps.TokenMap"
+                                            "$ref": "#/definitions/smaps.SemesterCloseMap"
                                         }
                                     }
                                 }
@@ -912,7 +1057,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad path params",
+                        "description": "Bad query param",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -923,6 +1068,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
+                    "404": {
+                        "description": "El semestre no ha sido cerrado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
                     "503": {
                         "description": "Service Unavailable - NATS || DB Service Unavailable",
                         "schema": {
@@ -932,9 +1083,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/modules/get_module/{idModule}": {
+        "/grades/get_grade_history/{idModule}/{idGrade}": {
             "get": {
-                "description": "Get module",
+                "description": "Get the changes of a grade or work grade (newest first)",
                 "consumes": [
                     "application/json"
                 ],
@@ -942,20 +1093,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "modules",
+                    "grades",
                     "classroom",
                     "roles.teacher",
-                    "roles.student",
-                    "roles.student_directive"
+                    "roles.director",
+                    "roles.directive"
                 ],
-                "summary": "Get module",
+                "summary": "Get grade history",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "MongoID",
+                        "description": "Mongo ID Module",
                         "name": "idModule",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Grade || Work grade",
+                        "name": "idGrade",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -970,13 +1128,19 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/smaps.ModuleMap"
+                                            "$ref": "#/definitions/smaps.GradeHistoryMap"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized role",
                         "schema": {
@@ -984,7 +1148,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Not found",
+                        "description": "No existe historial para esta calificación",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -998,9 +1162,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/modules/get_modules": {
+        "/grades/get_grade_programs/{idModule}": {
             "get": {
-                "description": "Get user modules",
+                "description": "Get program grade by MongoId (Sort by program number)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1008,13 +1172,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "modules",
+                    "grades",
                     "classroom",
-                    "roles.teacher",
-                    "roles.student",
-                    "roles.student_directive"
+                    "roles.all"
+                ],
+                "summary": "Get program grade",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Form",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get modules",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1027,7 +1198,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/smaps.ModulesMap"
+                                            "$ref": "#/definitions/smaps.ProgramGradeMap"
                                         }
                                     }
                                 }
@@ -1035,13 +1206,7 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Unauthorized role",
-                        "schema": {
-                            "$ref": "#/definitions/res.Response"
-                        }
-                    },
-                    "403": {
-                        "description": "No estás asignado a ningún curso",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -1055,8 +1220,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/modules/get_modules_history": {
+        "/grades/get_grades_analytics/{idModule}": {
             "get": {
+                "description": "Get the stats of the grades of a module by program, the students at risk and the comparison with the other sections of the subject in the semester",
                 "consumes": [
                     "application/json"
                 ],
@@ -1064,33 +1230,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "modules",
-                    "roles.student",
-                    "roles.student_directive"
+                    "grades",
+                    "classroom",
+                    "roles.teacher",
+                    "roles.director",
+                    "roles.directive"
                 ],
+                "summary": "Get grades analytics",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Get total?",
-                        "name": "total",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Skip",
-                        "name": "skip",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Mongo ID Module",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.GradesAnalyticsMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
                     "400": {
-                        "description": "Bad query param",
+                        "description": "Bad path param",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -1110,9 +1286,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/modules/new_sub_section/{idModule}": {
-            "post": {
-                "description": "New sub section to module",
+        "/grades/get_grading_scale/{idModule}": {
+            "get": {
+                "description": "Get the grading scale used by the module: its own scale, else the semester scale, else the linear min-max scale",
                 "consumes": [
                     "application/json"
                 ],
@@ -1120,23 +1296,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "modules",
-                    "classroom",
-                    "roles.teacher"
+                    "grades",
+                    "classroom"
                 ],
-                "summary": "New sub section",
+                "summary": "Get grading scale",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "MongoID",
+                        "description": "Mongo ID Module",
                         "name": "idModule",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -1146,21 +1321,27 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/smaps.InsertedIdMap"
+                                            "$ref": "#/definitions/smaps.GradingScaleMap"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad path params",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized role",
+                    "404": {
+                        "description": "No existe el módulo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -1168,9 +1349,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/modules/search/{idModule}": {
+        "/grades/get_student_grade_history/{idModule}/{idStudent}": {
             "get": {
-                "description": "search in all module content",
+                "description": "Get the changes of all the grades of a student in a module (newest first)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1178,25 +1359,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "modules",
+                    "grades",
                     "classroom",
-                    "roles.student",
-                    "roles.student_directive"
+                    "roles.teacher",
+                    "roles.director",
+                    "roles.directive"
                 ],
-                "summary": "search",
+                "summary": "Get student grade history",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Desc",
+                        "description": "Mongo ID Module",
                         "name": "idModule",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Search",
-                        "name": "search",
-                        "in": "query"
+                        "description": "Mongo ID Student",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1211,13 +1394,19 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/smaps.SearchHitsMap"
+                                            "$ref": "#/definitions/smaps.GradeHistoryMap"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized role",
                         "schema": {
@@ -1233,8 +1422,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/publications/delete/{idPublication}/{idModule}": {
-            "delete": {
+        "/grades/get_student_grades/{idModule}": {
+            "get": {
+                "description": "Get student grades (in a module)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1242,48 +1432,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "publications",
+                    "grades",
                     "classroom",
-                    "roles.teacher"
+                    "roles.student",
+                    "roles.student_directive"
                 ],
-                "summary": "Delete publication",
+                "summary": "Get student grades",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "MongoID",
+                        "description": "Mongo ID Form",
                         "name": "idModule",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idPublication",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/res.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad path param",
-                        "schema": {
-                            "$ref": "#/definitions/res.Response"
-                        }
-                    },
                     "401": {
-                        "description": "Not Access module",
-                        "schema": {
-                            "$ref": "#/definitions/res.Response"
-                        }
-                    },
-                    "404": {
-                        "description": "Publication not found",
+                        "description": "Unauthorized role",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -1297,8 +1463,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/publications/delete_attached/{idAttached}/{idModule}": {
-            "delete": {
+        "/grades/get_students_grades/{idModule}": {
+            "get": {
+                "description": "Get all students and their grades (in a module)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1306,36 +1473,99 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "publications",
+                    "grades",
                     "classroom",
-                    "roles.teacher"
+                    "roles.teacher",
+                    "roles.director",
+                    "roles.directive"
                 ],
-                "summary": "Delete publication attached",
+                "summary": "Get students grades",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "MongoID",
+                        "description": "Mongo ID Form",
                         "name": "idModule",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
                     },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/grades/import_grades/{idModule}": {
+            "post": {
+                "description": "Import grades from a xlsx or csv file with the layout of the export, students are matched by rut. All rows are validated and imported atomically, ROLS=[teacher]",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "tags": [
+                    "grades",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Import grades",
+                "parameters": [
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idAttached",
+                        "name": "idModule",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "xlsx or csv file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID, only import the column of this program",
+                        "name": "program",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only validate the file",
+                        "name": "dry_run",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/res.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.ImportGradesMap"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Esta publicación no tiene elementos adjuntos",
+                        "description": "El archivo debe ser xlsx o csv",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -1346,14 +1576,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "404": {
-                        "description": "No existe el elemento adjunto",
-                        "schema": {
-                            "$ref": "#/definitions/res.Response"
-                        }
-                    },
                     "503": {
-                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "description": "Service Unavailable - NATS || DB Service Unavailable\"..",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -1361,9 +1585,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/publications/get_publication/{idModule}/{idPublication}": {
+        "/grades/preview_close_semester": {
             "get": {
-                "description": "Get module publication",
+                "description": "Get the grades that the close of the current semester would fill with the min grade, nothing is registered",
                 "consumes": [
                     "application/json"
                 ],
@@ -1371,29 +1595,12 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "publications",
+                    "grades",
                     "classroom",
-                    "roles.teacher",
-                    "roles.student",
-                    "roles.student_directive"
-                ],
-                "summary": "Get publication",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idModule",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idPublication",
-                        "in": "path",
-                        "required": true
-                    }
+                    "roles.director",
+                    "roles.directive"
                 ],
+                "summary": "Preview semester close",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1406,7 +1613,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/smaps.PublicationMap"
+                                            "$ref": "#/definitions/smaps.CloseSemesterPreviewMap"
                                         }
                                     }
                                 }
@@ -1428,23 +1635,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/publications/get_publications/{idModule}": {
-            "get": {
-                "description": "Get module publications",
+        "/grades/release_program/{idModule}/{idProgram}": {
+            "post": {
+                "description": "Release the draft grades of a program, with date the release is scheduled, ROLS=[teacher]",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "publications",
+                    "grades",
                     "classroom",
-                    "roles.teacher",
-                    "roles.student",
-                    "roles.student_directive"
+                    "roles.teacher"
                 ],
-                "summary": "Get publications",
+                "summary": "Release grades",
                 "parameters": [
                     {
                         "type": "string",
@@ -1454,63 +1656,43 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Section",
-                        "name": "section",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Skip",
-                        "name": "skip",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idProgram",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "Get total?",
-                        "name": "total",
-                        "in": "query"
+                        "description": "Desc",
+                        "name": "release",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.ReleaseGradesForm"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/res.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "$ref": "#/definitions/smaps.PublicationsMap"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized role",
+                    "400": {
+                        "description": "La calificación no pertenece a este módulo",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "404": {
-                        "description": "No existe esta sección",
+                    "401": {
+                        "description": "Unauthorized role",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
                     "503": {
-                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "description": "Service Unavailable - NATS || DB Service Unavailable\"..",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -1518,56 +1700,76 @@ const docTemplate = `{
                 }
             }
         },
-        "/publications/update/{idPublication}": {
+        "/grades/update_grade/{idModule}/{idGrade}": {
             "put": {
+                "description": "Update grade in module to student, the change is registered in the grade history, ROLS=[teacher]",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "publications",
+                    "grades",
                     "classroom",
                     "roles.teacher"
                 ],
-                "summary": "Update publication",
+                "summary": "Update grade",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idPublication",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idGrade",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "grade",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.UpdateGradeForm"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
                     "400": {
-                        "description": "Bad path param",
+                        "description": "Calificación inválida. Mín: %v. Máx: %v",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
                     "401": {
-                        "description": "No tienes acceso a esta publicación",
+                        "description": "Unauthorized role",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
                     "404": {
-                        "description": "Publication not found",
+                        "description": "No existe la programación de calificación",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Esta calificación no pertenece al módulo",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
                     "503": {
-                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "description": "Service Unavailable - NATS || DB Service Unavailable\"..",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -1575,8 +1777,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/publications/upload/{idModule}": {
+        "/grades/upload_grade/{idModule}/{idStudent}": {
             "post": {
+                "description": "Upload grade in module to student, a draft grade is hidden until released, ROLS=[teacher]",
                 "consumes": [
                     "application/json"
                 ],
@@ -1584,11 +1787,11 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "publications",
+                    "grades",
                     "classroom",
                     "roles.teacher"
                 ],
-                "summary": "New publication",
+                "summary": "Upload grade",
                 "parameters": [
                     {
                         "type": "string",
@@ -1598,15 +1801,25 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Default: 0",
-                        "name": "section",
-                        "in": "query"
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "grade",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.GradeForm"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -1616,7 +1829,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/smaps.NewPublicationMap"
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
                                         }
                                     }
                                 }
@@ -1624,7 +1837,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "No existe esta sección",
+                        "description": "Calificación inválida. Mín: %v. Máx: %v",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -1635,6 +1848,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
+                    "409": {
+                        "description": "No se puede agregar una calificación ya subida",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
                     "503": {
                         "description": "Service Unavailable - NATS || DB Service Unavailable",
                         "schema": {
@@ -1644,8 +1863,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/delete_attached/{idWork}/{idAttached}": {
-            "delete": {
+        "/grades/upload_grading_scale/{idModule}": {
+            "post": {
+                "description": "Set the grading scale of a module, it replaces the semester scale, ROLS=[teacher]",
                 "consumes": [
                     "application/json"
                 ],
@@ -1653,23 +1873,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "works",
                     "classroom",
+                    "grades",
                     "roles.teacher"
                 ],
-                "summary": "Delete Attached",
+                "summary": "Upload module grading scale",
                 "parameters": [
+                    {
+                        "description": "Grading scale",
+                        "name": "scale",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.GradingScaleForm"
+                        }
+                    },
                     {
                         "type": "string",
-                        "description": "MongoID",
-                        "name": "idWork",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idAttached",
+                        "description": "Mongo ID Module",
+                        "name": "idModule",
                         "in": "path",
                         "required": true
                     }
@@ -1682,7 +1904,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad path param",
+                        "description": "Bad request - Bad body || Bad scale",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -1693,12 +1915,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "404": {
-                        "description": "No existe este elemento adjunto al trabajo",
-                        "schema": {
-                            "$ref": "#/definitions/res.Response"
-                        }
-                    },
                     "503": {
                         "description": "Service Unavailable - NATS || DB Service Unavailable",
                         "schema": {
@@ -1708,8 +1924,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/delete_file_work/{idWork}/{idFile}": {
-            "delete": {
+        "/grades/upload_program/{idModule}": {
+            "post": {
+                "description": "Upload program grade in module, ROLS=[teacher]",
                 "consumes": [
                     "application/json"
                 ],
@@ -1717,37 +1934,50 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "works",
+                    "grades",
                     "classroom",
-                    "roles.student",
-                    "roles.student_directive"
+                    "roles.teacher"
                 ],
-                "summary": "Delete file classroom",
+                "summary": "Upload program grade",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idWork",
+                        "name": "idModule",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idFile",
-                        "in": "path",
-                        "required": true
+                        "description": "Desc",
+                        "name": "programGrade",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.GradeProgramForm"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/res.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "No se puede eliminar un trabajo calificado",
+                        "description": "El porcentaje sumatorio de las calificaciones acumulativas debe ser exactamente 100 por cierto",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -1758,8 +1988,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "404": {
-                        "description": "No se encontró el archivo a eliminar en este trabajo",
+                    "409": {
+                        "description": "Esta calificación ya está programada",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -1773,8 +2003,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/delete_item_pattern/{idWork}/{idItem}": {
+        "/grading_scales/delete_semester_scale": {
             "delete": {
+                "description": "Delete the grading scale of the current semester, ROLS=[director,directive]",
                 "consumes": [
                     "application/json"
                 ],
@@ -1782,27 +2013,11 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "works",
                     "classroom",
-                    "roles.teacher"
-                ],
-                "summary": "Delete Item Pattern",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idWork",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idItem",
-                        "in": "path",
-                        "required": true
-                    }
+                    "grades",
+                    "roles.directive"
                 ],
+                "summary": "Delete semester grading scale",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1810,12 +2025,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "400": {
-                        "description": "Bad path param",
-                        "schema": {
-                            "$ref": "#/definitions/res.Response"
-                        }
-                    },
                     "401": {
                         "description": "Unauthorized role",
                         "schema": {
@@ -1823,7 +2032,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "No existe el item a eliminar",
+                        "description": "No existe una escala de calificación configurada",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -1837,8 +2046,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/delete_work/{idWork}": {
-            "delete": {
+        "/grading_scales/upload_semester_scale": {
+            "post": {
+                "description": "Set the grading scale of the current semester for the modules without scale, ROLS=[director,directive]",
                 "consumes": [
                     "application/json"
                 ],
@@ -1846,18 +2056,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "works",
                     "classroom",
-                    "roles.teacher"
+                    "grades",
+                    "roles.directive"
                 ],
-                "summary": "Delete work",
+                "summary": "Upload semester grading scale",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idWork",
-                        "in": "path",
-                        "required": true
+                        "description": "Grading scale",
+                        "name": "scale",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.GradingScaleForm"
+                        }
                     }
                 ],
                 "responses": {
@@ -1868,7 +2080,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "No se puede eliminar un trabajo calificado",
+                        "description": "Bad request - Bad body || Bad scale",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -1888,45 +2100,60 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/download_files_work_student/{idWork}/{idStudent}": {
+        "/modules/download_file/{idFile}/{idModule}": {
             "get": {
+                "description": "Download file of module",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/octet-stream"
+                    "application/json"
                 ],
                 "tags": [
-                    "works",
+                    "modules",
                     "classroom",
-                    "roles.teacher"
+                    "roles.teacher",
+                    "roles.student",
+                    "roles.student_directive"
                 ],
-                "summary": "Download files work student",
+                "summary": "Download file module",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idStudent",
+                        "name": "idModule",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idWork",
+                        "name": "idFile",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Zip file",
+                        "description": "OK",
                         "schema": {
-                            "type": "file"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.TokenMap"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Bad path param",
+                        "description": "Bad path params",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -1946,8 +2173,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/finish_form/{idWork}": {
-            "post": {
+        "/modules/get_module/{idModule}": {
+            "get": {
+                "description": "Get module",
                 "consumes": [
                     "application/json"
                 ],
@@ -1955,35 +2183,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "works",
+                    "modules",
                     "classroom",
+                    "roles.teacher",
                     "roles.student",
                     "roles.student_directive"
                 ],
-                "summary": "Finish form",
+                "summary": "Get module",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idWork",
+                        "name": "idModule",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Desc",
-                        "name": "answers",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/forms.AnswersForm"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/res.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.ModuleMap"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "401": {
@@ -1992,6 +2224,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
                     "503": {
                         "description": "Service Unavailable - NATS || DB Service Unavailable",
                         "schema": {
@@ -2001,8 +2239,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/get_form/{idWork}": {
+        "/modules/get_modules": {
             "get": {
+                "description": "Get user modules",
                 "consumes": [
                     "application/json"
                 ],
@@ -2010,21 +2249,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "works",
+                    "modules",
                     "classroom",
+                    "roles.teacher",
                     "roles.student",
                     "roles.student_directive"
                 ],
-                "summary": "Get form",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idWork",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "Get modules",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2037,21 +2268,21 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/smaps.FormWorkMap"
+                                            "$ref": "#/definitions/smaps.ModulesMap"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "No accediste al formulario, no hay respuestas a revisar",
+                    "401": {
+                        "description": "Unauthorized role",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized role",
+                    "403": {
+                        "description": "No estás asignado a ningún curso",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -2065,7 +2296,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/get_form_student/{idWork}/{idStudent}": {
+        "/modules/get_modules_history": {
             "get": {
                 "consumes": [
                     "application/json"
@@ -2074,36 +2305,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "works",
-                    "classroom",
-                    "roles.teacher"
+                    "modules",
+                    "roles.student",
+                    "roles.student_directive"
                 ],
-                "summary": "Get form student",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idWork",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Get total?",
+                        "name": "total",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idStudent",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/res.Response"
-                        }
-                    },
                     "400": {
-                        "description": "Este alumno no ha tiene respuestas, ya que no abrió el formulario",
+                        "description": "Bad query param",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -2123,8 +2351,67 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/get_modules_works": {
+        "/modules/new_sub_section/{idModule}": {
+            "post": {
+                "description": "New sub section to module",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "modules",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "New sub section",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.InsertedIdMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path params",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/modules/search/{idModule}": {
             "get": {
+                "description": "search in all module content",
                 "consumes": [
                     "application/json"
                 ],
@@ -2132,12 +2419,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "works",
+                    "modules",
                     "classroom",
                     "roles.student",
                     "roles.student_directive"
                 ],
-                "summary": "Get modules works",
+                "summary": "search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Desc",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2150,7 +2452,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/smaps.ModulesWorksMap"
+                                            "$ref": "#/definitions/smaps.SearchHitsMap"
                                         }
                                     }
                                 }
@@ -2172,8 +2474,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/get_students_status/{idModule}/{idWork}": {
-            "get": {
+        "/program_templates/apply_templates": {
+            "post": {
+                "description": "Create the grade programs of all the modules of the semester from its template, in one operation, ROLS=[director,directive]",
                 "consumes": [
                     "application/json"
                 ],
@@ -2181,25 +2484,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "works",
+                    "grades",
                     "classroom",
-                    "roles.teacher"
+                    "roles.director",
+                    "roles.directive"
                 ],
-                "summary": "Get students status",
+                "summary": "Apply grade program templates",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idModule",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idWork",
-                        "in": "path",
-                        "required": true
+                        "description": "Desc",
+                        "name": "apply",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.ApplyProgramTemplatesForm"
+                        }
                     }
                 ],
                 "responses": {
@@ -2214,7 +2513,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/smaps.StudentsStatusMap"
+                                            "$ref": "#/definitions/smaps.ApplyTemplatesMap"
                                         }
                                     }
                                 }
@@ -2222,7 +2521,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Ningún estudiante pertenece a este trabajo",
+                        "description": "Bad body",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -2233,6 +2532,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
+                    "404": {
+                        "description": "No hay plantillas de calificaciones",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
                     "503": {
                         "description": "Service Unavailable - NATS || DB Service Unavailable",
                         "schema": {
@@ -2242,27 +2547,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/get_work/{idWork}": {
-            "get": {
+        "/program_templates/delete_template/{idTemplate}": {
+            "delete": {
+                "description": "Delete a template of grade programs, the programs of the modules are kept, ROLS=[director,directive]",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "works",
+                    "grades",
                     "classroom",
-                    "roles.teacher",
-                    "roles.student",
-                    "roles.student_directive"
+                    "roles.director",
+                    "roles.directive"
                 ],
-                "summary": "Get work",
+                "summary": "Delete grade program template",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idWork",
+                        "name": "idTemplate",
                         "in": "path",
                         "required": true
                     }
@@ -2274,12 +2576,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized role",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
+                    "404": {
+                        "description": "No existe la plantilla",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
                     "503": {
                         "description": "Service Unavailable - NATS || DB Service Unavailable",
                         "schema": {
@@ -2289,8 +2603,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/get_works/{idModule}": {
+        "/program_templates/get_templates": {
             "get": {
+                "description": "Get the templates of grade programs of the subjects and courses",
                 "consumes": [
                     "application/json"
                 ],
@@ -2298,22 +2613,12 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "works",
+                    "grades",
                     "classroom",
-                    "roles.teacher",
-                    "roles.student",
-                    "roles.student_directive"
-                ],
-                "summary": "Get works",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idModule",
-                        "in": "path",
-                        "required": true
-                    }
+                    "roles.director",
+                    "roles.directive"
                 ],
+                "summary": "Get grade program templates",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2326,7 +2631,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/smaps.WorksMap"
+                                            "$ref": "#/definitions/smaps.ProgramTemplatesMap"
                                         }
                                     }
                                 }
@@ -2348,8 +2653,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/grade_files/{idWork}": {
-            "post": {
+        "/program_templates/update_template/{idTemplate}": {
+            "put": {
+                "description": "Update a template of grade programs, the modules keep its programs until the template is applied, ROLS=[director,directive]",
                 "consumes": [
                     "application/json"
                 ],
@@ -2357,18 +2663,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "works",
+                    "grades",
                     "classroom",
-                    "roles.teacher"
+                    "roles.director",
+                    "roles.directive"
                 ],
-                "summary": "Grade files",
+                "summary": "Update grade program template",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idWork",
+                        "name": "idTemplate",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.GradeProgramTemplateForm"
+                        }
                     }
                 ],
                 "responses": {
@@ -2379,7 +2695,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "No existen alumnos a evaluar en este trabajo",
+                        "description": "El porcentaje sumatorio de las calificaciones debe ser exactamente 100 por ciento",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -2390,8 +2706,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "403": {
-                        "description": "Este trabajo ya está evaluado",
+                    "404": {
+                        "description": "No existe la plantilla",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Ya existe una plantilla para esta asignatura y nivel",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -2405,8 +2727,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/grade_form/{idWork}": {
+        "/program_templates/upload_template": {
             "post": {
+                "description": "Upload a template of grade programs for a subject and/or course, ROLS=[director,directive]",
                 "consumes": [
                     "application/json"
                 ],
@@ -2414,29 +2737,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "works",
+                    "grades",
                     "classroom",
-                    "roles.teacher"
+                    "roles.director",
+                    "roles.directive"
                 ],
-                "summary": "Grade form",
+                "summary": "Upload grade program template",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idWork",
-                        "in": "path",
-                        "required": true
+                        "description": "Desc",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.GradeProgramTemplateForm"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/res.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "No existen alumnos a evaluar en este trabajo",
+                        "description": "La calificación N°%d está repetida",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -2447,8 +2785,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "403": {
-                        "description": "Este trabajo ya está evaluado",
+                    "409": {
+                        "description": "Ya existe una plantilla para esta asignatura y nivel",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -2462,8 +2800,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/save_answer/{idWork}/{idQuestion}": {
-            "post": {
+        "/publications/delete/{idPublication}/{idModule}": {
+            "delete": {
                 "consumes": [
                     "application/json"
                 ],
@@ -2471,35 +2809,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "works",
+                    "publications",
                     "classroom",
-                    "roles.student",
-                    "roles.student_directive"
+                    "roles.teacher"
                 ],
-                "summary": "Save answer",
+                "summary": "Delete publication",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idWork",
+                        "name": "idModule",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idQuestion",
+                        "name": "idPublication",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Desc",
-                        "name": "answer",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/forms.AnswerForm"
-                        }
                     }
                 ],
                 "responses": {
@@ -2510,28 +2838,34 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "El trabajo no es de tipo formulario",
+                        "description": "Bad path param",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized role",
+                        "description": "Not Access module",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable - NATS || DB Service Unavailable",
-                        "schema": {
+                    "404": {
+                        "description": "Publication not found",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     }
                 }
             }
         },
-        "/works/update_work/{idWork}": {
-            "put": {
+        "/publications/delete_attached/{idAttached}/{idModule}": {
+            "delete": {
                 "consumes": [
                     "application/json"
                 ],
@@ -2539,27 +2873,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "works",
+                    "publications",
                     "classroom",
                     "roles.teacher"
                 ],
-                "summary": "Update work",
+                "summary": "Delete publication attached",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idWork",
+                        "name": "idModule",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Desc",
-                        "name": "work",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/forms.UpdateWorkForm"
-                        }
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idAttached",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -2570,7 +2902,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "La fecha y hora de inicio registrada es mayor a la limite",
+                        "description": "Esta publicación no tiene elementos adjuntos",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -2582,7 +2914,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "No se puede actualizar un item que no está registrado",
+                        "description": "No existe el elemento adjunto",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -2596,8 +2928,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/upload_evaluate_files/{idWork}/{idStudent}": {
-            "post": {
+        "/publications/get_publication/{idModule}/{idPublication}": {
+            "get": {
+                "description": "Get module publication",
                 "consumes": [
                     "application/json"
                 ],
@@ -2605,50 +2938,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "works",
+                    "publications",
                     "classroom",
-                    "roles.teacher"
+                    "roles.teacher",
+                    "roles.student",
+                    "roles.student_directive"
                 ],
-                "summary": "Upload evaluate files",
+                "summary": "Get publication",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idWork",
+                        "name": "idModule",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idStudent",
+                        "name": "idPublication",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Desc",
-                        "name": "evaluate",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/forms.EvaluateFilesForm"
-                            }
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/res.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "Los puntos evaluados superan el máx. del item",
-                        "schema": {
-                            "$ref": "#/definitions/res.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.PublicationMap"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "401": {
@@ -2657,14 +2986,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "404": {
-                        "description": "No existe el item #%s en este trabajo",
-                        "schema": {
-                            "$ref": "#/definitions/res.Response"
-                        }
-                    },
                     "503": {
-                        "description": "No se encontraron archivos subidos por parte del alumno",
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -2672,45 +2995,73 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/upload_files/{idWork}": {
-            "post": {
+        "/publications/get_publications/{idModule}": {
+            "get": {
+                "description": "Get module publications",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "works",
+                    "publications",
                     "classroom",
+                    "roles.teacher",
                     "roles.student",
                     "roles.student_directive"
                 ],
-                "summary": "Upload files",
+                "summary": "Get publications",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idWork",
+                        "name": "idModule",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "file"
-                        },
-                        "description": "Form Data in files[]",
-                        "name": "files[]",
-                        "in": "formData",
-                        "required": true
+                        "type": "integer",
+                        "description": "Section",
+                        "name": "section",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Get total?",
+                        "name": "total",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/res.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.PublicationsMap"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "401": {
@@ -2719,8 +3070,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "413": {
-                        "description": "Solo se puede subir hasta 3 archivos por trabajo",
+                    "404": {
+                        "description": "No existe esta sección",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -2734,8 +3085,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/upload_points_question/{idWork}/{idQuestion}/{idStudent}": {
-            "post": {
+        "/publications/update/{idPublication}": {
+            "put": {
                 "consumes": [
                     "application/json"
                 ],
@@ -2743,41 +3094,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "works",
+                    "publications",
                     "classroom",
                     "roles.teacher"
                 ],
-                "summary": "Upload points question",
+                "summary": "Update publication",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idWork",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idQuestion",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idStudent",
+                        "name": "idPublication",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Desc",
-                        "name": "points",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/forms.EvaluateQuestion"
-                        }
                     }
                 ],
                 "responses": {
@@ -2788,19 +3116,19 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Puntaje fuera de rango. Debe ser entre cero y máx %v",
+                        "description": "Bad path param",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
                     "401": {
-                        "description": "Todavía no se pueden evaluar preguntas en este formulario",
+                        "description": "No tienes acceso a esta publicación",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
                     "404": {
-                        "description": "No existe la programación de calificación",
+                        "description": "Publication not found",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -2814,7 +3142,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/upload_reevaluate_files/{idWork}/{idStudent}": {
+        "/publications/upload/{idModule}": {
             "post": {
                 "consumes": [
                     "application/json"
@@ -2823,48 +3151,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "works",
+                    "publications",
                     "classroom",
                     "roles.teacher"
                 ],
-                "summary": "Upload reevaluate files",
+                "summary": "New publication",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idWork",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "MongoID",
-                        "name": "idStudent",
+                        "name": "idModule",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Desc",
-                        "name": "evaluate",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/forms.EvaluateFilesForm"
-                            }
-                        }
+                        "type": "integer",
+                        "description": "Default: 0",
+                        "name": "section",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/res.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.NewPublicationMap"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Los puntos evaluados superan el máx. del item",
+                        "description": "No existe esta sección",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -2875,14 +3202,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
-                    "404": {
-                        "description": "No existe el item #%s en este trabajo",
-                        "schema": {
-                            "$ref": "#/definitions/res.Response"
-                        }
-                    },
                     "503": {
-                        "description": "No se encontraron archivos subidos por parte del alumno",
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -2890,7 +3211,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/works/upload_work/{idModule}": {
+        "/works/adjust_group_member/{idWork}/{idGroup}/{idStudent}": {
             "post": {
                 "consumes": [
                     "application/json"
@@ -2903,34 +3224,48 @@ const docTemplate = `{
                     "classroom",
                     "roles.teacher"
                 ],
-                "summary": "Upload work",
+                "summary": "Adjust group member",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "MongoID",
-                        "name": "idModule",
+                        "name": "idWork",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Desc",
-                        "name": "work",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/forms.WorkForm"
-                        }
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idGroup",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "adjustment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.GroupAdjustmentForm"
+                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
                     },
                     "400": {
-                        "description": "Bad path param",
+                        "description": "El ajuste debe estar entre N y M",
                         "schema": {
                             "$ref": "#/definitions/res.Response"
                         }
@@ -2941,6 +3276,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/res.Response"
                         }
                     },
+                    "403": {
+                        "description": "Los ajustes solo se pueden modificar antes de calificar el trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el grupo indicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
                     "503": {
                         "description": "Service Unavailable - NATS || DB Service Unavailable",
                         "schema": {
@@ -2949,1336 +3296,7555 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "forms.AcumulativeForm": {
-            "type": "object",
-            "required": [
-                "number",
-                "percentage"
-            ],
-            "properties": {
-                "number": {
-                    "type": "integer",
-                    "minimum": 1,
-                    "example": 1
-                },
-                "percentage": {
-                    "type": "number",
-                    "example": 45
+        },
+        "/works/analyze_similarity/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Analyze similarity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Threshold",
+                        "name": "similarity",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WorkSimilarityForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "El análisis de similitud es solo para trabajos de formulario o archivos",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Ya hay un análisis de similitud en curso para este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
                 }
             }
         },
-        "forms.Answer": {
-            "type": "object",
-            "required": [
-                "question"
-            ],
-            "properties": {
-                "answer": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "question": {
-                    "type": "string"
-                },
-                "response": {
-                    "type": "string",
-                    "example": "Response..."
+        "/works/answer_appeal/{idWork}/{idAppeal}": {
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Answer appeal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idAppeal",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "answer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.AnswerWorkAppealForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Se debe indicar el motivo del rechazo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la apelación",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Esta apelación ya fue respondida",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
                 }
             }
         },
-        "forms.AnswerForm": {
-            "type": "object",
-            "properties": {
-                "answer": {
-                    "type": "integer",
-                    "example": 1
+        "/works/clone_work/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Clone work",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "clone",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WorkCloneForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.WorkClonesMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Esta calificación está registrada ya a un trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "No tienes acceso al módulo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/create_group/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Create group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.SelfWorkGroupForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo no es en grupo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Ya no se pueden modificar los grupos de este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "El estudiante ya tiene una entrega en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/delete_attached/{idWork}/{idAttached}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Delete Attached",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idAttached",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe este elemento adjunto al trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/delete_extension/{idWork}/{idExtension}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Delete extension",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idExtension",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este trabajo ya está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la prórroga indicada",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Esta prórroga no pertenece al trabajo indicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/delete_feedback/{idWork}/{idFeedback}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Delete feedback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idFeedback",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el comentario indicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Este comentario no pertenece al trabajo indicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/delete_file_work/{idWork}/{idFile}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Delete file classroom",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idFile",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "No se puede eliminar un trabajo calificado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No se encontró el archivo a eliminar en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/delete_group/{idWork}/{idGroup}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Delete group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idGroup",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo no es en grupo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este trabajo ya está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el grupo indicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "El grupo ya tiene una entrega, no se pueden modificar sus integrantes",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/delete_item_pattern/{idWork}/{idItem}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Delete Item Pattern",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idItem",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el item a eliminar",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/delete_rubric/{idRubric}": {
+            "delete": {
+                "description": "Author deletes(soft) a rubric template, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classroom",
+                    "works",
+                    "roles.teacher"
+                ],
+                "summary": "Delete rubric template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Rubric",
+                        "name": "idRubric",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "No tienes acceso a esta rúbrica",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la rúbrica indicada",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/delete_work/{idWork}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Delete work",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "No se puede eliminar un trabajo calificado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/download_files_work_student/{idWork}/{idStudent}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Download files work student",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Zip file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/download_peer_review_files/{idWork}/{idReview}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Download peer review files",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idReview",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Zip file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la revisión indicada",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/finish_form/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Finish form",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "answers",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.AnswersForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_extensions/{idWork}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get extensions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.WorkExtensionsMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_feedback/{idWork}/{idStudent}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get feedback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.WorkFeedbackMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_form/{idWork}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Get form",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.FormWorkMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "No accediste al formulario, no hay respuestas a revisar",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_form_student/{idWork}/{idStudent}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get form student",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Este alumno no ha tiene respuestas, ya que no abrió el formulario",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_groups/{idWork}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Get groups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.WorkGroupsMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_item_analysis/{idWork}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get item analysis",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.ItemAnalysisMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo todavía no está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_modules_works": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Get modules works",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.ModulesWorksMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_peer_reviews/{idWork}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Get peer reviews",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.PeerReviewsMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo no tiene revisión de pares",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "La revisión de pares todavía no comienza",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_rubrics": {
+            "get": {
+                "description": "Get the rubric templates of the user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get rubric templates, ROLES=[teacher]",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.RubricsMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_similarity_report/{idWork}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get similarity report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.SimilarityReportMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Este trabajo no tiene análisis de similitud",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_student_peer_reviews/{idWork}/{idStudent}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get student peer reviews",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.StudentPeerReviewsMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo no tiene revisión de pares",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "La revisión de pares todavía no comienza",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_students_status/{idModule}/{idWork}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Get students status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.StudentsStatusMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Ningún estudiante pertenece a este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_work/{idWork}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Get work",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/get_works/{idModule}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Get works",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.WorksMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/grade_files/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Grade files",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Keep the grades as draft until released",
+                        "name": "draft",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "No existen alumnos a evaluar en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este trabajo ya está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/grade_form/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Grade form",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Keep the grades as draft until released",
+                        "name": "draft",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "No existen alumnos a evaluar en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este trabajo ya está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/grade_inperson/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Grade inperson work",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Keep the grades as draft until released",
+                        "name": "draft",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "No existen alumnos a evaluar en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este trabajo ya está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/join_group/{idWork}/{idGroup}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Join group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idGroup",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo no es en grupo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Ya no se pueden modificar los grupos de este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el grupo indicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "El estudiante ya pertenece a otro grupo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/leave_group/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Leave group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo no es en grupo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Ya no se pueden modificar los grupos de este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No perteneces a un grupo en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "El grupo ya tiene una entrega, no se pueden modificar sus integrantes",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/new_attempt/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Start a new attempt of the form",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Este formulario no admite múltiples intentos",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "No te quedan intentos disponibles",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Debes terminar el intento actual antes de comenzar otro",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/publish_work/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Publish work",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "publish",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.PublishWorkForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "La fecha de publicación debe ser anterior a la fecha límite",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Este trabajo ya está publicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/release_grades/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Release work grades",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "release",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.ReleaseGradesForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo todavía no está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/remove_question/{idWork}/{idQuestion}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Remove question from the grade",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idQuestion",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "No se pueden eliminar todas las preguntas con puntaje",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "La pregunta no pertenece al trabajo indicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Esta pregunta ya fue eliminada de la calificación",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/resolve_peer_review/{idWork}/{idStudent}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Resolve peer review",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "resolve",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.ResolvePeerReviewForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "La entrega del alumno no tiene revisiones de pares",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "La revisión de pares de este trabajo todavía no termina",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/save_answer/{idWork}/{idQuestion}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Save answer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idQuestion",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "answer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.AnswerForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "El trabajo no es de tipo formulario",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/update_group/{idWork}/{idGroup}": {
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Update group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idGroup",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WorkGroupForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "El estudiante no pertenece a este módulo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este trabajo ya está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el grupo indicado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "El estudiante ya pertenece a otro grupo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/update_rubric/{idRubric}": {
+            "put": {
+                "description": "Update a rubric template, works that already use it keep their criteria, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classroom",
+                    "works",
+                    "roles.teacher"
+                ],
+                "summary": "Update rubric template",
+                "parameters": [
+                    {
+                        "description": "Update rubric",
+                        "name": "rubric",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.RubricForm"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mongo ID Rubric",
+                        "name": "idRubric",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Bad body",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la rúbrica indicada",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/update_work/{idWork}": {
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Update work",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "work",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.UpdateWorkForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "La fecha y hora de inicio registrada es mayor a la limite",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No se puede actualizar un item que no está registrado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_appeal/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive",
+                    "roles.attorney"
+                ],
+                "summary": "Upload appeal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "appeal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WorkAppealForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Este trabajo todavía no está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "El estudiante no tiene calificación en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Ya existe una apelación abierta para este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_evaluate_files/{idWork}/{idStudent}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload evaluate files",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "evaluate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/forms.EvaluateFilesForm"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Los puntos evaluados superan el máx. del item",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el item #%s en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "No se encontraron archivos subidos por parte del alumno",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_evaluate_inperson/{idWork}/{idStudent}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload evaluate Inperson",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "evaluate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.EvaluateInperson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad body",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la programación de calificación",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "No se encontraron archivos subidos por parte del alumno",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_extension/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload extension",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "extension",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WorkExtensionForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "El estudiante no pertenece a este módulo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este trabajo ya está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_feedback/{idWork}/{idStudent}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload feedback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "feedback",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WorkFeedbackForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Solo se pueden comentar archivos en un trabajo de archivos",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "El archivo no fue subido por el alumno en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_files/{idWork}": {
+            "post": {
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Upload files",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "file"
+                        },
+                        "description": "Form Data in files[]",
+                        "name": "files[]",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "413": {
+                        "description": "Solo se puede subir hasta 3 archivos por trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_group/{idWork}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WorkGroupForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "El estudiante no pertenece a este módulo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Este trabajo ya está evaluado",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "El estudiante ya tiene una entrega en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_peer_review/{idWork}/{idReview}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.student",
+                    "roles.student_directive"
+                ],
+                "summary": "Upload peer review",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idReview",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "evaluate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/forms.EvaluateFilesForm"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "La revisión debe evaluar todos los items de la pauta",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "La revisión de pares de este trabajo ya terminó",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la revisión indicada",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_points_question/{idWork}/{idQuestion}/{idStudent}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload points question",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idQuestion",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "points",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.EvaluateQuestion"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Puntaje fuera de rango. Debe ser entre cero y máx %v",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Todavía no se pueden evaluar preguntas en este formulario",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe la programación de calificación",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_reevaluate_files/{idWork}/{idStudent}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload reevaluate Person",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idWork",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idStudent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "evaluate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.EvaluateInperson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Los puntos evaluados superan el máx. del item",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No existe el item #%s en este trabajo",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "No se encontraron archivos subidos por parte del alumno",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_rubric": {
+            "post": {
+                "description": "Upload a rubric template reusable in files works, ROLS=[teacher]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classroom",
+                    "works",
+                    "roles.teacher"
+                ],
+                "summary": "Upload rubric template",
+                "parameters": [
+                    {
+                        "description": "Add rubric",
+                        "name": "rubric",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.RubricForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/res.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/smaps.IdInsertedMap"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Bad body",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/upload_work/{idModule}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "works",
+                    "classroom",
+                    "roles.teacher"
+                ],
+                "summary": "Upload work",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "MongoID",
+                        "name": "idModule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desc",
+                        "name": "work",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/forms.WorkForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad path param",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized role",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - NATS || DB Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/res.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "forms.AcumulativeForm": {
+            "type": "object",
+            "required": [
+                "number",
+                "percentage"
+            ],
+            "properties": {
+                "number": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 45
+                }
+            }
+        },
+        "forms.Answer": {
+            "type": "object",
+            "required": [
+                "question"
+            ],
+            "properties": {
+                "answer": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        0,
+                        2
+                    ]
+                },
+                "number": {
+                    "type": "number",
+                    "example": 3.14
+                },
+                "question": {
+                    "type": "string"
+                },
+                "response": {
+                    "type": "string",
+                    "example": "Response..."
+                }
+            }
+        },
+        "forms.AnswerForm": {
+            "description": "answers: multiple_select =\u003e selected answers, matching =\u003e option of each answer, ordering =\u003e answers in order",
+            "type": "object",
+            "properties": {
+                "answer": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        0,
+                        2
+                    ]
+                },
+                "number": {
+                    "type": "number",
+                    "example": 3.14
+                },
+                "response": {
+                    "type": "string",
+                    "example": "Response..."
+                }
+            }
+        },
+        "forms.AnswerWorkAppealForm": {
+            "type": "object",
+            "required": [
+                "accept"
+            ],
+            "properties": {
+                "accept": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "La pauta no considera ese desarrollo"
+                }
+            }
+        },
+        "forms.AnswersForm": {
+            "type": "object",
+            "required": [
+                "answers"
+            ],
+            "properties": {
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.Answer"
+                    }
+                }
+            }
+        },
+        "forms.ApplyProgramTemplatesForm": {
+            "type": "object",
+            "properties": {
+                "replace": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "forms.Attached": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "file": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "link": {
+                    "type": "string",
+                    "example": "https://example.com"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Title!"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "file"
+                }
+            }
+        },
+        "forms.BankQuestionForm": {
+            "type": "object",
+            "required": [
+                "difficulty",
+                "question",
+                "subject"
+            ],
+            "properties": {
+                "difficulty": {
+                    "type": "string",
+                    "enum": [
+                        "easy",
+                        "medium",
+                        "hard"
+                    ],
+                    "example": "medium"
+                },
+                "question": {
+                    "$ref": "#/definitions/forms.QuestionForm"
+                },
+                "subject": {
+                    "type": "string",
+                    "example": "637846a8bc4ea33de990c098"
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "algebra",
+                        "ecuaciones"
+                    ]
+                }
+            }
+        },
+        "forms.EvaluateFilesForm": {
+            "type": "object",
+            "required": [
+                "pattern"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Buen análisis, faltan fuentes"
+                },
+                "level": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "pattern": {
+                    "type": "string",
+                    "example": "Pattern"
+                },
+                "points": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 25
+                }
+            }
+        },
+        "forms.EvaluateInperson": {
+            "type": "object",
+            "required": [
+                "block",
+                "in_date",
+                "pregrade"
+            ],
+            "properties": {
+                "block": {
+                    "type": "string"
+                },
+                "in_date": {
+                    "type": "string"
+                },
+                "pregrade": {
+                    "type": "number",
+                    "example": 6.5
+                }
+            }
+        },
+        "forms.EvaluateQuestion": {
+            "type": "object",
+            "required": [
+                "points"
+            ],
+            "properties": {
+                "points": {
+                    "type": "integer",
+                    "example": 25
+                }
+            }
+        },
+        "forms.FormForm": {
+            "type": "object",
+            "required": [
+                "has_points",
+                "items",
+                "title"
+            ],
+            "properties": {
+                "has_points": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/forms.ItemForm"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Form title"
+                }
+            }
+        },
+        "forms.GradeForm": {
+            "type": "object",
+            "required": [
+                "grade",
+                "program"
+            ],
+            "properties": {
+                "acumulative": {
+                    "type": "string",
+                    "example": "637ab12ae976057567a4d67d"
+                },
+                "draft": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "grade": {
+                    "type": "number",
+                    "example": 70
+                },
+                "program": {
+                    "type": "string",
+                    "example": "637ab12ae976057567a4d67d"
+                }
+            }
+        },
+        "forms.GradeProgramForm": {
+            "type": "object",
+            "required": [
+                "is_acumulative",
+                "number",
+                "percentage"
+            ],
+            "properties": {
+                "acumulative": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.AcumulativeForm"
+                    }
+                },
+                "is_acumulative": {
+                    "type": "boolean"
+                },
+                "number": {
+                    "type": "integer",
+                    "maximum": 30,
+                    "minimum": 1,
+                    "example": 1
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 25
+                }
+            }
+        },
+        "forms.GradeProgramTemplateForm": {
+            "type": "object",
+            "required": [
+                "name",
+                "programs"
+            ],
+            "properties": {
+                "course": {
+                    "type": "string",
+                    "example": "637ab12ae976057567a4d67d"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Plantilla Matemáticas"
+                },
+                "programs": {
+                    "type": "array",
+                    "maxItems": 30,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/forms.GradeProgramForm"
+                    }
+                },
+                "subject": {
+                    "type": "string",
+                    "example": "637ab12ae976057567a4d67d"
+                }
+            }
+        },
+        "forms.GradingScaleForm": {
+            "type": "object",
+            "required": [
+                "max",
+                "min",
+                "type"
+            ],
+            "properties": {
+                "max": {
+                    "type": "number",
+                    "example": 7
+                },
+                "min": {
+                    "type": "number",
+                    "example": 1
+                },
+                "pass_grade": {
+                    "type": "number",
+                    "example": 4
+                },
+                "table": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 2,
+                    "items": {
+                        "$ref": "#/definitions/forms.GradingScaleRange"
+                    }
+                },
+                "threshold": {
+                    "type": "number",
+                    "example": 60
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "linear",
+                        "threshold",
+                        "table"
+                    ],
+                    "example": "threshold"
+                }
+            }
+        },
+        "forms.GradingScaleRange": {
+            "type": "object",
+            "required": [
+                "from",
+                "grade"
+            ],
+            "properties": {
+                "from": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0,
+                    "example": 60
+                },
+                "grade": {
+                    "type": "number",
+                    "example": 4
+                }
+            }
+        },
+        "forms.GroupAdjustmentForm": {
+            "type": "object",
+            "required": [
+                "adjustment"
+            ],
+            "properties": {
+                "adjustment": {
+                    "type": "number",
+                    "example": -0.5
+                },
+                "comment": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "example": "No participó en la presentación"
+                }
+            }
+        },
+        "forms.ItemForm": {
+            "description": "points required if type == \"equal\"",
+            "type": "object",
+            "required": [
+                "_id",
+                "points",
+                "questions",
+                "title"
+            ],
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637846a8bc4ea33de990c098"
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "points_type": {
+                    "type": "string",
+                    "enum": [
+                        "without",
+                        "equal",
+                        "custom"
+                    ],
+                    "example": "without"
+                },
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.QuestionForm"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Item Form Title !"
+                }
+            }
+        },
+        "forms.PublishWorkForm": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                }
+            }
+        },
+        "forms.QuestionForm": {
+            "description": "correct required if type == \"alternatives_correct\" || \"true_false\" (0 = true, 1 = false) corrects required if type == \"multiple_select\" || \"matching\" || \"ordering\" corrects: multiple_select =\u003e correct answers, matching =\u003e option of each answer, ordering =\u003e answers in order options required if type == \"matching\" number required if type == \"numeric\" points required if (father)item.type != \"equal\" question and type are taken from the question bank if bank is sent",
+            "type": "object",
+            "required": [
+                "question",
+                "type"
+            ],
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637846a8bc4ea33de990c098"
+                },
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "a",
+                        " b",
+                        " c"
+                    ]
+                },
+                "bank": {
+                    "type": "string",
+                    "example": "637846a8bc4ea33de990c098"
+                },
+                "bank_mode": {
+                    "type": "string",
+                    "enum": [
+                        "copy",
+                        "reference"
+                    ],
+                    "example": "reference"
+                },
+                "correct": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "corrects": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        0,
+                        2
+                    ]
+                },
+                "number": {
+                    "type": "number",
+                    "example": 3.14
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "a",
+                        " b",
+                        " c"
+                    ]
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 21
+                },
+                "question": {
+                    "type": "string",
+                    "minLength": 3,
+                    "example": "Who is...?"
+                },
+                "tolerance": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 0.01
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "alternatives",
+                        "alternatives_correct",
+                        "written",
+                        "multiple_select",
+                        "numeric",
+                        "true_false",
+                        "matching",
+                        "ordering"
+                    ],
+                    "example": "alternatives"
+                }
+            }
+        },
+        "forms.ReleaseGradesForm": {
+            "type": "object",
+            "properties": {
+                "acumulative": {
+                    "type": "string",
+                    "example": "637ab12ae976057567a4d67d"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                }
+            }
+        },
+        "forms.ResolvePeerReviewForm": {
+            "type": "object",
+            "required": [
+                "mode"
+            ],
+            "properties": {
+                "evaluate": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.EvaluateFilesForm"
+                    }
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "accept",
+                        "blend",
+                        "override"
+                    ],
+                    "example": "blend"
+                },
+                "peer_weight": {
+                    "type": "integer",
+                    "maximum": 99,
+                    "minimum": 1,
+                    "example": 30
+                }
+            }
+        },
+        "forms.RubricForm": {
+            "type": "object",
+            "required": [
+                "criteria",
+                "title"
+            ],
+            "properties": {
+                "criteria": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkPatternFiles"
+                    }
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 300,
+                    "example": "This is a description..."
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Rúbrica de ensayo"
+                }
+            }
+        },
+        "forms.SelfWorkGroupForm": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 1,
+                    "example": "Grupo 1"
+                }
+            }
+        },
+        "forms.UpdateGradeForm": {
+            "type": "object",
+            "required": [
+                "grade"
+            ],
+            "properties": {
+                "grade": {
+                    "type": "number",
+                    "example": 55
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 300,
+                    "example": "Error de digitación"
+                }
+            }
+        },
+        "forms.UpdateWorkForm": {
+            "type": "object",
+            "properties": {
+                "attached": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.Attached"
+                    }
+                },
+                "attempts": {
+                    "$ref": "#/definitions/forms.WorkAttempts"
+                },
+                "date_limit": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                },
+                "date_start": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "example": "This is a description..."
+                },
+                "form": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "form_access": {
+                    "type": "string",
+                    "enum": [
+                        "default",
+                        "wtime"
+                    ]
+                },
+                "form_shuffle": {
+                    "$ref": "#/definitions/forms.WorkFormShuffle"
+                },
+                "grade": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "groups": {
+                    "$ref": "#/definitions/forms.WorkGroups"
+                },
+                "late_policy": {
+                    "$ref": "#/definitions/forms.WorkLatePolicy"
+                },
+                "pattern": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkPatternWIDFiles"
+                    }
+                },
+                "peer_review": {
+                    "$ref": "#/definitions/forms.WorkPeerReview"
+                },
+                "remove_late_policy": {
+                    "type": "boolean"
+                },
+                "rubric": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkSession"
+                    }
+                },
+                "time_access": {
+                    "description": "Seconds",
+                    "type": "integer",
+                    "example": 3600
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Title!"
+                }
+            }
+        },
+        "forms.WorkAppealForm": {
+            "type": "object",
+            "required": [
+                "justification"
+            ],
+            "properties": {
+                "justification": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 1,
+                    "example": "La pregunta 3 está bien desarrollada"
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "forms.WorkAttempts": {
+            "type": "object",
+            "required": [
+                "quantity",
+                "scoring"
+            ],
+            "properties": {
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1,
+                    "example": 3
+                },
+                "scoring": {
+                    "type": "string",
+                    "enum": [
+                        "best",
+                        "last",
+                        "average"
+                    ],
+                    "example": "best"
+                }
+            }
+        },
+        "forms.WorkCloneForm": {
+            "type": "object",
+            "required": [
+                "targets"
+            ],
+            "properties": {
+                "date_publish": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                },
+                "draft": {
+                    "type": "boolean"
+                },
+                "offset": {
+                    "type": "integer",
+                    "maximum": 730,
+                    "minimum": -730,
+                    "example": 182
+                },
+                "targets": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkCloneTarget"
+                    }
+                }
+            }
+        },
+        "forms.WorkCloneTarget": {
+            "type": "object",
+            "required": [
+                "module"
+            ],
+            "properties": {
+                "grade": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "forms.WorkExtensionForm": {
+            "type": "object",
+            "required": [
+                "student"
+            ],
+            "properties": {
+                "date_limit": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 300,
+                    "example": "Licencia médica"
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "time_access": {
+                    "description": "Seconds",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 3600
+                }
+            }
+        },
+        "forms.WorkFeedbackForm": {
+            "type": "object",
+            "required": [
+                "comment"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 1,
+                    "example": "Buen desarrollo, revisa la conclusión"
+                },
+                "file": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "question": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "forms.WorkForm": {
+            "type": "object",
+            "required": [
+                "date_limit",
+                "date_start",
+                "is_qualified",
+                "title",
+                "type",
+                "virtual"
+            ],
+            "properties": {
+                "acumulative": {
+                    "type": "string"
+                },
+                "attached": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.Attached"
+                    }
+                },
+                "attempts": {
+                    "$ref": "#/definitions/forms.WorkAttempts"
+                },
+                "date_limit": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                },
+                "date_publish": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                },
+                "date_start": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "example": "This is a description..."
+                },
+                "draft": {
+                    "type": "boolean"
+                },
+                "form": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "form_access": {
+                    "type": "string",
+                    "enum": [
+                        "default",
+                        "wtime"
+                    ],
+                    "example": "wtime"
+                },
+                "form_shuffle": {
+                    "$ref": "#/definitions/forms.WorkFormShuffle"
+                },
+                "grade": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "groups": {
+                    "$ref": "#/definitions/forms.WorkGroups"
+                },
+                "is_qualified": {
+                    "type": "boolean"
+                },
+                "late_policy": {
+                    "$ref": "#/definitions/forms.WorkLatePolicy"
+                },
+                "pattern": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkPatternFiles"
+                    }
+                },
+                "peer_review": {
+                    "$ref": "#/definitions/forms.WorkPeerReview"
+                },
+                "rubric": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkSession"
+                    }
+                },
+                "time_access": {
+                    "description": "Seconds",
+                    "type": "integer",
+                    "example": 3600
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Title!"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "files",
+                        "form"
+                    ],
+                    "example": "files"
+                },
+                "virtual": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "forms.WorkFormDraw": {
+            "type": "object",
+            "required": [
+                "item",
+                "quantity"
+            ],
+            "properties": {
+                "item": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 5
+                }
+            }
+        },
+        "forms.WorkFormShuffle": {
+            "type": "object",
+            "properties": {
+                "answers": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "draws": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkFormDraw"
+                    }
+                },
+                "items": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "questions": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "forms.WorkGroupForm": {
+            "type": "object",
+            "required": [
+                "members",
+                "name"
+            ],
+            "properties": {
+                "members": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "637d5de216f58bc8ec7f7f51"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 1,
+                    "example": "Grupo 1"
+                }
+            }
+        },
+        "forms.WorkGroups": {
+            "type": "object",
+            "required": [
+                "max_size",
+                "min_size",
+                "mode"
+            ],
+            "properties": {
+                "max_size": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1,
+                    "example": 4
+                },
+                "min_size": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1,
+                    "example": 2
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "teacher",
+                        "self"
+                    ],
+                    "example": "self"
+                }
+            }
+        },
+        "forms.WorkLatePolicy": {
+            "type": "object",
+            "required": [
+                "cap",
+                "penalty",
+                "unit",
+                "window"
+            ],
+            "properties": {
+                "cap": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 20
+                },
+                "penalty": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 5
+                },
+                "unit": {
+                    "type": "string",
+                    "enum": [
+                        "day",
+                        "hour"
+                    ],
+                    "example": "day"
+                },
+                "window": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 48
+                }
+            }
+        },
+        "forms.WorkPatternFiles": {
+            "type": "object",
+            "required": [
+                "description",
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 300,
+                    "minLength": 1,
+                    "example": "This is a description..."
+                },
+                "levels": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 2,
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkPatternLevel"
+                    }
+                },
+                "points": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 25
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Title!"
+                }
+            }
+        },
+        "forms.WorkPatternLevel": {
+            "type": "object",
+            "required": [
+                "points",
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 300,
+                    "example": "Explica el fenómeno con ejemplos"
+                },
+                "points": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 25
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Logrado"
+                }
+            }
+        },
+        "forms.WorkPatternWIDFiles": {
+            "type": "object",
+            "required": [
+                "description",
+                "title"
+            ],
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 300,
+                    "minLength": 1,
+                    "example": "This is a description..."
+                },
+                "levels": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 2,
+                    "items": {
+                        "$ref": "#/definitions/forms.WorkPatternLevel"
+                    }
+                },
+                "points": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 25
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Title!"
+                }
+            }
+        },
+        "forms.WorkPeerReview": {
+            "type": "object",
+            "required": [
+                "date_limit",
+                "reviewers"
+            ],
+            "properties": {
+                "date_limit": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04"
+                },
+                "reviewers": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "example": 2
+                }
+            }
+        },
+        "forms.WorkSession": {
+            "type": "object",
+            "required": [
+                "block",
+                "dates"
+            ],
+            "properties": {
+                "block": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "dates": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "forms.WorkSimilarityForm": {
+            "type": "object",
+            "properties": {
+                "threshold": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0.1,
+                    "example": 0.5
+                }
+            }
+        },
+        "models.Acumulative": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637850aafa8118c9f0f1202a"
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 20
+                }
+            }
+        },
+        "models.Answer": {
+            "type": "object",
+            "properties": {
+                "answer": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "x-omitempty": true,
+                    "example": [
+                        0,
+                        2
+                    ]
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "number": {
+                    "type": "number",
+                    "x-omitempty": true,
+                    "example": 3.14
+                },
+                "question": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "response": {
+                    "type": "string",
+                    "example": "Response..."
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "work": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.Attached": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "file": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "link": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "https://example.com"
+                },
+                "title": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "This is a title"
+                },
+                "type": {
+                    "description": "Link or file",
+                    "type": "string",
+                    "enum": [
+                        "link",
+                        "file"
+                    ],
+                    "example": "link"
+                }
+            }
+        },
+        "models.BankQuestionWLookup": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "author": {
+                    "$ref": "#/definitions/models.SimpleUser"
+                },
+                "difficulty": {
+                    "type": "string",
+                    "enum": [
+                        "easy",
+                        "medium",
+                        "hard"
+                    ],
+                    "example": "medium"
+                },
+                "question": {
+                    "$ref": "#/definitions/models.ItemQuestion"
+                },
+                "subject": {
+                    "$ref": "#/definitions/models.Subject"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "algebra",
+                        "ecuaciones"
+                    ]
+                },
+                "update_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "upload_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                }
+            }
+        },
+        "models.CalendarBlock": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "hour_finish": {
+                    "type": "string"
+                },
+                "hour_start": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Course": {
+            "type": "object",
+            "properties": {
+                "__v": {
+                    "type": "integer"
+                },
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "course": {
+                    "type": "string",
+                    "example": "First"
+                }
+            }
+        },
+        "models.EvaluatedFiles": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "comment": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "Buen análisis, faltan fuentes"
+                },
+                "level": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "pattern": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 25
+                }
+            }
+        },
+        "models.File": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "filename.png"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "$53r34re"
+                },
+                "permissions": {
+                    "type": "string",
+                    "enum": [
+                        "private",
+                        "public",
+                        "public_classroom"
+                    ],
+                    "example": "private"
+                },
+                "status": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Title"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "application/pdf"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/file"
+                }
+            }
+        },
+        "models.FileUploadedClassroomWLookup": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_update": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "evaluate": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EvaluatedFiles"
+                    },
+                    "x-omitempty": true
+                },
+                "files_uploaded": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.File"
+                    }
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "work": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.Form": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "6376c8283cc695e19d785b08"
+                },
+                "author": {
+                    "type": "string",
+                    "example": "6376c8283cc695e19d785b08"
+                },
+                "has_points": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FormItem"
+                    }
+                },
+                "status": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "This is a form!"
+                },
+                "update_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "upload_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                }
+            }
+        },
+        "models.FormAccess": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "attempt": {
+                    "type": "integer",
+                    "x-omitempty": true,
+                    "example": 2
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_finish": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "opened",
+                        "finished",
+                        "revised"
+                    ],
+                    "example": "opened"
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "work": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.FormItem": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "6376c8283cc695e19d785b08"
+                },
+                "points_type": {
+                    "type": "string",
+                    "x-omitempty": true
+                },
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "6376c8283cc695e19d785b08"
+                    ]
+                },
+                "title": {
+                    "type": "string",
+                    "example": "This is a item form!"
+                }
+            }
+        },
+        "models.FormItemWLookup": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "6376c8283cc695e19d785b08"
+                },
+                "points_type": {
+                    "type": "string",
+                    "x-omitempty": true
+                },
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ItemQuestion"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "This is a item title!"
+                }
+            }
+        },
+        "models.FormWLookup": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "6376c8283cc695e19d785b08"
+                },
+                "has_points": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FormItemWLookup"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "This is a form!"
+                },
+                "update_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "upload_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                }
+            }
+        },
+        "models.GradeHistoryWLookup": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "actor": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.SimpleUser"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "collection": {
+                    "type": "string",
+                    "enum": [
+                        "grades",
+                        "work_grades"
+                    ],
+                    "example": "grades"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "grade": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "new_grade": {
+                    "type": "number",
+                    "example": 5.2
+                },
+                "old_grade": {
+                    "type": "number",
+                    "x-omitempty": true,
+                    "example": 4.5
+                },
+                "reason": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "Error de digitación"
+                },
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "manual",
+                        "evaluation",
+                        "reevaluation",
+                        "close_grades_semester"
+                    ],
+                    "example": "manual"
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.GradeProgramTemplate": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "author": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "course": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Plantilla Matemáticas"
+                },
+                "programs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TemplateProgram"
+                    }
+                },
+                "subject": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "update_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                }
+            }
+        },
+        "models.GradesProgram": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637850aafa8118c9f0f1202a"
+                },
+                "acumulative": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Acumulative"
+                    },
+                    "x-omitempty": true
+                },
+                "is_acumulative": {
+                    "type": "boolean"
+                },
+                "module": {
+                    "type": "string",
+                    "example": "637850aafa8118c9f0f1202a"
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 25
+                }
+            }
+        },
+        "models.GradingScale": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "author": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "date": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "max": {
+                    "type": "number",
+                    "example": 7
+                },
+                "min": {
+                    "type": "number",
+                    "example": 1
+                },
+                "module": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "pass_grade": {
+                    "type": "number",
+                    "x-omitempty": true,
+                    "example": 4
+                },
+                "semester": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "table": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GradingScaleRange"
+                    },
+                    "x-omitempty": true
+                },
+                "threshold": {
+                    "type": "number",
+                    "x-omitempty": true,
+                    "example": 60
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "linear",
+                        "threshold",
+                        "table"
+                    ],
+                    "example": "threshold"
+                }
+            }
+        },
+        "models.GradingScaleRange": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "number",
+                    "example": 60
+                },
+                "grade": {
+                    "type": "number",
+                    "example": 4
+                }
+            }
+        },
+        "models.GroupAdjustment": {
+            "type": "object",
+            "properties": {
+                "adjustment": {
+                    "type": "number",
+                    "example": -0.5
+                },
+                "comment": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "No participó en la presentación"
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.ItemQuestion": {
+            "description": "Points is null if (father)form.has_points = false Answers is null if question.type=written || numeric Correct is null if question.type!=alternatives_correct \u0026\u0026 true_false Corrects is null if question.type!=multiple_select \u0026\u0026 matching \u0026\u0026 ordering Options is null if question.type!=matching Number and Tolerance are null if question.type!=numeric Bank is null if the question is not imported by reference from the question bank",
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "6376c8283cc695e19d785b08"
+                },
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-omitempty": true,
+                    "example": [
+                        "a",
+                        " b"
+                    ]
+                },
+                "bank": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "6376c8283cc695e19d785b08"
+                },
+                "correct": {
+                    "type": "integer",
+                    "x-omitempty": true,
+                    "example": 2
+                },
+                "corrects": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "x-omitempty": true,
+                    "example": [
+                        0,
+                        2
+                    ]
+                },
+                "number": {
+                    "type": "number",
+                    "x-omitempty": true,
+                    "example": 3.14
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-omitempty": true,
+                    "example": [
+                        "a",
+                        " b"
+                    ]
+                },
+                "points": {
+                    "type": "integer",
+                    "x-omitempty": true,
+                    "example": 25
+                },
+                "question": {
+                    "type": "string",
+                    "example": "Whats your name?"
+                },
+                "tolerance": {
+                    "type": "number",
+                    "x-omitempty": true,
+                    "example": 0.01
+                },
+                "type": {
+                    "type": "string",
+                    "example": "alternatives_correct"
+                }
+            }
+        },
+        "models.ModuleWithLookup": {
+            "type": "object",
+            "properties": {
+                "__v": {
+                    "type": "integer"
+                },
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "section": {
+                    "$ref": "#/definitions/models.Section"
+                },
+                "semester": {
+                    "$ref": "#/definitions/models.Semester"
+                },
+                "status": {
+                    "type": "boolean"
+                },
+                "students": {
+                    "description": "If parent - Student Assigned",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SimpleUser"
+                    }
+                },
+                "sub_sections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SubSection"
+                    }
+                },
+                "subject": {
+                    "$ref": "#/definitions/models.Subject"
+                },
+                "works": {
+                    "description": "Works",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Work"
+                    },
+                    "x-omitempty": true
+                }
+            }
+        },
+        "models.PeerReview": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_review": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "evaluate": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EvaluatedFiles"
+                    },
+                    "x-omitempty": true
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "reviewer": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "submission": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "work": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.RegisteredCalendarBlock": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "block": {
+                    "$ref": "#/definitions/models.CalendarBlock"
+                },
+                "day": {
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Rubric": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "author": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "criteria": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkPattern"
+                    }
+                },
+                "description": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "This is a description"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Rúbrica de ensayo"
+                },
+                "update_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "upload_date": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                }
+            }
+        },
+        "models.Section": {
+            "type": "object",
+            "properties": {
+                "__v": {
+                    "type": "integer"
+                },
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "course": {
+                    "$ref": "#/definitions/models.Course"
+                },
+                "file": {
+                    "$ref": "#/definitions/models.File"
+                },
+                "section": {
+                    "type": "string",
+                    "example": "A"
+                }
+            }
+        },
+        "models.Semester": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "semester": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "year": {
+                    "type": "integer",
+                    "example": 2022
+                }
+            }
+        },
+        "models.SemesterClose": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "attempts": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "averages": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "date_finish": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_start": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_update": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "error": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "no se pudo calcular los promedios"
+                },
+                "modules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SemesterCloseModule"
+                    }
+                },
+                "semester": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "running",
+                        "completed",
+                        "failed"
+                    ],
+                    "example": "running"
+                }
+            }
+        },
+        "models.SemesterCloseModule": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "error": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "no se pudo obtener los estudiantes"
+                },
+                "filled": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "completed",
+                        "failed"
+                    ],
+                    "example": "completed"
+                }
+            }
+        },
+        "models.SessionWLookup": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "block": {
+                    "$ref": "#/definitions/models.RegisteredCalendarBlock"
+                },
+                "date": {
+                    "type": "integer"
+                },
+                "file": {
+                    "type": "string"
+                },
+                "in_date": {
+                    "type": "integer"
+                },
+                "pregrade": {
+                    "type": "number"
+                },
+                "student": {
+                    "type": "string"
+                },
+                "work": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SimilarityOmitted": {
+            "type": "object",
+            "properties": {
+                "file": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "tipo de archivo no soportado"
+                },
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.SimilarityPair": {
+            "type": "object",
+            "properties": {
+                "question": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "similarity": {
+                    "type": "number",
+                    "example": 0.82
+                },
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "written",
+                        "files"
+                    ],
+                    "example": "written"
+                },
+                "student_a": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "student_b": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "models.SimpleUser": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "63785424db1efbc237faecca"
+                },
+                "first_lastname": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "FirstLastname"
+                },
+                "name": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "Name"
+                },
+                "rut": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "12345678-9"
+                },
+                "second_lastname": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "SecondLastname"
+                }
+            }
+        },
+        "models.SubSection": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Math - Geo"
+                }
+            }
+        },
+        "models.Subject": {
+            "type": "object",
+            "properties": {
+                "__v": {
+                    "type": "integer"
+                },
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "subject": {
+                    "type": "string",
+                    "example": "Math"
+                }
+            }
+        },
+        "models.TemplateAcumulative": {
+            "type": "object",
+            "properties": {
+                "number": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 20
+                }
+            }
+        },
+        "models.TemplateProgram": {
+            "type": "object",
+            "properties": {
+                "acumulative": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TemplateAcumulative"
+                    },
+                    "x-omitempty": true
+                },
+                "is_acumulative": {
+                    "type": "boolean"
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 25
+                }
+            }
+        },
+        "models.Work": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "acumulative": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "attached": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Attached"
+                    },
+                    "x-omitempty": true
+                },
+                "attempts": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkAttempts"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "author": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "date_limit": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_publish": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_start": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_update": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_upload": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "description": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "This is a description"
+                },
+                "draft": {
+                    "type": "boolean",
+                    "x-omitempty": true
+                },
+                "form": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "form_access": {
+                    "type": "string",
+                    "enum": [
+                        "default",
+                        "wtime"
+                    ],
+                    "x-omitempty": true,
+                    "example": "default"
+                },
+                "form_shuffle": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkFormShuffle"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "grade": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "groups": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkGroups"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "is_qualified": {
+                    "type": "boolean"
+                },
+                "is_revised": {
+                    "type": "boolean"
+                },
+                "late_policy": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkLatePolicy"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "pattern": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkPattern"
+                    },
+                    "x-omitempty": true
+                },
+                "peer_review": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkPeerReview"
+                        }
+                    ],
+                    "x-omitempty": true
                 },
-                "response": {
-                    "type": "string",
-                    "example": "Response..."
-                }
-            }
-        },
-        "forms.AnswersForm": {
-            "type": "object",
-            "required": [
-                "answers"
-            ],
-            "properties": {
-                "answers": {
+                "removed_questions": {
+                    "description": "Questions out of the grade after the item analysis",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/forms.Answer"
+                        "type": "string"
+                    },
+                    "x-omitempty": true,
+                    "example": [
+                        "637d5de216f58bc8ec7f7f51"
+                    ]
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkSession"
                     }
-                }
-            }
-        },
-        "forms.Attached": {
-            "type": "object",
-            "required": [
-                "type"
-            ],
-            "properties": {
-                "file": {
-                    "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "link": {
-                    "type": "string",
-                    "example": "https://example.com"
+                "time_access": {
+                    "type": "integer",
+                    "x-omitempty": true,
+                    "example": 2
                 },
                 "title": {
                     "type": "string",
-                    "example": "Title!"
+                    "example": "Work!"
                 },
                 "type": {
                     "type": "string",
-                    "example": "file"
-                }
-            }
-        },
-        "forms.EvaluateFilesForm": {
-            "type": "object",
-            "required": [
-                "pattern",
-                "points"
-            ],
-            "properties": {
-                "pattern": {
-                    "type": "string",
-                    "example": "Pattern"
+                    "enum": [
+                        "files",
+                        "form"
+                    ],
+                    "example": "form"
                 },
-                "points": {
-                    "type": "integer",
-                    "minimum": 0,
-                    "example": 25
+                "virtual": {
+                    "type": "boolean"
                 }
             }
         },
-        "forms.EvaluateQuestion": {
+        "models.WorkAttempts": {
             "type": "object",
-            "required": [
-                "points"
-            ],
             "properties": {
-                "points": {
+                "quantity": {
                     "type": "integer",
-                    "example": 25
-                }
-            }
-        },
-        "forms.FormForm": {
-            "type": "object",
-            "required": [
-                "has_points",
-                "items",
-                "title"
-            ],
-            "properties": {
-                "has_points": {
-                    "type": "string"
-                },
-                "items": {
-                    "type": "array",
-                    "minItems": 1,
-                    "items": {
-                        "$ref": "#/definitions/forms.ItemForm"
-                    }
+                    "example": 3
                 },
-                "title": {
+                "scoring": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3,
-                    "example": "Form title"
+                    "enum": [
+                        "best",
+                        "last",
+                        "average"
+                    ],
+                    "example": "best"
                 }
             }
         },
-        "forms.GradeForm": {
+        "models.WorkExtensionWLookup": {
             "type": "object",
-            "required": [
-                "grade",
-                "program"
-            ],
             "properties": {
-                "acumulative": {
+                "_id": {
                     "type": "string",
-                    "example": "637ab12ae976057567a4d67d"
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "grade": {
-                    "type": "number",
-                    "example": 70
+                "author": {
+                    "$ref": "#/definitions/models.SimpleUser"
                 },
-                "program": {
+                "date": {
                     "type": "string",
-                    "example": "637ab12ae976057567a4d67d"
-                }
-            }
-        },
-        "forms.GradeProgramForm": {
-            "type": "object",
-            "required": [
-                "is_acumulative",
-                "number",
-                "percentage"
-            ],
-            "properties": {
-                "acumulative": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/forms.AcumulativeForm"
-                    }
+                    "example": "2022-09-21T20:10:23.309+00:00"
                 },
-                "is_acumulative": {
-                    "type": "boolean"
+                "date_limit": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
                 },
-                "number": {
+                "reason": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "Licencia médica"
+                },
+                "student": {
+                    "$ref": "#/definitions/models.SimpleUser"
+                },
+                "time_access": {
                     "type": "integer",
-                    "maximum": 30,
-                    "minimum": 1,
-                    "example": 1
+                    "x-omitempty": true,
+                    "example": 3600
                 },
-                "percentage": {
-                    "type": "number",
-                    "example": 25
+                "work": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
                 }
             }
         },
-        "forms.ItemForm": {
-            "description": "points required if type == \"equal\"",
+        "models.WorkFeedback": {
             "type": "object",
-            "required": [
-                "_id",
-                "points",
-                "questions",
-                "title"
-            ],
             "properties": {
                 "_id": {
                     "type": "string",
-                    "example": "637846a8bc4ea33de990c098"
-                },
-                "points": {
-                    "type": "integer",
-                    "example": 25
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "points_type": {
+                "comment": {
                     "type": "string",
-                    "enum": [
-                        "without",
-                        "equal",
-                        "custom"
-                    ],
-                    "example": "without"
-                },
-                "questions": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/forms.QuestionForm"
-                    }
+                    "example": "Buen desarrollo, revisa la conclusión"
                 },
-                "title": {
+                "date": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3,
-                    "example": "Item Form Title !"
-                }
-            }
-        },
-        "forms.QuestionForm": {
-            "description": "correct required if type == \"alternatives_correct\" points required if (father)item.type != \"equal\"",
-            "type": "object",
-            "required": [
-                "question",
-                "type"
-            ],
-            "properties": {
-                "_id": {
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "evaluator": {
                     "type": "string",
-                    "example": "637846a8bc4ea33de990c098"
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "answers": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "a",
-                        " b",
-                        " c"
-                    ]
+                "file": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "correct": {
-                    "type": "integer",
-                    "example": 0
+                "question": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "points": {
-                    "type": "integer",
-                    "example": 21
+                "student": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "question": {
+                "update_date": {
                     "type": "string",
-                    "minLength": 3,
-                    "example": "Who is...?"
+                    "example": "2022-09-21T20:10:23.309+00:00"
                 },
-                "type": {
+                "work": {
                     "type": "string",
-                    "enum": [
-                        "alternatives",
-                        "alternatives_correct",
-                        "written"
-                    ],
-                    "example": "alternatives"
+                    "example": "637d5de216f58bc8ec7f7f51"
                 }
             }
         },
-        "forms.UpdateGradeForm": {
+        "models.WorkFormDraw": {
             "type": "object",
-            "required": [
-                "grade"
-            ],
             "properties": {
-                "grade": {
-                    "type": "number",
-                    "example": 55
+                "item": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 5
                 }
             }
         },
-        "forms.UpdateWorkForm": {
+        "models.WorkFormShuffle": {
             "type": "object",
             "properties": {
-                "attached": {
+                "answers": {
+                    "type": "boolean"
+                },
+                "draws": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/forms.Attached"
-                    }
+                        "$ref": "#/definitions/models.WorkFormDraw"
+                    },
+                    "x-omitempty": true
                 },
-                "date_limit": {
-                    "type": "string",
-                    "example": "2006-01-02 15:04"
+                "items": {
+                    "type": "boolean"
                 },
-                "date_start": {
+                "questions": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.WorkGroup": {
+            "type": "object",
+            "properties": {
+                "_id": {
                     "type": "string",
-                    "example": "2006-01-02 15:04"
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "description": {
-                    "type": "string",
-                    "maxLength": 150,
-                    "example": "This is a description..."
+                "adjustments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GroupAdjustment"
+                    },
+                    "x-omitempty": true
                 },
-                "form": {
+                "author": {
                     "type": "string",
                     "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "form_access": {
+                "date": {
                     "type": "string",
-                    "enum": [
-                        "default",
-                        "wtime"
-                    ]
+                    "example": "2022-09-21T20:10:23.309+00:00"
                 },
-                "grade": {
+                "date_update": {
                     "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
+                    "example": "2022-09-21T20:10:23.309+00:00"
                 },
-                "pattern": {
+                "members": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/forms.WorkPatternWIDFiles"
-                    }
+                        "type": "string"
+                    },
+                    "example": [
+                        "637d5de216f58bc8ec7f7f51"
+                    ]
                 },
-                "time_access": {
-                    "description": "Seconds",
-                    "type": "integer",
-                    "example": 3600
+                "name": {
+                    "type": "string",
+                    "example": "Grupo 1"
                 },
-                "title": {
+                "work": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 1,
-                    "example": "Title!"
+                    "example": "637d5de216f58bc8ec7f7f51"
                 }
             }
         },
-        "forms.WorkForm": {
+        "models.WorkGroupWLookup": {
             "type": "object",
-            "required": [
-                "date_limit",
-                "date_start",
-                "is_qualified",
-                "title",
-                "type"
-            ],
             "properties": {
-                "acumulative": {
-                    "type": "string"
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "attached": {
+                "adjustments": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/forms.Attached"
-                    }
-                },
-                "date_limit": {
-                    "type": "string",
-                    "example": "2006-01-02 15:04"
-                },
-                "date_start": {
-                    "type": "string",
-                    "example": "2006-01-02 15:04"
-                },
-                "description": {
-                    "type": "string",
-                    "maxLength": 150,
-                    "example": "This is a description..."
+                        "$ref": "#/definitions/models.GroupAdjustment"
+                    },
+                    "x-omitempty": true
                 },
-                "form": {
+                "author": {
                     "type": "string",
                     "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "form_access": {
+                "date": {
                     "type": "string",
-                    "enum": [
-                        "default",
-                        "wtime"
-                    ],
-                    "example": "wtime"
+                    "example": "2022-09-21T20:10:23.309+00:00"
                 },
-                "grade": {
+                "date_update": {
                     "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
-                },
-                "is_qualified": {
-                    "type": "boolean"
+                    "example": "2022-09-21T20:10:23.309+00:00"
                 },
-                "pattern": {
+                "members": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/forms.WorkPatternFiles"
+                        "$ref": "#/definitions/models.SimpleUser"
                     }
                 },
-                "time_access": {
-                    "description": "Seconds",
-                    "type": "integer",
-                    "example": 3600
-                },
-                "title": {
+                "name": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 1,
-                    "example": "Title!"
+                    "example": "Grupo 1"
                 },
-                "type": {
+                "work": {
                     "type": "string",
-                    "enum": [
-                        "files",
-                        "form"
-                    ],
-                    "example": "files"
+                    "example": "637d5de216f58bc8ec7f7f51"
                 }
             }
         },
-        "forms.WorkPatternFiles": {
+        "models.WorkGroups": {
             "type": "object",
-            "required": [
-                "description",
-                "points",
-                "title"
-            ],
             "properties": {
-                "description": {
-                    "type": "string",
-                    "maxLength": 300,
-                    "minLength": 1,
-                    "example": "This is a description..."
+                "max_size": {
+                    "type": "integer",
+                    "example": 4
                 },
-                "points": {
+                "min_size": {
                     "type": "integer",
-                    "minimum": 1,
-                    "example": 25
+                    "example": 2
                 },
-                "title": {
+                "mode": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 1,
-                    "example": "Title!"
+                    "enum": [
+                        "teacher",
+                        "self"
+                    ],
+                    "example": "self"
                 }
             }
         },
-        "forms.WorkPatternWIDFiles": {
+        "models.WorkLatePolicy": {
             "type": "object",
-            "required": [
-                "description",
-                "points",
-                "title"
-            ],
             "properties": {
-                "_id": {
-                    "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
-                },
-                "description": {
-                    "type": "string",
-                    "maxLength": 300,
-                    "minLength": 1,
-                    "example": "This is a description..."
+                "cap": {
+                    "type": "number",
+                    "example": 20
                 },
-                "points": {
-                    "type": "integer",
-                    "minimum": 1,
-                    "example": 25
+                "penalty": {
+                    "type": "number",
+                    "example": 5
                 },
-                "title": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 1,
-                    "example": "Title!"
-                }
-            }
-        },
-        "models.Acumulative": {
-            "type": "object",
-            "properties": {
-                "_id": {
+                "unit": {
                     "type": "string",
-                    "example": "637850aafa8118c9f0f1202a"
+                    "enum": [
+                        "day",
+                        "hour"
+                    ],
+                    "example": "day"
                 },
-                "number": {
+                "window": {
                     "type": "integer",
-                    "example": 1
-                },
-                "percentage": {
-                    "type": "number",
-                    "example": 20
+                    "example": 48
                 }
             }
         },
-        "models.Answer": {
+        "models.WorkPattern": {
             "type": "object",
             "properties": {
-                "answer": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "date": {
-                    "type": "string",
-                    "example": "2022-09-21T20:10:23.309+00:00"
-                },
-                "id": {
+                "_id": {
                     "type": "string",
                     "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "question": {
+                "description": {
                     "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
+                    "example": "This is a description"
                 },
-                "response": {
-                    "type": "string",
-                    "example": "Response..."
+                "levels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkPatternLevel"
+                    },
+                    "x-omitempty": true
                 },
-                "student": {
-                    "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
+                "points": {
+                    "type": "integer",
+                    "example": 25
                 },
-                "work": {
+                "title": {
                     "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
+                    "example": "Pattern!"
                 }
             }
         },
-        "models.Attached": {
+        "models.WorkPatternLevel": {
             "type": "object",
             "properties": {
                 "_id": {
                     "type": "string",
                     "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "file": {
+                "description": {
                     "type": "string",
-                    "x-omitempty": true,
-                    "example": "637d5de216f58bc8ec7f7f51"
+                    "example": "Explica el fenómeno con ejemplos"
                 },
-                "link": {
-                    "type": "string",
-                    "x-omitempty": true,
-                    "example": "https://example.com"
+                "points": {
+                    "type": "integer",
+                    "example": 25
                 },
                 "title": {
                     "type": "string",
-                    "x-omitempty": true,
-                    "example": "This is a title"
-                },
-                "type": {
-                    "description": "Link or file",
-                    "type": "string",
-                    "enum": [
-                        "link",
-                        "file"
-                    ],
-                    "example": "link"
+                    "example": "Logrado"
                 }
             }
         },
-        "models.Course": {
+        "models.WorkPeerReview": {
             "type": "object",
             "properties": {
-                "__v": {
-                    "type": "integer"
+                "assigned": {
+                    "type": "boolean"
                 },
-                "_id": {
+                "date_limit": {
                     "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
+                    "example": "2022-09-21T20:10:23.309+00:00"
                 },
-                "course": {
-                    "type": "string",
-                    "example": "First"
+                "reviewers": {
+                    "type": "integer",
+                    "example": 2
                 }
             }
         },
-        "models.EvaluatedFiles": {
+        "models.WorkSession": {
             "type": "object",
             "properties": {
-                "_id": {
-                    "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
-                },
-                "pattern": {
-                    "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
+                "block": {
+                    "type": "string"
                 },
-                "points": {
-                    "type": "integer",
-                    "example": 25
+                "dates": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 }
             }
         },
-        "models.File": {
+        "models.WorkSimilarity": {
             "type": "object",
             "properties": {
                 "_id": {
                     "type": "string",
                     "example": "637d5de216f58bc8ec7f7f51"
                 },
+                "author": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
                 "date": {
                     "type": "string",
                     "example": "2022-09-21T20:10:23.309+00:00"
                 },
-                "filename": {
+                "date_finish": {
                     "type": "string",
-                    "example": "filename.png"
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
                 },
-                "key": {
-                    "type": "string",
-                    "example": "$53r34re"
+                "documents": {
+                    "type": "integer",
+                    "example": 60
                 },
-                "permissions": {
+                "error": {
                     "type": "string",
-                    "enum": [
-                        "private",
-                        "public",
-                        "public_classroom"
-                    ],
-                    "example": "private"
+                    "x-omitempty": true
                 },
-                "status": {
-                    "type": "boolean"
+                "omitted": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SimilarityOmitted"
+                    },
+                    "x-omitempty": true
                 },
-                "title": {
-                    "type": "string",
-                    "example": "Title"
+                "pairs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SimilarityPair"
+                    }
                 },
-                "type": {
+                "status": {
                     "type": "string",
-                    "example": "application/pdf"
+                    "enum": [
+                        "running",
+                        "completed",
+                        "failed"
+                    ],
+                    "example": "completed"
                 },
-                "url": {
+                "threshold": {
+                    "type": "number",
+                    "example": 0.5
+                },
+                "work": {
                     "type": "string",
-                    "example": "https://example.com/file"
+                    "example": "637d5de216f58bc8ec7f7f51"
                 }
             }
         },
-        "models.FileUploadedClassroomWLookup": {
+        "models.WorkWLookup": {
             "type": "object",
             "properties": {
                 "_id": {
                     "type": "string",
                     "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "date": {
+                "acumulative": {
                     "type": "string",
-                    "example": "2022-09-21T20:10:23.309+00:00"
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "evaluate": {
+                "attached": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.EvaluatedFiles"
-                    },
+                        "$ref": "#/definitions/models.Attached"
+                    }
+                },
+                "attempts": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkAttempts"
+                        }
+                    ],
                     "x-omitempty": true
                 },
-                "files_uploaded": {
+                "author": {
+                    "$ref": "#/definitions/models.SimpleUser"
+                },
+                "blocks": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.File"
+                        "$ref": "#/definitions/models.RegisteredCalendarBlock"
                     }
                 },
-                "student": {
+                "date_limit": {
                     "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
+                    "example": "2022-09-21T20:10:23.309+00:00"
                 },
-                "work": {
+                "date_publish": {
                     "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
-                }
-            }
-        },
-        "models.Form": {
-            "type": "object",
-            "properties": {
-                "_id": {
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "date_start": {
                     "type": "string",
-                    "example": "6376c8283cc695e19d785b08"
+                    "example": "2022-09-21T20:10:23.309+00:00"
                 },
-                "author": {
+                "date_update": {
                     "type": "string",
-                    "example": "6376c8283cc695e19d785b08"
+                    "example": "2022-09-21T20:10:23.309+00:00"
                 },
-                "has_points": {
+                "date_upload": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
+                },
+                "description": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "This is a description"
+                },
+                "draft": {
                     "type": "boolean",
-                    "example": true
+                    "x-omitempty": true
                 },
-                "items": {
+                "form": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "form_access": {
+                    "type": "string",
+                    "enum": [
+                        "default",
+                        "wtime"
+                    ],
+                    "x-omitempty": true,
+                    "example": "default"
+                },
+                "form_shuffle": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkFormShuffle"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "grade": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.GradesProgram"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "groups": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkGroups"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "is_qualified": {
+                    "type": "boolean"
+                },
+                "is_revised": {
+                    "type": "boolean"
+                },
+                "late_policy": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkLatePolicy"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "pattern": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.FormItem"
+                        "$ref": "#/definitions/models.WorkPattern"
+                    },
+                    "x-omitempty": true
+                },
+                "peer_review": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkPeerReview"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkSession"
                     }
                 },
-                "status": {
-                    "type": "boolean"
+                "time_access": {
+                    "type": "integer",
+                    "x-omitempty": true,
+                    "example": 2
                 },
                 "title": {
                     "type": "string",
-                    "example": "This is a form!"
+                    "example": "This is a title"
                 },
-                "update_date": {
+                "type": {
                     "type": "string",
-                    "example": "2022-09-21T20:10:23.309+00:00"
+                    "enum": [
+                        "files",
+                        "form"
+                    ],
+                    "example": "files"
                 },
-                "upload_date": {
-                    "type": "string",
-                    "example": "2022-09-21T20:10:23.309+00:00"
+                "virtual": {
+                    "type": "boolean"
                 }
             }
         },
-        "models.FormAccess": {
+        "res.Response": {
             "type": "object",
             "properties": {
-                "_id": {
-                    "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
+                "body": {
+                    "type": "object",
+                    "additionalProperties": true
                 },
-                "date": {
-                    "type": "string",
-                    "example": "2022-09-21T20:10:23.309+00:00"
+                "message": {
+                    "type": "string"
                 },
-                "status": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "services.AlternativeAnalysis": {
+            "type": "object",
+            "properties": {
+                "answer": {
                     "type": "string",
-                    "enum": [
-                        "opened",
-                        "finished",
-                        "revised"
-                    ],
-                    "example": "opened"
+                    "example": "Santiago"
                 },
-                "student": {
+                "correct": {
+                    "type": "boolean"
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "percentage": {
+                    "type": "number",
+                    "example": 40
+                }
+            }
+        },
+        "services.AnswerRes": {
+            "type": "object",
+            "properties": {
+                "answer": {
+                    "$ref": "#/definitions/models.Answer"
+                },
+                "evaluate": {
+                    "x-omitempty": true
+                },
+                "feedback": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkFeedback"
+                        }
+                    ],
+                    "x-omitempty": true
+                }
+            }
+        },
+        "services.AppliedTemplate": {
+            "type": "object",
+            "properties": {
+                "module": {
                     "type": "string",
                     "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "work": {
+                "programs": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "template": {
                     "type": "string",
                     "example": "637d5de216f58bc8ec7f7f51"
                 }
             }
         },
-        "models.FormItem": {
+        "services.ApplyTemplatesRes": {
             "type": "object",
             "properties": {
-                "_id": {
-                    "type": "string",
-                    "example": "6376c8283cc695e19d785b08"
-                },
-                "points_type": {
-                    "type": "string",
-                    "x-omitempty": true
-                },
-                "questions": {
+                "applied": {
                     "type": "array",
                     "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "6376c8283cc695e19d785b08"
-                    ]
+                        "$ref": "#/definitions/services.AppliedTemplate"
+                    }
                 },
-                "title": {
-                    "type": "string",
-                    "example": "This is a item form!"
+                "skipped": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.SkippedTemplate"
+                    }
                 }
             }
         },
-        "models.FormItemWLookup": {
+        "services.AtRiskStudent": {
             "type": "object",
             "properties": {
-                "_id": {
-                    "type": "string",
-                    "example": "6376c8283cc695e19d785b08"
-                },
-                "points_type": {
-                    "type": "string",
-                    "x-omitempty": true
+                "average": {
+                    "type": "number",
+                    "example": 3.6
                 },
-                "questions": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.ItemQuestion"
-                    }
+                "missing": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "title": {
-                    "type": "string",
-                    "example": "This is a item title!"
+                "student": {
+                    "$ref": "#/definitions/models.SimpleUser"
                 }
             }
         },
-        "models.FormWLookup": {
+        "services.AttachedRes": {
             "type": "object",
             "properties": {
                 "_id": {
                     "type": "string",
-                    "example": "6376c8283cc695e19d785b08"
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "has_points": {
-                    "type": "boolean"
+                "file": {
+                    "$ref": "#/definitions/models.File"
                 },
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.FormItemWLookup"
-                    }
+                "link": {
+                    "type": "string",
+                    "example": "https://example.com"
                 },
                 "title": {
                     "type": "string",
-                    "example": "This is a form!"
+                    "example": "This is a title"
                 },
-                "update_date": {
+                "type": {
                     "type": "string",
-                    "example": "2022-09-21T20:10:23.309+00:00"
+                    "enum": [
+                        "link",
+                        "file"
+                    ],
+                    "example": "link"
+                }
+            }
+        },
+        "services.ClonedWork": {
+            "type": "object",
+            "properties": {
+                "module": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "upload_date": {
+                "work": {
                     "type": "string",
-                    "example": "2022-09-21T20:10:23.309+00:00"
+                    "example": "637d5de216f58bc8ec7f7f51"
                 }
             }
         },
-        "models.GradesProgram": {
+        "services.CloseModulePreview": {
             "type": "object",
             "properties": {
-                "_id": {
+                "error": {
                     "type": "string",
-                    "example": "637850aafa8118c9f0f1202a"
+                    "x-omitempty": true,
+                    "example": "no se pudo obtener los estudiantes"
                 },
-                "acumulative": {
+                "grades": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Acumulative"
-                    },
-                    "x-omitempty": true
-                },
-                "is_acumulative": {
-                    "type": "boolean"
+                        "$ref": "#/definitions/services.MissingGrade"
+                    }
                 },
                 "module": {
                     "type": "string",
-                    "example": "637850aafa8118c9f0f1202a"
-                },
-                "number": {
-                    "type": "integer",
-                    "example": 3
-                },
-                "percentage": {
-                    "type": "number",
-                    "example": 25
+                    "example": "637d5de216f58bc8ec7f7f51"
                 }
             }
         },
-        "models.ItemQuestion": {
-            "description": "Points is null if (father)form.has_points = false Answers is null if question.type=written Correct is null if question.type=written || alternatives",
+        "services.CloseSemesterPreview": {
             "type": "object",
             "properties": {
-                "_id": {
-                    "type": "string",
-                    "example": "6376c8283cc695e19d785b08"
-                },
-                "answers": {
+                "modules": {
                     "type": "array",
                     "items": {
-                        "type": "string"
-                    },
-                    "x-omitempty": true,
-                    "example": [
-                        "a",
-                        " b"
-                    ]
+                        "$ref": "#/definitions/services.CloseModulePreview"
+                    }
                 },
-                "correct": {
-                    "type": "integer",
-                    "x-omitempty": true,
-                    "example": 2
+                "semester": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "points": {
+                "total": {
                     "type": "integer",
-                    "x-omitempty": true,
                     "example": 25
-                },
-                "question": {
-                    "type": "string",
-                    "example": "Whats your name?"
-                },
-                "type": {
-                    "type": "string",
-                    "example": "alternatives_correct"
                 }
             }
         },
-        "models.ModuleWithLookup": {
+        "services.GradesAnalyticsRes": {
             "type": "object",
             "properties": {
-                "__v": {
-                    "type": "integer"
-                },
-                "_id": {
-                    "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
-                },
-                "section": {
-                    "$ref": "#/definitions/models.Section"
-                },
-                "semester": {
-                    "$ref": "#/definitions/models.Semester"
-                },
-                "status": {
-                    "type": "boolean"
+                "module": {
+                    "$ref": "#/definitions/services.ModuleAnalytics"
                 },
-                "sub_sections": {
+                "sections": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.SubSection"
+                        "$ref": "#/definitions/services.SectionAnalytics"
                     }
-                },
-                "subject": {
-                    "$ref": "#/definitions/models.Subject"
-                },
-                "works": {
-                    "description": "Works",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Work"
-                    },
-                    "x-omitempty": true
                 }
             }
         },
-        "models.Section": {
+        "services.GradesStats": {
             "type": "object",
             "properties": {
-                "__v": {
-                    "type": "integer"
+                "count": {
+                    "type": "integer",
+                    "example": 30
                 },
-                "_id": {
-                    "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
+                "failed": {
+                    "type": "integer",
+                    "example": 3
                 },
-                "course": {
-                    "$ref": "#/definitions/models.Course"
+                "histogram": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.HistogramBin"
+                    }
                 },
-                "file": {
-                    "$ref": "#/definitions/models.File"
+                "max": {
+                    "type": "number",
+                    "example": 7
                 },
-                "section": {
-                    "type": "string",
-                    "example": "A"
+                "mean": {
+                    "type": "number",
+                    "example": 5.4
+                },
+                "median": {
+                    "type": "number",
+                    "example": 5.5
+                },
+                "min": {
+                    "type": "number",
+                    "example": 2.9
+                },
+                "pass_rate": {
+                    "type": "number",
+                    "example": 90
+                },
+                "passed": {
+                    "type": "integer",
+                    "example": 27
+                },
+                "std_dev": {
+                    "type": "number",
+                    "example": 0.8
                 }
             }
         },
-        "models.Semester": {
+        "services.HistogramBin": {
             "type": "object",
             "properties": {
-                "_id": {
-                    "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
-                },
-                "semester": {
+                "count": {
                     "type": "integer",
-                    "example": 2
+                    "example": 12
                 },
-                "year": {
-                    "type": "integer",
-                    "example": 2022
+                "from": {
+                    "type": "number",
+                    "example": 4
+                },
+                "to": {
+                    "type": "number",
+                    "example": 5
                 }
             }
         },
-        "models.SimpleUser": {
+        "services.ImportGradeError": {
             "type": "object",
             "properties": {
-                "_id": {
-                    "type": "string",
-                    "example": "63785424db1efbc237faecca"
-                },
-                "first_lastname": {
+                "column": {
                     "type": "string",
                     "x-omitempty": true,
-                    "example": "FirstLastname"
+                    "example": "B"
                 },
-                "name": {
+                "message": {
                     "type": "string",
-                    "x-omitempty": true,
-                    "example": "Name"
+                    "example": "calificación inválida. Mín: 1. Máx: 7"
+                },
+                "row": {
+                    "type": "integer",
+                    "example": 3
                 },
                 "rut": {
                     "type": "string",
                     "x-omitempty": true,
                     "example": "12345678-9"
-                },
-                "second_lastname": {
-                    "type": "string",
-                    "x-omitempty": true,
-                    "example": "SecondLastname"
                 }
             }
         },
-        "models.SubSection": {
+        "services.ImportGradesRes": {
             "type": "object",
             "properties": {
-                "_id": {
-                    "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
+                "committed": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.ImportGradeError"
+                    }
+                },
+                "inserted": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "rows": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "unchanged": {
+                    "type": "integer",
+                    "example": 3
                 },
-                "name": {
-                    "type": "string",
-                    "example": "Math - Geo"
+                "updated": {
+                    "type": "integer",
+                    "example": 2
                 }
             }
         },
-        "models.Subject": {
+        "services.ItemAnalysisRes": {
             "type": "object",
             "properties": {
-                "__v": {
-                    "type": "integer"
+                "group": {
+                    "type": "integer",
+                    "example": 8
                 },
-                "_id": {
-                    "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.QuestionAnalysis"
+                    }
                 },
-                "subject": {
-                    "type": "string",
-                    "example": "Math"
+                "students": {
+                    "type": "integer",
+                    "example": 30
                 }
             }
         },
-        "models.Work": {
+        "services.MissingGrade": {
             "type": "object",
             "properties": {
-                "_id": {
-                    "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
-                },
                 "acumulative": {
                     "type": "string",
                     "x-omitempty": true,
                     "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "attached": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Attached"
-                    },
-                    "x-omitempty": true
+                "grade": {
+                    "type": "number",
+                    "example": 1
                 },
-                "author": {
-                    "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
+                "number": {
+                    "type": "integer",
+                    "example": 3
                 },
-                "date_limit": {
+                "program": {
                     "type": "string",
-                    "example": "2022-09-21T20:10:23.309+00:00"
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "date_start": {
+                "student": {
                     "type": "string",
-                    "example": "2022-09-21T20:10:23.309+00:00"
+                    "example": "637d5de216f58bc8ec7f7f51"
+                }
+            }
+        },
+        "services.ModuleAnalytics": {
+            "type": "object",
+            "properties": {
+                "at_risk": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.AtRiskStudent"
+                    }
                 },
-                "date_update": {
-                    "type": "string",
-                    "example": "2022-09-21T20:10:23.309+00:00"
+                "averages": {
+                    "$ref": "#/definitions/services.GradesStats"
                 },
-                "date_upload": {
+                "module": {
                     "type": "string",
-                    "example": "2022-09-21T20:10:23.309+00:00"
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "description": {
-                    "type": "string",
-                    "x-omitempty": true,
-                    "example": "This is a description"
+                "pass_grade": {
+                    "type": "number",
+                    "example": 4
                 },
-                "form": {
-                    "type": "string",
-                    "x-omitempty": true,
-                    "example": "637d5de216f58bc8ec7f7f51"
+                "programs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.ProgramAnalytics"
+                    }
                 },
-                "form_access": {
+                "section": {
                     "type": "string",
-                    "enum": [
-                        "default",
-                        "wtime"
-                    ],
-                    "x-omitempty": true,
-                    "example": "default"
+                    "example": "Primero A"
                 },
-                "grade": {
-                    "type": "string",
-                    "x-omitempty": true,
-                    "example": "637d5de216f58bc8ec7f7f51"
+                "students": {
+                    "type": "integer",
+                    "example": 30
+                }
+            }
+        },
+        "services.ModuleAverageRes": {
+            "description": "Average is null if the student has no grades in the module",
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number",
+                    "example": 5.8
                 },
-                "is_qualified": {
+                "complete": {
                     "type": "boolean"
                 },
-                "is_revised": {
+                "is_final": {
                     "type": "boolean"
                 },
                 "module": {
                     "type": "string",
                     "example": "637d5de216f58bc8ec7f7f51"
                 },
+                "subject": {
+                    "type": "string",
+                    "example": "Matemáticas"
+                }
+            }
+        },
+        "services.PeerReviewMeanRes": {
+            "type": "object",
+            "properties": {
                 "pattern": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.WorkPattern"
-                    },
-                    "x-omitempty": true
-                },
-                "time_access": {
-                    "type": "integer",
-                    "x-omitempty": true,
-                    "example": 2
-                },
-                "title": {
                     "type": "string",
-                    "example": "Work!"
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "type": {
-                    "type": "string",
-                    "enum": [
-                        "files",
-                        "form"
-                    ],
-                    "example": "form"
+                "points": {
+                    "type": "number",
+                    "example": 18.5
                 }
             }
         },
-        "models.WorkPattern": {
+        "services.ProgramAnalytics": {
             "type": "object",
             "properties": {
                 "_id": {
                     "type": "string",
                     "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "description": {
-                    "type": "string",
-                    "example": "This is a description"
+                "is_acumulative": {
+                    "type": "boolean"
                 },
-                "points": {
+                "number": {
                     "type": "integer",
+                    "example": 1
+                },
+                "percentage": {
+                    "type": "number",
                     "example": 25
                 },
-                "title": {
-                    "type": "string",
-                    "example": "Pattern!"
+                "stats": {
+                    "$ref": "#/definitions/services.GradesStats"
                 }
             }
         },
-        "models.WorkWLookup": {
+        "services.PublicationsRes": {
             "type": "object",
             "properties": {
                 "_id": {
                     "type": "string",
                     "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "acumulative": {
-                    "type": "string",
-                    "x-omitempty": true,
-                    "example": "637d5de216f58bc8ec7f7f51"
-                },
                 "attached": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Attached"
+                        "$ref": "#/definitions/services.AttachedRes"
                     }
                 },
-                "author": {
-                    "$ref": "#/definitions/models.SimpleUser"
-                },
-                "date_limit": {
-                    "type": "string",
-                    "example": "2022-09-21T20:10:23.309+00:00"
-                },
-                "date_start": {
+                "content": {
                     "type": "string",
-                    "example": "2022-09-21T20:10:23.309+00:00"
+                    "example": "Content..."
                 },
-                "date_update": {
+                "update_date": {
                     "type": "string",
                     "example": "2022-09-21T20:10:23.309+00:00"
                 },
-                "date_upload": {
+                "upload_date": {
                     "type": "string",
                     "example": "2022-09-21T20:10:23.309+00:00"
-                },
-                "description": {
-                    "type": "string",
-                    "x-omitempty": true,
-                    "example": "This is a description"
-                },
-                "form": {
+                }
+            }
+        },
+        "services.QuestionAnalysis": {
+            "description": "Discrimination is null if there are not enough students to compare the groups Alternatives is null if question.type!=alternatives_correct \u0026\u0026 true_false",
+            "type": "object",
+            "properties": {
+                "_id": {
                     "type": "string",
-                    "x-omitempty": true,
                     "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "form_access": {
-                    "type": "string",
-                    "enum": [
-                        "default",
-                        "wtime"
-                    ],
-                    "x-omitempty": true,
-                    "example": "default"
-                },
-                "grade": {
-                    "x-omitempty": true,
-                    "$ref": "#/definitions/models.GradesProgram"
-                },
-                "is_qualified": {
+                "all_missed": {
                     "type": "boolean"
                 },
-                "is_revised": {
-                    "type": "boolean"
-                },
-                "module": {
-                    "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
-                },
-                "pattern": {
+                "alternatives": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.WorkPattern"
+                        "$ref": "#/definitions/services.AlternativeAnalysis"
                     },
                     "x-omitempty": true
                 },
-                "time_access": {
+                "correct": {
+                    "type": "number",
+                    "example": 63.3
+                },
+                "discrimination": {
+                    "type": "number",
+                    "example": 0.42
+                },
+                "omitted": {
                     "type": "integer",
-                    "x-omitempty": true,
                     "example": 2
                 },
-                "title": {
+                "points": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "question": {
                     "type": "string",
-                    "example": "This is a title"
+                    "example": "¿Capital de Chile?"
+                },
+                "removed": {
+                    "type": "boolean"
+                },
+                "students": {
+                    "type": "integer",
+                    "example": 30
                 },
                 "type": {
                     "type": "string",
-                    "enum": [
-                        "files",
-                        "form"
-                    ],
-                    "example": "files"
+                    "example": "alternatives_correct"
                 }
             }
         },
-        "res.Response": {
+        "services.ReviewerPeerReviewRes": {
+            "description": "The reviewer doesn't know the student of the submission",
             "type": "object",
             "properties": {
-                "body": {
-                    "type": "object",
-                    "additionalProperties": true
+                "_id": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "message": {
-                    "type": "string"
+                "date_review": {
+                    "type": "string",
+                    "x-omitempty": true,
+                    "example": "2022-09-21T20:10:23.309+00:00"
                 },
-                "success": {
-                    "type": "boolean"
+                "evaluate": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EvaluatedFiles"
+                    },
+                    "x-omitempty": true
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 40
                 }
             }
         },
-        "services.AnswerRes": {
+        "services.ReviewerPeerReviewsRes": {
             "type": "object",
             "properties": {
-                "answer": {
-                    "$ref": "#/definitions/models.Answer"
+                "date_limit": {
+                    "type": "string",
+                    "example": "2022-09-21T20:10:23.309+00:00"
                 },
-                "evaluate": {
-                    "x-omitempty": true
+                "pattern": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkPattern"
+                    }
+                },
+                "reviews": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.ReviewerPeerReviewRes"
+                    }
                 }
             }
         },
-        "services.AttachedRes": {
+        "services.SectionAnalytics": {
             "type": "object",
             "properties": {
-                "_id": {
-                    "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
+                "at_risk": {
+                    "type": "integer",
+                    "example": 2
                 },
-                "file": {
-                    "$ref": "#/definitions/models.File"
+                "averages": {
+                    "$ref": "#/definitions/services.GradesStats"
                 },
-                "link": {
-                    "type": "string",
-                    "example": "https://example.com"
+                "current": {
+                    "type": "boolean"
                 },
-                "title": {
+                "module": {
                     "type": "string",
-                    "example": "This is a title"
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "type": {
+                "section": {
                     "type": "string",
-                    "enum": [
-                        "link",
-                        "file"
-                    ],
-                    "example": "link"
+                    "example": "Primero B"
+                },
+                "students": {
+                    "type": "integer",
+                    "example": 28
                 }
             }
         },
-        "services.PublicationsRes": {
+        "services.SimilarityReportRes": {
+            "description": "Students are the users of the flagged pairs",
             "type": "object",
             "properties": {
-                "_id": {
-                    "type": "string",
-                    "example": "637d5de216f58bc8ec7f7f51"
+                "report": {
+                    "$ref": "#/definitions/models.WorkSimilarity"
                 },
-                "attached": {
+                "students": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/services.AttachedRes"
+                        "$ref": "#/definitions/models.SimpleUser"
                     }
-                },
-                "content": {
-                    "type": "string",
-                    "example": "Content..."
-                },
-                "update_date": {
+                }
+            }
+        },
+        "services.SkippedTemplate": {
+            "type": "object",
+            "properties": {
+                "module": {
                     "type": "string",
-                    "example": "2022-09-21T20:10:23.309+00:00"
+                    "example": "637d5de216f58bc8ec7f7f51"
                 },
-                "upload_date": {
+                "reason": {
                     "type": "string",
-                    "example": "2022-09-21T20:10:23.309+00:00"
+                    "example": "el módulo ya tiene calificaciones programadas"
                 }
             }
         },
@@ -4293,8 +10859,12 @@ const docTemplate = `{
                     "example": "637d5de216f58bc8ec7f7f51"
                 },
                 "access": {
-                    "x-omitempty": true,
-                    "$ref": "#/definitions/models.FormAccess"
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.FormAccess"
+                        }
+                    ],
+                    "x-omitempty": true
                 },
                 "course": {
                     "type": "string",
@@ -4308,18 +10878,100 @@ const docTemplate = `{
                     "x-omitempty": true
                 },
                 "files_uploaded": {
-                    "x-omitempty": true,
-                    "$ref": "#/definitions/models.FileUploadedClassroomWLookup"
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.FileUploadedClassroomWLookup"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "group": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkGroup"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
+                "late": {
+                    "type": "boolean"
                 },
                 "registration_number": {
                     "type": "string",
                     "example": "MDVCW45"
                 },
+                "session": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.SessionWLookup"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
                 "user": {
                     "$ref": "#/definitions/models.SimpleUser"
                 }
             }
         },
+        "services.StudentAveragesRes": {
+            "description": "IsFinal is true when the semester was closed, else the averages are partial",
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number",
+                    "example": 5.6
+                },
+                "is_final": {
+                    "type": "boolean"
+                },
+                "modules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.ModuleAverageRes"
+                    }
+                },
+                "semester": {
+                    "type": "string",
+                    "example": "637d5de216f58bc8ec7f7f51"
+                },
+                "student": {
+                    "$ref": "#/definitions/models.SimpleUser"
+                }
+            }
+        },
+        "services.StudentPeerReviewRes": {
+            "type": "object",
+            "properties": {
+                "review": {
+                    "$ref": "#/definitions/models.PeerReview"
+                },
+                "reviewer": {
+                    "$ref": "#/definitions/models.SimpleUser"
+                }
+            }
+        },
+        "services.StudentPeerReviewsRes": {
+            "description": "Points is the sum of the means of the reviewed items",
+            "type": "object",
+            "properties": {
+                "means": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.PeerReviewMeanRes"
+                    }
+                },
+                "points": {
+                    "type": "number",
+                    "example": 42.5
+                },
+                "reviews": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.StudentPeerReviewRes"
+                    }
+                }
+            }
+        },
         "services.WorkStatus": {
             "type": "object",
             "properties": {
@@ -4360,6 +11012,36 @@ const docTemplate = `{
                 }
             }
         },
+        "smaps.ApplyTemplatesMap": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "$ref": "#/definitions/services.ApplyTemplatesRes"
+                }
+            }
+        },
+        "smaps.BankQuestionsMap": {
+            "type": "object",
+            "properties": {
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BankQuestionWLookup"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "smaps.CloseSemesterPreviewMap": {
+            "type": "object",
+            "properties": {
+                "preview": {
+                    "$ref": "#/definitions/services.CloseSemesterPreview"
+                }
+            }
+        },
         "smaps.FormMap": {
             "type": "object",
             "properties": {
@@ -4380,12 +11062,28 @@ const docTemplate = `{
                         "$ref": "#/definitions/services.AnswerRes"
                     }
                 },
+                "feedback": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WorkFeedback"
+                        }
+                    ],
+                    "x-omitempty": true
+                },
                 "form": {
                     "$ref": "#/definitions/models.FormWLookup"
                 },
                 "work": {
                     "type": "object",
                     "properties": {
+                        "attempt": {
+                            "type": "integer",
+                            "x-omitempty": true
+                        },
+                        "attempts": {
+                            "type": "integer",
+                            "x-omitempty": true
+                        },
                         "date_limit": {
                             "type": "string"
                         },
@@ -4421,6 +11119,33 @@ const docTemplate = `{
                 }
             }
         },
+        "smaps.GradeHistoryMap": {
+            "type": "object",
+            "properties": {
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GradeHistoryWLookup"
+                    }
+                }
+            }
+        },
+        "smaps.GradesAnalyticsMap": {
+            "type": "object",
+            "properties": {
+                "analytics": {
+                    "$ref": "#/definitions/services.GradesAnalyticsRes"
+                }
+            }
+        },
+        "smaps.GradingScaleMap": {
+            "type": "object",
+            "properties": {
+                "scale": {
+                    "$ref": "#/definitions/models.GradingScale"
+                }
+            }
+        },
         "smaps.IdInsertedMap": {
             "type": "object",
             "properties": {
@@ -4429,6 +11154,14 @@ const docTemplate = `{
                 }
             }
         },
+        "smaps.ImportGradesMap": {
+            "type": "object",
+            "properties": {
+                "report": {
+                    "$ref": "#/definitions/services.ImportGradesRes"
+                }
+            }
+        },
         "smaps.InsertedIdMap": {
             "type": "object",
             "properties": {
@@ -4437,6 +11170,14 @@ const docTemplate = `{
                 }
             }
         },
+        "smaps.ItemAnalysisMap": {
+            "type": "object",
+            "properties": {
+                "analysis": {
+                    "$ref": "#/definitions/services.ItemAnalysisRes"
+                }
+            }
+        },
         "smaps.ModuleMap": {
             "type": "object",
             "properties": {
@@ -4481,6 +11222,14 @@ const docTemplate = `{
                 }
             }
         },
+        "smaps.PeerReviewsMap": {
+            "type": "object",
+            "properties": {
+                "peer_reviews": {
+                    "$ref": "#/definitions/services.ReviewerPeerReviewsRes"
+                }
+            }
+        },
         "smaps.ProgramGradeMap": {
             "type": "object",
             "properties": {
@@ -4492,6 +11241,17 @@ const docTemplate = `{
                 }
             }
         },
+        "smaps.ProgramTemplatesMap": {
+            "type": "object",
+            "properties": {
+                "templates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GradeProgramTemplate"
+                    }
+                }
+            }
+        },
         "smaps.PublicationMap": {
             "type": "object",
             "properties": {
@@ -4514,12 +11274,55 @@ const docTemplate = `{
                 }
             }
         },
+        "smaps.RubricsMap": {
+            "type": "object",
+            "properties": {
+                "rubrics": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Rubric"
+                    }
+                }
+            }
+        },
         "smaps.SearchHitsMap": {
             "type": "object",
             "properties": {
                 "hits": {}
             }
         },
+        "smaps.SemesterCloseMap": {
+            "type": "object",
+            "properties": {
+                "close": {
+                    "$ref": "#/definitions/models.SemesterClose"
+                }
+            }
+        },
+        "smaps.SimilarityReportMap": {
+            "type": "object",
+            "properties": {
+                "similarity": {
+                    "$ref": "#/definitions/services.SimilarityReportRes"
+                }
+            }
+        },
+        "smaps.StudentAveragesMap": {
+            "type": "object",
+            "properties": {
+                "averages": {
+                    "$ref": "#/definitions/services.StudentAveragesRes"
+                }
+            }
+        },
+        "smaps.StudentPeerReviewsMap": {
+            "type": "object",
+            "properties": {
+                "peer_reviews": {
+                    "$ref": "#/definitions/services.StudentPeerReviewsRes"
+                }
+            }
+        },
         "smaps.StudentsStatusMap": {
             "type": "object",
             "properties": {
@@ -4542,6 +11345,50 @@ const docTemplate = `{
                 }
             }
         },
+        "smaps.WorkClonesMap": {
+            "type": "object",
+            "properties": {
+                "works": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.ClonedWork"
+                    }
+                }
+            }
+        },
+        "smaps.WorkExtensionsMap": {
+            "type": "object",
+            "properties": {
+                "extensions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkExtensionWLookup"
+                    }
+                }
+            }
+        },
+        "smaps.WorkFeedbackMap": {
+            "type": "object",
+            "properties": {
+                "feedback": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkFeedback"
+                    }
+                }
+            }
+        },
+        "smaps.WorkGroupsMap": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkGroupWLookup"
+                    }
+                }
+            }
+        },
         "smaps.WorksMap": {
             "type": "object",
             "properties": {
@@ -162,6 +162,11 @@ func Init() {
 		"/api/c/classroom/grades",
 		middlewares.JWTMiddleware(),
 	)
+	programTemplate := router.Group(
+		"/api/c/classroom/program_templates",
+		middlewares.JWTMiddleware(),
+		middlewares.RolesMiddleware([]string{models.DIRECTOR, models.DIRECTIVE}),
+	)
 	work := router.Group(
 		"/api/c/classroom/works",
 		middlewares.JWTMiddleware(),
@@ -171,8 +176,11 @@ func Init() {
 		modulesController := new(controllers_query.ModulesController)
 		publicationsController := new(controllers_query.PublicationController)
 		formsController := new(controllers_query.FormController)
+		questionBankController := new(controllers_query.QuestionBankController)
 		gradesController := new(controllers_query.GradesController)
 		worksController := new(controllers_query.WorkController)
+		rubricController := new(controllers_query.RubricController)
+		gradingScaleController := new(controllers_query.GradingScaleController)
 		// Define routes
 		// Modules
 		modules.GET(
@@ -206,12 +214,22 @@ func Init() {
 		// Forms
 		forms.GET("/get_forms", formsController.GetForms)
 		forms.GET("/get_form/:idForm", formsController.GetForm)
+		forms.GET(
+			"/search_bank",
+			middlewares.RolesMiddleware([]string{models.TEACHER}),
+			questionBankController.SearchBankQuestions,
+		)
 		// Grades
 		grade.GET(
 			"/get_grade_programs/:idModule",
 			middlewares.AuthorizedRouteModule(),
 			gradesController.GetProgramGrade,
 		)
+		grade.GET(
+			"/get_grading_scale/:idModule",
+			middlewares.AuthorizedRouteModule(),
+			gradingScaleController.GetGradingScale,
+		)
 		grade.GET(
 			"/get_students_grades/:idModule",
 			middlewares.RolesMiddleware([]string{
@@ -223,6 +241,36 @@ func Init() {
 			middlewares.AuthorizedRouteModule(),
 			gradesController.GetStudentsGrades,
 		)
+		grade.GET(
+			"/get_grade_history/:idModule/:idGrade",
+			middlewares.RolesMiddleware([]string{
+				models.TEACHER,
+				models.DIRECTOR,
+				models.DIRECTIVE,
+			}),
+			middlewares.AuthorizedRouteModule(),
+			gradesController.GetGradeHistory,
+		)
+		grade.GET(
+			"/get_student_grade_history/:idModule/:idStudent",
+			middlewares.RolesMiddleware([]string{
+				models.TEACHER,
+				models.DIRECTOR,
+				models.DIRECTIVE,
+			}),
+			middlewares.AuthorizedRouteModule(),
+			gradesController.GetStudentGradeHistory,
+		)
+		grade.GET(
+			"/get_grades_analytics/:idModule",
+			middlewares.RolesMiddleware([]string{
+				models.TEACHER,
+				models.DIRECTOR,
+				models.DIRECTIVE,
+			}),
+			middlewares.AuthorizedRouteModule(),
+			gradesController.GetGradesAnalytics,
+		)
 		grade.GET(
 			"/get_student_grades/:idModule",
 			middlewares.RolesMiddleware([]string{
@@ -244,9 +292,50 @@ func Init() {
 		)
 		grade.GET(
 			"/download_grades",
-			middlewares.RolesMiddleware([]string{models.STUDENT, models.STUDENT_DIRECTIVE}),
+			middlewares.RolesMiddleware([]string{
+				models.STUDENT,
+				models.STUDENT_DIRECTIVE,
+				models.ATTORNEY,
+			}),
 			gradesController.ExportGradesStudent,
 		)
+		grade.GET(
+			"/download_section_grades/:idSection",
+			middlewares.RolesMiddleware([]string{
+				models.DIRECTOR,
+				models.DIRECTIVE,
+			}),
+			gradesController.ExportSectionGrades,
+		)
+		grade.GET(
+			"/get_averages",
+			middlewares.RolesMiddleware([]string{
+				models.STUDENT,
+				models.STUDENT_DIRECTIVE,
+				models.ATTORNEY,
+				models.DIRECTOR,
+				models.DIRECTIVE,
+			}),
+			gradesController.GetStudentAverages,
+		)
+		grade.GET(
+			"/get_close_semester",
+			middlewares.RolesMiddleware([]string{
+				models.DIRECTOR,
+				models.DIRECTIVE,
+			}),
+			gradesController.GetSemesterClose,
+		)
+		grade.GET(
+			"/preview_close_semester",
+			middlewares.RolesMiddleware([]string{
+				models.DIRECTOR,
+				models.DIRECTIVE,
+			}),
+			gradesController.PreviewCloseSemester,
+		)
+		// Program templates
+		programTemplate.GET("/get_templates", gradesController.GetProgramTemplates)
 		// Works
 		work.GET(
 			"/get_modules_works",
@@ -288,6 +377,46 @@ func Init() {
 			middlewares.AuthorizedRouteModule(),
 			worksController.GetStudentsStatus,
 		)
+		work.GET(
+			"/get_similarity_report/:idWork",
+			middlewares.RolesMiddleware([]string{models.TEACHER}),
+			middlewares.AuthorizedRouteModule(),
+			worksController.GetSimilarityReport,
+		)
+		work.GET(
+			"/get_peer_reviews/:idWork",
+			middlewares.RolesMiddleware([]string{
+				models.STUDENT,
+				models.STUDENT_DIRECTIVE,
+			}),
+			middlewares.AuthorizedRouteModule(),
+			worksController.GetPeerReviews,
+		)
+		work.GET(
+			"/download_peer_review_files/:idWork/:idReview",
+			middlewares.RolesMiddleware([]string{
+				models.STUDENT,
+				models.STUDENT_DIRECTIVE,
+			}),
+			middlewares.AuthorizedRouteModule(),
+			worksController.DownloadPeerReviewFiles,
+		)
+		work.GET(
+			"/get_student_peer_reviews/:idWork/:idStudent",
+			middlewares.RolesMiddleware([]string{models.TEACHER}),
+			middlewares.AuthorizedRouteModule(),
+			worksController.GetStudentPeerReviews,
+		)
+		work.GET(
+			"/get_groups/:idWork",
+			middlewares.RolesMiddleware([]string{
+				models.TEACHER,
+				models.STUDENT,
+				models.STUDENT_DIRECTIVE,
+			}),
+			middlewares.AuthorizedRouteModule(),
+			worksController.GetGroups,
+		)
 		work.GET(
 			"/get_form_student/:idWork/:idStudent",
 			middlewares.RolesMiddleware([]string{
@@ -297,12 +426,24 @@ func Init() {
 			middlewares.AuthorizedRouteModule(),
 			worksController.GetFormStudent,
 		)
+		work.GET(
+			"/get_item_analysis/:idWork",
+			middlewares.RolesMiddleware([]string{models.TEACHER}),
+			middlewares.AuthorizedRouteModule(),
+			worksController.GetItemAnalysis,
+		)
 		work.GET(
 			"/download_files_work_student/:idWork/:idStudent",
 			middlewares.RolesMiddleware([]string{models.TEACHER}),
 			middlewares.AuthorizedRouteModule(),
 			worksController.DownloadFilesWorkStudent,
 		)
+		// Rubrics
+		work.GET(
+			"/get_rubrics",
+			middlewares.RolesMiddleware([]string{models.TEACHER}),
+			rubricController.GetRubrics,
+		)
 	}
 	// Route docs
 	router.GET("/api/c/classroom/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -335,16 +335,14 @@ func (w *WorkRepository) GetModulesWorks(
 	return workStatus, nil
 }
 
-func (w *WorkRepository) GetWorks(idObjModule primitive.ObjectID) ([]models.WorkWLookup, *res.ErrorRes) {
+func (w *WorkRepository) GetWorks(filter bson.M) ([]models.WorkWLookup, *res.ErrorRes) {
 	// Get
 	var works []models.WorkWLookup
 
 	match := bson.D{
 		{
-			Key: "$match",
-			Value: bson.M{
-				"module": idObjModule,
-			},
+			Key:   "$match",
+			Value: filter,
 		},
 	}
 	lookupUser := w.getLookupUser()
@@ -363,6 +361,8 @@ func (w *WorkRepository) GetWorks(idObjModule primitive.ObjectID) ([]models.Work
 				"date_update":  1,
 				"acumulative":  1,
 				"sessions":     1,
+				"draft":        1,
+				"date_publish": 1,
 				"author": bson.M{
 					"$arrayElemAt": bson.A{"$author", 0},
 				},
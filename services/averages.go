@@ -0,0 +1,263 @@
+package services
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Round an average with the decimals and the rounding of the settings
+func roundAverage(average float64) float64 {
+	pow := math.Pow(10, float64(settingsData.AVERAGE_DECIMALS))
+	switch settingsData.AVERAGE_ROUNDING {
+	case "half_even":
+		return math.RoundToEven(average*pow) / pow
+	case "down":
+		return math.Floor(average*pow+1e-9) / pow
+	}
+	// Half up, the epsilon fixes floats as 5.45 -> 5.4499999
+	return math.Floor(average*pow+0.5+1e-9) / pow
+}
+
+// Grade of a program, an acumulative program is the weighted average
+// of its acumulative grades
+func getProgramGrade(grade *OrderedGrade, program models.GradesProgram) (*float64, bool) {
+	if grade == nil {
+		return nil, false
+	}
+	if !program.IsAcumulative {
+		programGrade := grade.Grade
+		return &programGrade, true
+	}
+	var sum, percentages float64
+	complete := true
+	for i, acumulative := range program.Acumulative {
+		if i >= len(grade.Acumulative) || grade.Acumulative[i] == nil {
+			complete = false
+			continue
+		}
+		sum += grade.Acumulative[i].Grade * float64(acumulative.Percentage)
+		percentages += float64(acumulative.Percentage)
+	}
+	if percentages == 0 {
+		return nil, false
+	}
+	programGrade := sum / percentages
+	return &programGrade, complete
+}
+
+// Weighted average of a module over the programs with grade, it is
+// complete if all the programs (and acumulatives) have grade
+func getModuleAverage(grades []*OrderedGrade, programs []models.GradesProgram) (*float64, bool) {
+	var sum, percentages float64
+	complete := len(programs) > 0
+	for i, program := range programs {
+		var grade *OrderedGrade
+		if i < len(grades) {
+			grade = grades[i]
+		}
+		programGrade, programComplete := getProgramGrade(grade, program)
+		if !programComplete {
+			complete = false
+		}
+		if programGrade == nil {
+			continue
+		}
+		sum += *programGrade * float64(program.Percentage)
+		percentages += float64(program.Percentage)
+	}
+	if percentages == 0 {
+		return nil, false
+	}
+	average := roundAverage(sum / percentages)
+	return &average, complete
+}
+
+// Semester average, the mean of the averages of the modules
+func getMeanAverage(averages []float64) *float64 {
+	if len(averages) == 0 {
+		return nil
+	}
+	var sum float64
+	for _, average := range averages {
+		sum += average
+	}
+	average := roundAverage(sum / float64(len(averages)))
+	return &average
+}
+
+// Register the averages of the students of a module, returns the
+// averages by student
+func upsertModuleAverages(
+	module models.Module,
+	idObjSemester primitive.ObjectID,
+) (map[string]float64, error) {
+	idModule := module.ID.Hex()
+	programs, errRes := gradesService.GetGradePrograms(idModule)
+	if errRes != nil {
+		return nil, errRes.Err
+	}
+	averages := make(map[string]float64)
+	if len(programs) == 0 {
+		return averages, nil
+	}
+	studentsGrades, errRes := gradesService.GetStudentsGrades(idModule, false, nil)
+	if errRes != nil {
+		return nil, errRes.Err
+	}
+
+	opts := options.Update().SetUpsert(true)
+	for _, studentGrades := range studentsGrades {
+		average, complete := getModuleAverage(studentGrades.Grades, programs)
+		if average == nil {
+			continue
+		}
+		idObjStudent, err := primitive.ObjectIDFromHex(studentGrades.Student.ID)
+		if err != nil {
+			return nil, err
+		}
+		modelAverage := models.NewModelModuleAverage(
+			module.ID,
+			idObjSemester,
+			idObjStudent,
+			*average,
+			complete,
+		)
+		_, err = moduleAverageModel.Use().UpdateOne(db.Ctx, bson.D{
+			{
+				Key:   "module",
+				Value: module.ID,
+			},
+			{
+				Key:   "student",
+				Value: idObjStudent,
+			},
+		}, bson.D{{
+			Key:   "$set",
+			Value: modelAverage,
+		}}, opts)
+		if err != nil {
+			return nil, err
+		}
+		averages[studentGrades.Student.ID] = *average
+	}
+	return averages, nil
+}
+
+func (g *GradesService) getStudentModuleAverages(
+	idObjSemester,
+	idObjStudent primitive.ObjectID,
+) (map[primitive.ObjectID]models.ModuleAverage, error) {
+	var moduleAverages []models.ModuleAverage
+
+	cursor, err := moduleAverageModel.GetAll(bson.D{
+		{
+			Key:   "semester",
+			Value: idObjSemester,
+		},
+		{
+			Key:   "student",
+			Value: idObjStudent,
+		},
+	}, &options.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &moduleAverages); err != nil {
+		return nil, err
+	}
+	averages := make(map[primitive.ObjectID]models.ModuleAverage)
+	for _, moduleAverage := range moduleAverages {
+		averages[moduleAverage.Module] = moduleAverage
+	}
+	return averages, nil
+}
+
+// Averages of the student in the semester. Until the semester is
+// closed, the averages are calculated with the current grades
+func (g *GradesService) GetStudentAverages(
+	claims *Claims,
+	idSemester,
+	idStudent string,
+) (*StudentAveragesRes, *res.ErrorRes) {
+	student, errRes := g.getClaimsStudent(claims, idStudent)
+	if errRes != nil {
+		return nil, errRes
+	}
+	idObjStudent, err := primitive.ObjectIDFromHex(student.ID)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	semester, errRes := getSemesterOrCurrent(idSemester)
+	if errRes != nil {
+		return nil, errRes
+	}
+	modules, errRes := g.getStudentSemesterModules(student.ID, idSemester)
+	if errRes != nil {
+		return nil, errRes
+	}
+	registered, err := g.getStudentModuleAverages(semester.ID, idObjStudent)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	// Students and attorneys only see the released grades
+	onlyReleased := claims.UserType != models.DIRECTOR && claims.UserType != models.DIRECTIVE
+
+	averagesRes := &StudentAveragesRes{
+		Student:  *student,
+		Semester: semester.ID.Hex(),
+		Modules:  []ModuleAverageRes{},
+	}
+	var averages []float64
+	for _, module := range modules {
+		moduleAverage := ModuleAverageRes{
+			Module:  module.ID.Hex(),
+			Subject: module.Subject.Subject,
+		}
+		if registeredAverage, exists := registered[module.ID]; exists {
+			moduleAverage.Average = &registeredAverage.Average
+			moduleAverage.Complete = registeredAverage.Complete
+			moduleAverage.IsFinal = true
+		} else {
+			programs, errRes := g.GetGradePrograms(module.ID.Hex())
+			if errRes != nil {
+				return nil, errRes
+			}
+			grades, errRes := g.GetStudentGrades(module.ID.Hex(), student.ID, onlyReleased)
+			if errRes != nil {
+				return nil, errRes
+			}
+			moduleAverage.Average, moduleAverage.Complete = getModuleAverage(grades, programs)
+		}
+		if moduleAverage.Average != nil {
+			averages = append(averages, *moduleAverage.Average)
+		}
+		averagesRes.Modules = append(averagesRes.Modules, moduleAverage)
+	}
+	// Semester average
+	averagesRes.Average, err = g.getSemesterAverage(semester.ID, idObjStudent)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if averagesRes.Average != nil {
+		averagesRes.IsFinal = true
+	} else {
+		averagesRes.Average = getMeanAverage(averages)
+	}
+	return averagesRes, nil
+}
@@ -0,0 +1,40 @@
+package services
+
+import "testing"
+
+func TestRoundAverage(t *testing.T) {
+	decimals := settingsData.AVERAGE_DECIMALS
+	rounding := settingsData.AVERAGE_ROUNDING
+	defer func() {
+		settingsData.AVERAGE_DECIMALS = decimals
+		settingsData.AVERAGE_ROUNDING = rounding
+	}()
+
+	tests := []struct {
+		name     string
+		decimals int
+		rounding string
+		average  float64
+		rounded  float64
+	}{
+		{"half up", 1, "half_up", 5.45, 5.5},
+		{"half up below the half", 1, "half_up", 5.44, 5.4},
+		{"half up is the default", 1, "", 5.45, 5.5},
+		{"half up two decimals", 2, "half_up", 5.445, 5.45},
+		{"half up without decimals", 0, "half_up", 4.5, 5},
+		{"half even down", 1, "half_even", 5.25, 5.2},
+		{"half even up", 1, "half_even", 5.35, 5.4},
+		{"down", 1, "down", 5.49, 5.4},
+		{"down exact", 1, "down", 5.3, 5.3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			settingsData.AVERAGE_DECIMALS = test.decimals
+			settingsData.AVERAGE_ROUNDING = test.rounding
+			if rounded := roundAverage(test.average); rounded != test.rounded {
+				t.Errorf("roundAverage(%v) = %v, want %v", test.average, rounded, test.rounded)
+			}
+		})
+	}
+}
@@ -4,10 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math"
 	"net/http"
 	"reflect"
-	"sync"
 
 	"github.com/CPU-commits/Intranet_BClassroom/db"
 	"github.com/CPU-commits/Intranet_BClassroom/funct"
@@ -25,6 +23,9 @@ var moduleService = NewModulesService()
 func init() {
 	validateDirectivesModule()
 	closeGrades()
+	releaseScheduledGrades()
+	publishScheduledWorks()
+	assignScheduledPeerReviews()
 }
 
 func getParentStudents(idObjUser primitive.ObjectID) ([]primitive.ObjectID, *res.ErrorRes) {
@@ -392,6 +393,17 @@ func validateDirectivesModule() {
 			}
 		}
 
+		if payload["template"] == true {
+			outOfTemplate, err := gradesService.isOutOfTemplate(idModule)
+			if err != nil {
+				return
+			}
+			if outOfTemplate {
+				success = false
+				messages = append(messages, "template")
+			}
+		}
+
 		min_grades := make(map[string]interface{})
 		v := reflect.ValueOf(payload["min_grades"])
 		if v.Kind() == reflect.Map {
@@ -418,240 +430,6 @@ func validateDirectivesModule() {
 	})
 }
 
-func closeGrades() {
-	nats.Subscribe("close_grades_semester", func(m *natsPackage.Msg) {
-		// Recovery if close channel
-		defer func() {
-			recovery := recover()
-			if recovery != nil {
-				fmt.Printf("A channel closed")
-			}
-		}()
-
-		allModules, err := moduleService.GetAllModulesSemester()
-		if err != nil {
-			return
-		}
-		min, _, err := GetMinNMaxGrade()
-		if err != nil {
-			return
-		}
-		semester, err := getCurrentSemester()
-		if err != nil {
-			return
-		}
-		minGrade := float64(min)
-
-		var gradesToRegister []interface{}
-		var allStudents []Student
-		c := make(chan int, 3)
-		var wg sync.WaitGroup
-		var lock sync.Mutex
-		for _, module := range allModules {
-			wg.Add(1)
-			c <- 1
-			go func(module models.Module, wg *sync.WaitGroup, lock *sync.Mutex, errRet *error) {
-				defer wg.Done()
-
-				idModule := module.ID.Hex()
-				// Get grades program
-				programs, errRes := gradesService.GetGradePrograms(idModule)
-				if errRes != nil {
-					*errRet = errRes.Err
-					close(c)
-					return
-				}
-				// Get module students
-				students, err := workService.getStudentsFromIdModule(idModule)
-				if err != nil {
-					*errRet = err
-					close(c)
-					return
-				}
-
-				for _, student := range students {
-					// Add Student to all students
-					var inStudents bool
-					lock.Lock()
-					for _, st := range allStudents {
-						if st.User.ID == student.User.ID {
-							inStudents = true
-							break
-						}
-					}
-					lock.Unlock()
-					if !inStudents {
-						lock.Lock()
-						allStudents = append(allStudents, student)
-						lock.Unlock()
-					}
-					// Register grades
-					idObjStudent, _ := primitive.ObjectIDFromHex(student.User.ID)
-
-					grades, errRes := gradesService.GetStudentGrades(idModule, student.User.ID)
-					if errRes != nil {
-						*errRet = errRes.Err
-						close(c)
-						return
-					}
-					// Evaluate grades
-					for i, grade := range grades {
-						if grade == nil {
-							gradeModel := models.NewModelGrade(
-								module.ID,
-								idObjStudent,
-								primitive.NilObjectID,
-								programs[i].ID,
-								primitive.NilObjectID, // System
-								minGrade,
-								false,
-							)
-
-							lock.Lock()
-							gradesToRegister = append(gradesToRegister, gradeModel)
-							lock.Unlock()
-						} else if grade.IsAcumulative {
-							for j, acum := range grade.Acumulative {
-								if acum == nil {
-									gradeModel := models.NewModelGrade(
-										module.ID,
-										idObjStudent,
-										programs[i].Acumulative[j].ID,
-										programs[i].ID,
-										primitive.NilObjectID,
-										minGrade,
-										true,
-									)
-
-									lock.Lock()
-									gradesToRegister = append(gradesToRegister, gradeModel)
-									lock.Unlock()
-								}
-							}
-						}
-					}
-				}
-				<-c
-			}(module, &wg, &lock, &err)
-		}
-		wg.Wait()
-		if err != nil {
-			return
-		}
-
-		if len(gradesToRegister) > 0 {
-			_, err = gradeModel.Use().InsertMany(db.Ctx, gradesToRegister)
-			if err != nil {
-				return
-			}
-		}
-		// Insert all averages
-		var averages []interface{}
-		cS := make(chan int, 5)
-
-		for _, student := range allStudents {
-			wg.Add(1)
-			cS <- 1
-			go func(student Student, wg *sync.WaitGroup, lock *sync.Mutex, errRet *error) {
-				defer wg.Done()
-
-				idObjStudent, err := primitive.ObjectIDFromHex(student.User.ID)
-				if err != nil {
-					*errRet = err
-					close(cS)
-					return
-				}
-				courses, errRes := FindCourses(&Claims{
-					ID:       student.User.ID,
-					UserType: models.STUDENT,
-				})
-				if errRes != nil {
-					*errRet = errRes.Err
-					close(cS)
-					return
-				}
-				modules, errRes := modulesService.GetModules(courses, models.STUDENT, true)
-				if errRes != nil {
-					*errRet = errRes.Err
-					close(cS)
-					return
-				}
-
-				var average float64
-				var haveAverage bool
-				for _, module := range modules {
-					program, errRes := gradesService.GetGradePrograms(module.ID.Hex())
-					if errRes != nil {
-						*errRet = errRes.Err
-						close(cS)
-						return
-					}
-					if len(program) > 0 {
-						haveAverage = true
-						grades, errRes := gradesService.GetStudentGrades(module.ID.Hex(), student.User.ID)
-						if errRes != nil {
-							*errRet = errRes.Err
-							close(cS)
-							return
-						}
-						for i, grade := range grades {
-							average += grade.Grade * (float64(program[i].Percentage) / 100)
-						}
-					}
-				}
-				if haveAverage {
-					var existsAverage *models.Average
-					cursor := averageModel.GetOne(bson.D{
-						{
-							Key:   "semester",
-							Value: semester.ID,
-						},
-						{
-							Key:   "student",
-							Value: idObjStudent,
-						},
-					})
-					if err := cursor.Decode(&existsAverage); err != nil && err.Error() != db.NO_SINGLE_DOCUMENT {
-						*errRet = err
-						return
-					}
-					if existsAverage == nil {
-						average = math.Round(average)
-						modelAverage := models.NewModelAverage(
-							average,
-							semester.ID,
-							idObjStudent,
-						)
-						lock.Lock()
-						averages = append(averages, modelAverage)
-						lock.Unlock()
-					}
-				}
-				<-cS
-			}(student, &wg, &lock, &err)
-		}
-		wg.Wait()
-		if err != nil {
-			return
-		}
-		if len(averages) > 0 {
-			_, err = averageModel.Use().InsertMany(db.Ctx, averages)
-			if err != nil {
-				return
-			}
-		}
-		// Respond
-		response := map[string]bool{
-			"success": true,
-		}
-		jsonResponse, err := json.Marshal(response)
-		if err != nil {
-			return
-		}
-		m.Respond(jsonResponse)
-	})
-}
-
 // Get URL file
 func GetAwsTokenFiles(keys []string) ([]string, error) {
 	// Request nats
@@ -16,72 +16,230 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-func (w *WorkSerice) TransformPointsToGrade(
-	scale float32,
-	minGrade,
-	points int,
-) float64 {
-	if points == 0 {
-		return float64(minGrade)
+// Late policy
+func (w *WorkSerice) getLateDateLimit(work *models.Work) time.Time {
+	if work.LatePolicy == nil {
+		return work.DateLimit.Time()
+	}
+	return work.DateLimit.Time().Add(time.Duration(work.LatePolicy.Window) * time.Hour)
+}
+
+func (w *WorkSerice) getLatePenalty(work *models.Work, dateSubmit time.Time) float64 {
+	if work.LatePolicy == nil || !dateSubmit.After(work.DateLimit.Time()) {
+		return 0
+	}
+	unit := time.Hour
+	if work.LatePolicy.Unit == "day" {
+		unit = 24 * time.Hour
+	}
+	// Every unit started counts as a full unit
+	units := math.Ceil(float64(dateSubmit.Sub(work.DateLimit.Time())) / float64(unit))
+	penalty := units * work.LatePolicy.Penalty
+	if work.LatePolicy.Cap > 0 && penalty > work.LatePolicy.Cap {
+		penalty = work.LatePolicy.Cap
+	}
+	return penalty
+}
+
+func (w *WorkSerice) applyLatePenalty(grade, minGrade, penalty float64) float64 {
+	if penalty == 0 {
+		return grade
+	}
+	finalGrade := math.Max(grade-penalty, minGrade)
+	return math.Round(finalGrade*10) / 10
+}
+
+func (w *WorkSerice) getDateSubmitStudent(work *models.Work, idObjStudent primitive.ObjectID) (time.Time, error) {
+	if work.Type == "form" {
+		access, err := w.getAccessFromIdStudentNIdWork(idObjStudent, work.ID)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return time.Time{}, nil
+			}
+			return time.Time{}, err
+		}
+		return w.getDateSubmitForm(work, access), nil
+	} else if work.Type == "files" {
+		var fUC *models.FileUploadedClassroom
+		cursor := fileUCModel.GetOne(bson.D{
+			{
+				Key:   "work",
+				Value: work.ID,
+			},
+			{
+				Key:   "student",
+				Value: idObjStudent,
+			},
+		})
+		if err := cursor.Decode(&fUC); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return time.Time{}, nil
+			}
+			return time.Time{}, err
+		}
+		return w.getDateSubmitFiles(fUC.Date, fUC.DateUpdate), nil
+	}
+	return time.Time{}, nil
+}
+
+// Without date finish the form was closed by the time or by the date limit
+func (w *WorkSerice) getDateSubmitForm(work *models.Work, access *models.FormAccess) time.Time {
+	if access.DateFinish != 0 {
+		return access.DateFinish.Time()
+	}
+	if work.FormAccess == "wtime" && work.TimeFormAccess > 0 {
+		return access.Date.Time().Add(time.Duration(work.TimeFormAccess) * time.Second)
+	}
+	if access.Date.Time().After(work.DateLimit.Time()) {
+		return access.Date.Time()
+	}
+	return work.DateLimit.Time()
+}
+
+func (w *WorkSerice) getDateSubmitFiles(date, dateUpdate primitive.DateTime) time.Time {
+	if dateUpdate != 0 {
+		return dateUpdate.Time()
+	}
+	return date.Time()
+}
+
+// Points of an auto-graded answer, with partial credit for
+// multiple_select, matching and ordering questions
+func (w *WorkSerice) getAnswerPoints(question *models.ItemQuestion, answer *models.Answer) int {
+	partialPoints := func(corrects int) int {
+		if corrects <= 0 || len(question.Corrects) == 0 {
+			return 0
+		}
+		ratio := float64(corrects) / float64(len(question.Corrects))
+		return int(math.Round(float64(question.Points) * ratio))
+	}
+
+	switch question.Type {
+	case "alternatives_correct", "true_false":
+		if question.Correct == answer.Answer {
+			return question.Points
+		}
+	case "multiple_select":
+		corrects := 0
+		for _, selected := range answer.Answers {
+			isCorrect := false
+			for _, correct := range question.Corrects {
+				if selected == correct {
+					isCorrect = true
+					break
+				}
+			}
+			if isCorrect {
+				corrects += 1
+			} else {
+				corrects -= 1
+			}
+		}
+		return partialPoints(corrects)
+	case "numeric":
+		if answer.Number != nil && question.Number != nil &&
+			math.Abs(*answer.Number-*question.Number) <= question.Tolerance {
+			return question.Points
+		}
+	case "matching", "ordering":
+		corrects := 0
+		for i, selected := range answer.Answers {
+			if i < len(question.Corrects) && question.Corrects[i] == selected {
+				corrects += 1
+			}
+		}
+		return partialPoints(corrects)
 	}
-	grade := (scale * float32(points)) + float32(minGrade)
-	finalGrade := math.Round(float64(grade)*10) / 10
-	return finalGrade
+	return 0
 }
 
+// Grade of the student from the submission of its group, with the
+// adjustment of the member and its own late penalty
 func (w *WorkSerice) updateGrade(
 	work *models.Work,
 	idObjStudent,
 	idObjEvaluator primitive.ObjectID,
 	points int,
 ) error {
+	var group *models.WorkGroup
+	if work.Groups != nil {
+		var err error
+		group, err = w.getStudentGroup(work.ID, idObjStudent)
+		if err != nil {
+			return err
+		}
+	}
+	idObjSubmitter := idObjStudent
+	if group != nil {
+		idObjSubmitter = group.Members[0]
+	}
 	var maxPoints int
 	if work.Type == "form" {
 		questions, err := w.getQuestionsFromIdForm(work.Form)
 		if err != nil {
 			return err
 		}
+		// Questions of the student
+		access, err := w.getAccessFromIdStudentNIdWork(idObjSubmitter, work.ID)
+		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			return err
+		}
+		questions = w.getQuestionsStudent(questions, access)
 		// MAX Points
-		var questionsWPoints []models.ItemQuestion
-		for _, question := range questions {
-			if question.Type != "alternatives" {
-				maxPoints += question.Points
-				questionsWPoints = append(questionsWPoints, question)
-			}
+		questionsWPoints := w.getQuestionsWPoints(work, questions)
+		for _, question := range questionsWPoints {
+			maxPoints += question.Points
 		}
 		// Points
 		points, _, err = w.getStudentEvaluate(
 			questionsWPoints,
-			idObjStudent,
+			idObjSubmitter,
 			work.ID,
 		)
 		if err != nil {
 			return err
 		}
+		// Attempts
+		points, err = w.getAttemptsPoints(work, idObjSubmitter, points, maxPoints)
+		if err != nil {
+			return err
+		}
 	} else if work.Type == "files" {
 		for _, item := range work.Pattern {
 			maxPoints += item.Points
 		}
 	}
 	// Update grade
-	min, max, err := GetMinNMaxGrade()
+	scale, err := getGradingScale(work.Module)
 	if err != nil {
 		return err
 	}
-
-	var grade float64
-	if work.Type != "in-person" {
-		var scale float32 = float32(max-min) / float32(maxPoints)
-		grade = w.TransformPointsToGrade(
-			scale,
-			min,
-			points,
-		)
-	} else {
-		grade = float64(points) / 1000
+	grade := scale.Grade(points, maxPoints)
+	// Late penalty
+	workStudent, err := w.getWorkStudent(work, idObjStudent)
+	if err != nil {
+		return err
 	}
+	dateSubmit, err := w.getDateSubmitStudent(workStudent, idObjSubmitter)
+	if err != nil {
+		return err
+	}
+	latePenalty := w.getLatePenalty(workStudent, dateSubmit)
+	grade = w.applyLatePenalty(grade, scale.Min(), latePenalty)
+	grade = w.applyGroupAdjustment(group, idObjStudent, grade, scale)
+	return w.saveGrade(work, idObjStudent, idObjEvaluator, grade, latePenalty)
+}
+
+func (w *WorkSerice) saveGrade(
+	work *models.Work,
+	idObjStudent,
+	idObjEvaluator primitive.ObjectID,
+	grade,
+	latePenalty float64,
+) error {
 	if work.IsQualified {
 		// Get grade
 		var gradeD *models.GradesProgram
@@ -112,24 +270,23 @@ func (w *WorkSerice) updateGrade(
 			})
 		}
 
-		_, err = gradeModel.Use().UpdateOne(
-			db.Ctx,
+		err := gradeHistoryService.updateGrade(
 			match,
-			bson.D{{
-				Key: "$set",
-				Value: bson.M{
-					"grade":     grade,
-					"date":      primitive.NewDateTimeFromTime(time.Now()),
-					"evaluator": idObjEvaluator,
-				},
-			}},
+			bson.M{
+				"grade":        grade,
+				"late_penalty": latePenalty,
+				"date":         primitive.NewDateTimeFromTime(time.Now()),
+				"evaluator":    idObjEvaluator,
+			},
+			idObjEvaluator,
+			models.GRADE_SOURCE_REEVALUATION,
+			"",
 		)
 		if err != nil {
 			return err
 		}
 	} else {
-		_, err = workGradeModel.Use().UpdateOne(
-			db.Ctx,
+		err := gradeHistoryService.updateWorkGrade(
 			bson.D{
 				{
 					Key:   "module",
@@ -144,20 +301,20 @@ func (w *WorkSerice) updateGrade(
 					Value: work.ID,
 				},
 			},
-			bson.D{{
-				Key: "$set",
-				Value: bson.M{
-					"grade":     grade,
-					"date":      primitive.NewDateTimeFromTime(time.Now()),
-					"evaluator": idObjEvaluator,
-				},
-			}},
+			bson.M{
+				"grade":        grade,
+				"late_penalty": latePenalty,
+				"date":         primitive.NewDateTimeFromTime(time.Now()),
+				"evaluator":    idObjEvaluator,
+			},
+			idObjEvaluator,
+			models.GRADE_SOURCE_REEVALUATION,
 		)
 		if err != nil {
 			return err
 		}
 	}
-	return nil
+	return w.resolveAppeals(work, idObjStudent, grade)
 }
 
 func (w *WorkSerice) UploadPointsStudent(
@@ -261,9 +418,17 @@ func (w *WorkSerice) UploadPointsStudent(
 			StatusCode: http.StatusBadRequest,
 		}
 	}
+	// Answers of the group of the student
+	idObjSubmitter, err := w.getSubmitter(work, idObjStudent)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	// Form Access
 	_, err = w.getAccessFromIdStudentNIdWork(
-		idObjStudent,
+		idObjSubmitter,
 		idObjWork,
 	)
 	if err != nil {
@@ -285,7 +450,7 @@ func (w *WorkSerice) UploadPointsStudent(
 		},
 		{
 			Key:   "student",
-			Value: idObjStudent,
+			Value: idObjSubmitter,
 		},
 	})
 	if err := cursor.Decode(&evaluatedAnswer); err != nil && err.Error() != db.NO_SINGLE_DOCUMENT {
@@ -312,7 +477,7 @@ func (w *WorkSerice) UploadPointsStudent(
 	} else {
 		modelEvaluatedAnswer := models.NewModelEvaluatedAnswers(
 			points,
-			idObjStudent,
+			idObjSubmitter,
 			idObjQuestion,
 			idObjWork,
 			idObjEvaluator,
@@ -325,31 +490,129 @@ func (w *WorkSerice) UploadPointsStudent(
 			}
 		}
 	}
-	// Grade
+	// Grade, the members of the group share the evaluation
 	if work.IsRevised && work.IsQualified {
-		err = w.updateGrade(work, idObjStudent, idObjEvaluator, 0)
+		members, err := w.getGroupMembers(work, idObjStudent)
 		if err != nil {
 			return &res.ErrorRes{
 				Err:        err,
 				StatusCode: http.StatusServiceUnavailable,
 			}
 		}
-		// Send notifications
-		nats.PublishEncode("notify/classroom", res.NotifyClassroom{
-			Title: fmt.Sprintf("Calificación N%d° actualizada", gradeProgram.Number),
-			Link: fmt.Sprintf(
-				"/aula_virtual/clase/%s/calificaciones",
-				work.Module.Hex(),
-			),
-			Where:  module.Subject.Hex(),
-			Room:   module.Section.Hex(),
-			Type:   res.GRADE,
-			IDUser: idStudent,
-		})
+		for _, member := range members {
+			err = w.updateGrade(work, member, idObjEvaluator, 0)
+			if err != nil {
+				return &res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusServiceUnavailable,
+				}
+			}
+			released, err := w.isStudentGradeReleased(work, member)
+			if err != nil {
+				return &res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusServiceUnavailable,
+				}
+			}
+			if !released {
+				continue
+			}
+			// Send notifications
+			nats.PublishEncode("notify/classroom", res.NotifyClassroom{
+				Title: fmt.Sprintf("Calificación N%d° actualizada", gradeProgram.Number),
+				Link: fmt.Sprintf(
+					"/aula_virtual/clase/%s/calificaciones",
+					work.Module.Hex(),
+				),
+				Where:  module.Subject.Hex(),
+				Room:   module.Section.Hex(),
+				Type:   res.GRADE,
+				IDUser: member.Hex(),
+			})
+		}
 	}
 	return nil
 }
 
+// Pattern of the work with the level, points and comment given to the student
+func (w *WorkSerice) getFilledRubric(
+	pattern []models.WorkPattern,
+	evaluate []models.EvaluatedFiles,
+) []RubricCriterionRes {
+	var rubric []RubricCriterionRes
+	for _, item := range pattern {
+		criterion := RubricCriterionRes{
+			Criterion: item,
+		}
+		for _, evaluated := range evaluate {
+			if evaluated.Pattern != item.ID {
+				continue
+			}
+			criterion.Points = evaluated.Points
+			criterion.Comment = evaluated.Comment
+			for i := range item.Levels {
+				if item.Levels[i].ID == evaluated.Level {
+					criterion.Level = &item.Levels[i]
+					break
+				}
+			}
+			break
+		}
+		rubric = append(rubric, criterion)
+	}
+	return rubric
+}
+
+// Points of the evaluated item, with levels the points are the points of the level
+func (w *WorkSerice) getPatternPoints(
+	item *models.WorkPattern,
+	evaluate *forms.EvaluateFilesForm,
+) (int, primitive.ObjectID, *res.ErrorRes) {
+	if len(item.Levels) == 0 {
+		if evaluate.Level != "" {
+			return 0, primitive.NilObjectID, &res.ErrorRes{
+				Err:        fmt.Errorf("el item #%s no tiene niveles de desempeño", evaluate.Pattern),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		if evaluate.Points == nil {
+			return 0, primitive.NilObjectID, &res.ErrorRes{
+				Err:        fmt.Errorf("indique los puntos del item #%s", evaluate.Pattern),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		if item.Points < *evaluate.Points {
+			return 0, primitive.NilObjectID, &res.ErrorRes{
+				Err:        fmt.Errorf("los puntos evaluados superan el máx. del item"),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		return *evaluate.Points, primitive.NilObjectID, nil
+	}
+	if evaluate.Level == "" {
+		return 0, primitive.NilObjectID, &res.ErrorRes{
+			Err:        fmt.Errorf("el item #%s se evalúa con un nivel de desempeño", evaluate.Pattern),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjLevel, err := primitive.ObjectIDFromHex(evaluate.Level)
+	if err != nil {
+		return 0, primitive.NilObjectID, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	for _, level := range item.Levels {
+		if level.ID == idObjLevel {
+			return level.Points, idObjLevel, nil
+		}
+	}
+	return 0, primitive.NilObjectID, &res.ErrorRes{
+		Err:        fmt.Errorf("no existe el nivel #%s en el item", evaluate.Level),
+		StatusCode: http.StatusNotFound,
+	}
+}
+
 func (w *WorkSerice) UploadEvaluateFiles(
 	evalute []forms.EvaluateFilesForm,
 	idWork,
@@ -405,6 +668,12 @@ func (w *WorkSerice) UploadEvaluateFiles(
 			StatusCode: http.StatusUnauthorized,
 		}
 	}
+	if w.isPeerReviewOpen(work) {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("la revisión de pares de este trabajo todavía no termina"),
+			StatusCode: http.StatusUnauthorized,
+		}
+	}
 	// Get module
 	module, err := moduleService.GetModuleFromID(work.Module.Hex())
 	if err != nil {
@@ -431,12 +700,20 @@ func (w *WorkSerice) UploadEvaluateFiles(
 			}
 		}
 	}
+	// Files of the group of the student
+	idObjSubmitter, err := w.getSubmitter(work, idObjStudent)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	// Get evaluate student
 	var fUC *models.FileUploadedClassroom
 	cursor := fileUCModel.GetOne(bson.D{
 		{
 			Key:   "student",
-			Value: idObjStudent,
+			Value: idObjSubmitter,
 		},
 		{
 			Key:   "work",
@@ -451,6 +728,7 @@ func (w *WorkSerice) UploadEvaluateFiles(
 	}
 	// Build model
 	var evaluateFiles []interface{}
+	pointsTotal := 0
 	for _, ev := range evalute {
 		idObjPattern, err := primitive.ObjectIDFromHex(ev.Pattern)
 		if err != nil {
@@ -459,25 +737,24 @@ func (w *WorkSerice) UploadEvaluateFiles(
 				StatusCode: http.StatusBadRequest,
 			}
 		}
-		exists := false
-		for _, item := range work.Pattern {
-			if item.ID == idObjPattern {
-				if item.Points < *ev.Points {
-					return &res.ErrorRes{
-						Err:        fmt.Errorf("los puntos evaluados superan el máx. del item"),
-						StatusCode: http.StatusBadRequest,
-					}
-				}
-				exists = true
+		var patternItem *models.WorkPattern
+		for i := range work.Pattern {
+			if work.Pattern[i].ID == idObjPattern {
+				patternItem = &work.Pattern[i]
 				break
 			}
 		}
-		if !exists {
+		if patternItem == nil {
 			return &res.ErrorRes{
 				Err:        fmt.Errorf("no existe el item #%s en este trabajo", ev.Pattern),
 				StatusCode: http.StatusNotFound,
 			}
 		}
+		points, idObjLevel, errRes := w.getPatternPoints(patternItem, &ev)
+		if errRes != nil {
+			return errRes
+		}
+		pointsTotal += points
 		// Get evaluate files
 		var idEvaluate primitive.ObjectID
 		uploaded := false
@@ -493,9 +770,35 @@ func (w *WorkSerice) UploadEvaluateFiles(
 			evaluateFiles = append(evaluateFiles, models.EvaluatedFiles{
 				ID:      primitive.NewObjectID(),
 				Pattern: idObjPattern,
-				Points:  *ev.Points,
+				Points:  points,
+				Level:   idObjLevel,
+				Comment: ev.Comment,
 			})
 		} else {
+			set := bson.M{
+				"evaluate.$.points": points,
+			}
+			unset := bson.M{}
+			if !idObjLevel.IsZero() {
+				set["evaluate.$.level"] = idObjLevel
+			} else {
+				unset["evaluate.$.level"] = ""
+			}
+			if ev.Comment != "" {
+				set["evaluate.$.comment"] = ev.Comment
+			} else {
+				unset["evaluate.$.comment"] = ""
+			}
+			update := bson.D{{
+				Key:   "$set",
+				Value: set,
+			}}
+			if len(unset) > 0 {
+				update = append(update, bson.E{
+					Key:   "$unset",
+					Value: unset,
+				})
+			}
 			_, err = fileUCModel.Use().UpdateOne(
 				db.Ctx,
 				bson.D{
@@ -512,12 +815,7 @@ func (w *WorkSerice) UploadEvaluateFiles(
 						},
 					},
 				},
-				bson.D{{
-					Key: "$set",
-					Value: bson.M{
-						"evaluate.$.points": *ev.Points,
-					},
-				}},
+				update,
 			)
 			if err != nil {
 				return &res.ErrorRes{
@@ -542,32 +840,52 @@ func (w *WorkSerice) UploadEvaluateFiles(
 				StatusCode: http.StatusServiceUnavailable,
 			}
 		}
-	} else if reavaluate {
-		points := 0
-		for _, eva := range evalute {
-			points += *eva.Points
+	}
+	if !reavaluate && (!work.IsRevised || !work.IsQualified) {
+		return nil
+	}
+	// The members of the group share the evaluation
+	members, err := w.getGroupMembers(work, idObjStudent)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	for _, member := range members {
+		if reavaluate {
+			err = w.updateGrade(work, member, idObjEvaluator, pointsTotal)
+			if err != nil {
+				return &res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusServiceUnavailable,
+				}
+			}
+		}
+		if !work.IsRevised || !work.IsQualified {
+			continue
 		}
-		err = w.updateGrade(work, idObjStudent, idObjEvaluator, points)
+		released, err := w.isStudentGradeReleased(work, member)
 		if err != nil {
 			return &res.ErrorRes{
 				Err:        err,
 				StatusCode: http.StatusServiceUnavailable,
 			}
 		}
-	}
-	// Send notifications
-	if work.IsRevised && work.IsQualified {
-		nats.PublishEncode("notify/classroom", res.NotifyClassroom{
-			Title: fmt.Sprintf("Calificación N%d° actualizada", gradeProgram.Number),
-			Link: fmt.Sprintf(
-				"/aula_virtual/clase/%s/calificaciones",
-				work.Module.Hex(),
-			),
-			Where:  module.Subject.Hex(),
-			Room:   module.Section.Hex(),
-			Type:   res.GRADE,
-			IDUser: idStudent,
-		})
+		// Send notifications
+		if released {
+			nats.PublishEncode("notify/classroom", res.NotifyClassroom{
+				Title: fmt.Sprintf("Calificación N%d° actualizada", gradeProgram.Number),
+				Link: fmt.Sprintf(
+					"/aula_virtual/clase/%s/calificaciones",
+					work.Module.Hex(),
+				),
+				Where:  module.Subject.Hex(),
+				Room:   module.Section.Hex(),
+				Type:   res.GRADE,
+				IDUser: member.Hex(),
+			})
+		}
 	}
 	return nil
 }
@@ -679,27 +997,43 @@ func (w *WorkSerice) UploadEvaluateInperson(
 			}
 		}
 	}
-	min, max, err := GetMinNMaxGrade()
+	scale, err := getGradingScale(work.Module)
 	if err != nil {
 		return &res.ErrorRes{
 			Err:        err,
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
-	if min > int(evalute.Pregrade) && max < int(evalute.Pregrade) {
+	pregrade := float64(evalute.Pregrade)
+	if scale.Min() > pregrade || scale.Max() < pregrade {
 		return &res.ErrorRes{
-			Err:        fmt.Errorf("la calificación debe estar entre %d y %d", min, max),
+			Err:        fmt.Errorf("la calificación debe estar entre %v y %v", scale.Min(), scale.Max()),
 			StatusCode: http.StatusBadRequest,
 		}
 	}
 
+	// Session of the group of the student
+	var group *models.WorkGroup
+	if work.Groups != nil {
+		group, err = w.getStudentGroup(idObjWork, idObjStudent)
+		if err != nil {
+			return &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+	}
+	members := []primitive.ObjectID{idObjStudent}
+	if group != nil {
+		members = group.Members
+	}
 	// Upload
 	// Exists session
 	var session *models.Session
 	cursor := sessionModel.GetOne(bson.D{
 		{
 			Key:   "student",
-			Value: idObjStudent,
+			Value: members[0],
 		},
 		{
 			Key:   "work",
@@ -717,7 +1051,7 @@ func (w *WorkSerice) UploadEvaluateInperson(
 	if session == nil {
 		newSession, err := models.NewModelSession(
 			evalute,
-			idObjStudent,
+			members[0],
 			idObjWork,
 		)
 		if err != nil {
@@ -758,28 +1092,42 @@ func (w *WorkSerice) UploadEvaluateInperson(
 			}
 		}
 	}
-	if reavaluate {
-		err = w.updateGrade(work, idObjStudent, idObjEvaluator, int(evalute.Pregrade*1000))
+	// The members of the group share the evaluation
+	for _, member := range members {
+		if reavaluate {
+			grade := w.applyGroupAdjustment(group, member, roundGrade(scale, pregrade), scale)
+			err = w.saveGrade(work, member, idObjEvaluator, grade, 0)
+			if err != nil {
+				return &res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusServiceUnavailable,
+				}
+			}
+		}
+		if !work.IsRevised || !work.IsQualified {
+			continue
+		}
+		released, err := w.isStudentGradeReleased(work, member)
 		if err != nil {
 			return &res.ErrorRes{
 				Err:        err,
 				StatusCode: http.StatusServiceUnavailable,
 			}
 		}
-	}
-	// Send notifications
-	if work.IsRevised && work.IsQualified {
-		nats.PublishEncode("notify/classroom", res.NotifyClassroom{
-			Title: fmt.Sprintf("Calificación N%d° actualizada", number),
-			Link: fmt.Sprintf(
-				"/aula_virtual/clase/%s/calificaciones",
-				work.Module.Hex(),
-			),
-			Where:  module.Subject.Hex(),
-			Room:   module.Section.Hex(),
-			Type:   res.GRADE,
-			IDUser: idStudent,
-		})
+		// Send notifications
+		if released {
+			nats.PublishEncode("notify/classroom", res.NotifyClassroom{
+				Title: fmt.Sprintf("Calificación N%d° actualizada", number),
+				Link: fmt.Sprintf(
+					"/aula_virtual/clase/%s/calificaciones",
+					work.Module.Hex(),
+				),
+				Where:  module.Subject.Hex(),
+				Room:   module.Section.Hex(),
+				Type:   res.GRADE,
+				IDUser: member.Hex(),
+			})
+		}
 	}
 	return nil
 }
@@ -807,7 +1155,6 @@ func (w *WorkSerice) gradeForm(
 		}
 	}
 	// Get questions form
-	var questionsWPoints []models.ItemQuestion
 	questions, err := w.getQuestionsFromIdForm(work.Form)
 	if err != nil {
 		return nil, &res.ErrorRes{
@@ -815,16 +1162,18 @@ func (w *WorkSerice) gradeForm(
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
-	for _, question := range questions {
-		if question.Type != "alternatives" {
-			questionsWPoints = append(questionsWPoints, question)
-		}
-	}
+	questionsWPoints := w.getQuestionsWPoints(work, questions)
 	// Get evaluted students
+	var maxPoints int
+	for _, question := range questionsWPoints {
+		maxPoints += question.Points
+	}
 	type StudentPoints struct {
 		ID          primitive.ObjectID
 		Points      int
+		MaxPoints   int
 		ExistsGrade bool
+		LatePenalty float64
 	}
 	var studentsPoints []StudentPoints
 	// To add evaluated status
@@ -852,9 +1201,27 @@ func (w *WorkSerice) gradeForm(
 				setError(errRes)
 				return
 			}
+			// Student extension
+			workStudent, err := w.getWorkStudent(work, idObjStudent)
+			if err != nil {
+				setError(&res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusServiceUnavailable,
+				})
+				return
+			}
+			// Submission of the group of the student
+			idObjSubmitter, err := w.getSubmitter(work, idObjStudent)
+			if err != nil {
+				setError(&res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusServiceUnavailable,
+				})
+				return
+			}
 			// Get form access
-			_, err = w.getAccessFromIdStudentNIdWork(
-				idObjStudent,
+			access, err := w.getAccessFromIdStudentNIdWork(
+				idObjSubmitter,
 				work.ID,
 			)
 			if err != nil {
@@ -866,19 +1233,27 @@ func (w *WorkSerice) gradeForm(
 					return
 				}
 				lock.Lock()
-				studentsWithoutAccess = append(studentsWithoutAccess, idObjStudent)
+				if idObjSubmitter == idObjStudent {
+					studentsWithoutAccess = append(studentsWithoutAccess, idObjStudent)
+				}
 				studentsPoints = append(studentsPoints, StudentPoints{
 					ID:          idObjStudent,
 					Points:      0,
+					MaxPoints:   maxPoints,
 					ExistsGrade: existsGrade,
 				})
 				lock.Unlock()
 				return
 			}
 			// Get evaluate
+			questionsStudent := w.getQuestionsStudent(questionsWPoints, access)
+			maxPointsStudent := 0
+			for _, question := range questionsStudent {
+				maxPointsStudent += question.Points
+			}
 			points, prom, err := w.getStudentEvaluate(
-				questionsWPoints,
-				idObjStudent,
+				questionsStudent,
+				idObjSubmitter,
 				work.ID,
 			)
 			if err != nil {
@@ -895,11 +1270,22 @@ func (w *WorkSerice) gradeForm(
 				})
 				return
 			}
+			// Attempts
+			points, err = w.getAttemptsPoints(work, idObjSubmitter, points, maxPointsStudent)
+			if err != nil {
+				setError(&res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusServiceUnavailable,
+				})
+				return
+			}
 			lock.Lock()
 			studentsPoints = append(studentsPoints, StudentPoints{
 				ID:          idObjStudent,
 				Points:      points,
+				MaxPoints:   maxPointsStudent,
 				ExistsGrade: existsGrade,
+				LatePenalty: w.getLatePenalty(workStudent, w.getDateSubmitForm(workStudent, access)),
 			})
 			lock.Unlock()
 		},
@@ -910,8 +1296,8 @@ func (w *WorkSerice) gradeForm(
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
-	// Get min max grade
-	minGrade, maxGrade, err := GetMinNMaxGrade()
+	// Get grading scale
+	scale, err := getGradingScale(work.Module)
 	if err != nil {
 		return nil, &res.ErrorRes{
 			Err:        err,
@@ -920,21 +1306,12 @@ func (w *WorkSerice) gradeForm(
 	}
 	// Transform grades
 	var studentsGrade []StudentGrades
-	var maxPoints int
-	for _, question := range questionsWPoints {
-		maxPoints += question.Points
-	}
-
-	var scale float32 = float32(maxGrade-minGrade) / float32(maxPoints)
 	for _, student := range studentsPoints {
-		grade := w.TransformPointsToGrade(
-			scale,
-			minGrade,
-			student.Points,
-		)
+		grade := scale.Grade(student.Points, student.MaxPoints)
 		studentsGrade = append(studentsGrade, StudentGrades{
 			ID:          student.ID,
-			Grade:       grade,
+			Grade:       w.applyLatePenalty(grade, scale.Min(), student.LatePenalty),
+			LatePenalty: student.LatePenalty,
 			ExistsGrade: student.ExistsGrade,
 		})
 	}
@@ -989,6 +1366,7 @@ func (w *WorkSerice) gradeFiles(
 		Student     primitive.ObjectID
 		Points      int
 		ExistsGrade bool
+		LatePenalty float64
 	}
 	var lock sync.Mutex
 	var studentsPoints []StudentPoints
@@ -1013,6 +1391,24 @@ func (w *WorkSerice) gradeFiles(
 				setError(errRes)
 				return
 			}
+			// Student extension
+			workStudent, err := w.getWorkStudent(work, idObjStudent)
+			if err != nil {
+				setError(&res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusServiceUnavailable,
+				})
+				return
+			}
+			// Submission of the group of the student
+			idObjSubmitter, err := w.getSubmitter(work, idObjStudent)
+			if err != nil {
+				setError(&res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusServiceUnavailable,
+				})
+				return
+			}
 			// Get files uploaded W Points
 			var fUC *models.FileUploadedClassroom
 			cursor := fileUCModel.GetOne(bson.D{
@@ -1022,7 +1418,7 @@ func (w *WorkSerice) gradeFiles(
 				},
 				{
 					Key:   "student",
-					Value: idObjStudent,
+					Value: idObjSubmitter,
 				},
 			})
 			if err := cursor.Decode(&fUC); err != nil {
@@ -1060,6 +1456,10 @@ func (w *WorkSerice) gradeFiles(
 				Student:     idObjStudent,
 				Points:      points,
 				ExistsGrade: existsGrade,
+				LatePenalty: w.getLatePenalty(
+					workStudent,
+					w.getDateSubmitFiles(fUC.Date, fUC.DateUpdate),
+				),
 			})
 			lock.Unlock()
 		},
@@ -1067,8 +1467,8 @@ func (w *WorkSerice) gradeFiles(
 	if errRes != nil {
 		return nil, errRes
 	}
-	// Get min max grade
-	minGrade, maxGrade, err := GetMinNMaxGrade()
+	// Get grading scale
+	scale, err := getGradingScale(work.Module)
 	if err != nil {
 		return nil, &res.ErrorRes{
 			Err:        err,
@@ -1082,16 +1482,12 @@ func (w *WorkSerice) gradeFiles(
 		maxPoints += item.Points
 	}
 
-	var scale float32 = float32(maxGrade-minGrade) / float32(maxPoints)
 	for _, student := range studentsPoints {
-		grade := w.TransformPointsToGrade(
-			scale,
-			minGrade,
-			student.Points,
-		)
+		grade := scale.Grade(student.Points, maxPoints)
 		studentsGrade = append(studentsGrade, StudentGrades{
-			ID:    student.Student,
-			Grade: grade,
+			ID:          student.Student,
+			Grade:       w.applyLatePenalty(grade, scale.Min(), student.LatePenalty),
+			LatePenalty: student.LatePenalty,
 		})
 	}
 	// Update work status
@@ -1116,6 +1512,14 @@ func (w *WorkSerice) gradeInperson(
 	students []Student,
 	idObjUser primitive.ObjectID,
 ) ([]StudentGrades, *res.ErrorRes) {
+	// Get grading scale
+	scale, err := getGradingScale(work.Module)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	// Get grades student
 	var studentsGrade []StudentGrades
 	var lock sync.Mutex
@@ -1143,13 +1547,22 @@ func (w *WorkSerice) gradeInperson(
 				})
 				return
 			}
+			// Session of the group of the student
+			idObjSubmitter, err := w.getSubmitter(work, idObjStudent)
+			if err != nil {
+				setError(&res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusServiceUnavailable,
+				})
+				return
+			}
 			// Get session
 			var session *models.Session
 
 			cursor := sessionModel.GetOne(bson.D{
 				{
 					Key:   "student",
-					Value: idObjStudent,
+					Value: idObjSubmitter,
 				},
 				{
 					Key:   "work",
@@ -1174,7 +1587,7 @@ func (w *WorkSerice) gradeInperson(
 			lock.Lock()
 			studentsGrade = append(studentsGrade, StudentGrades{
 				ID:          idObjStudent,
-				Grade:       session.PreGrade,
+				Grade:       roundGrade(scale, session.PreGrade),
 				ExistsGrade: existsGrade,
 			})
 			lock.Unlock()
@@ -1193,6 +1606,7 @@ func (w *WorkSerice) GradeWork(
 	idWork,
 	idUser,
 	workType string,
+	draft bool,
 ) *res.ErrorRes {
 	idObjWork, err := primitive.ObjectIDFromHex(idWork)
 	if err != nil {
@@ -1231,7 +1645,7 @@ func (w *WorkSerice) GradeWork(
 			StatusCode: http.StatusBadRequest,
 		}
 	}
-	if time.Now().Before(work.DateLimit.Time()) {
+	if time.Now().Before(w.getLateDateLimit(work)) {
 		return &res.ErrorRes{
 			Err:        fmt.Errorf("este trabajo todavía no se puede calificar"),
 			StatusCode: http.StatusUnauthorized,
@@ -1243,6 +1657,51 @@ func (w *WorkSerice) GradeWork(
 			StatusCode: http.StatusForbidden,
 		}
 	}
+	if work.IsQualified && work.Grade.IsZero() {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("este trabajo no tiene una calificación asignada"),
+			StatusCode: http.StatusConflict,
+		}
+	}
+	if w.isPeerReviewOpen(work) {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("la revisión de pares de este trabajo todavía no termina"),
+			StatusCode: http.StatusUnauthorized,
+		}
+	}
+	// Check extensions
+	var extensions []models.WorkExtension
+	cursorE, err := workExtensionModel.GetAll(bson.D{{
+		Key:   "work",
+		Value: idObjWork,
+	}}, &options.FindOptions{})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if err := cursorE.All(db.Ctx, &extensions); err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	for _, extension := range extensions {
+		workStudent, err := w.getWorkStudent(work, extension.Student)
+		if err != nil {
+			return &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		if time.Now().Before(w.getLateDateLimit(workStudent)) {
+			return &res.ErrorRes{
+				Err:        fmt.Errorf("existen prórrogas vigentes en este trabajo"),
+				StatusCode: http.StatusUnauthorized,
+			}
+		}
+	}
 	// Get module
 	module, err := moduleService.GetModuleFromID(work.Module.Hex())
 	if err != nil {
@@ -1291,6 +1750,13 @@ func (w *WorkSerice) GradeWork(
 	if errRes != nil {
 		return errRes
 	}
+	// Adjustments of the members of the groups
+	if err := w.applyGroupsAdjustments(work, studentsGrade); err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	// Update work status
 	_, err = workModel.Use().UpdateByID(db.Ctx, idObjWork, bson.D{{
 		Key: "$set",
@@ -1311,6 +1777,7 @@ func (w *WorkSerice) GradeWork(
 			work,
 			idObjUser,
 			program,
+			draft,
 		)
 		if err != nil {
 			return &res.ErrorRes{
@@ -1329,15 +1796,32 @@ func (w *WorkSerice) GradeWork(
 				idObjWork,
 				student.Grade,
 			)
+			modelWorkGrade.LatePenalty = student.LatePenalty
+			modelWorkGrade.Draft = draft
 			modelsGrades = append(modelsGrades, modelWorkGrade)
 		}
-		_, err := workGradeModel.Use().InsertMany(db.Ctx, modelsGrades)
+		inserted, err := workGradeModel.Use().InsertMany(db.Ctx, modelsGrades)
 		if err != nil {
 			return &res.ErrorRes{
 				Err:        err,
 				StatusCode: http.StatusServiceUnavailable,
 			}
 		}
+		err = gradeHistoryService.registerInserted(
+			modelsGrades,
+			inserted.InsertedIDs,
+			idObjUser,
+			models.GRADE_SOURCE_EVALUATION,
+		)
+		if err != nil {
+			return &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+	}
+	if draft {
+		return nil
 	}
 
 	// Send notifications
@@ -1352,6 +1836,11 @@ func (w *WorkSerice) GradeWork(
 		Room:  module.Section.Hex(),
 		Type:  res.GRADE,
 	})
-
+	if err := w.publishFeedback(work); err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	return nil
 }
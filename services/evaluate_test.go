@@ -0,0 +1,115 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+)
+
+func TestGetAnswerPoints(t *testing.T) {
+	number := 3.14
+	closeNumber := 3.15
+	farNumber := 3.5
+
+	tests := []struct {
+		name     string
+		question models.ItemQuestion
+		answer   models.Answer
+		points   int
+	}{
+		{
+			name:     "alternatives correct",
+			question: models.ItemQuestion{Type: "alternatives_correct", Points: 10, Correct: 2},
+			answer:   models.Answer{Answer: 2},
+			points:   10,
+		},
+		{
+			name:     "alternatives incorrect",
+			question: models.ItemQuestion{Type: "alternatives_correct", Points: 10, Correct: 2},
+			answer:   models.Answer{Answer: 1},
+			points:   0,
+		},
+		{
+			name:     "true false correct",
+			question: models.ItemQuestion{Type: "true_false", Points: 5, Correct: 1},
+			answer:   models.Answer{Answer: 1},
+			points:   5,
+		},
+		{
+			name:     "multiple select all corrects",
+			question: models.ItemQuestion{Type: "multiple_select", Points: 10, Corrects: []int{0, 2}},
+			answer:   models.Answer{Answers: []int{0, 2}},
+			points:   10,
+		},
+		{
+			name:     "multiple select partial",
+			question: models.ItemQuestion{Type: "multiple_select", Points: 10, Corrects: []int{0, 2}},
+			answer:   models.Answer{Answers: []int{2}},
+			points:   5,
+		},
+		{
+			name:     "multiple select incorrect subtracts",
+			question: models.ItemQuestion{Type: "multiple_select", Points: 10, Corrects: []int{0, 2}},
+			answer:   models.Answer{Answers: []int{0, 1}},
+			points:   0,
+		},
+		{
+			name:     "multiple select without answers",
+			question: models.ItemQuestion{Type: "multiple_select", Points: 10, Corrects: []int{0, 2}},
+			answer:   models.Answer{},
+			points:   0,
+		},
+		{
+			name:     "numeric within tolerance",
+			question: models.ItemQuestion{Type: "numeric", Points: 8, Number: &number, Tolerance: 0.01},
+			answer:   models.Answer{Number: &closeNumber},
+			points:   8,
+		},
+		{
+			name:     "numeric out of tolerance",
+			question: models.ItemQuestion{Type: "numeric", Points: 8, Number: &number, Tolerance: 0.01},
+			answer:   models.Answer{Number: &farNumber},
+			points:   0,
+		},
+		{
+			name:     "numeric without answer",
+			question: models.ItemQuestion{Type: "numeric", Points: 8, Number: &number},
+			answer:   models.Answer{},
+			points:   0,
+		},
+		{
+			name:     "matching partial",
+			question: models.ItemQuestion{Type: "matching", Points: 9, Corrects: []int{2, 0, 1}},
+			answer:   models.Answer{Answers: []int{2, 1, 0}},
+			points:   3,
+		},
+		{
+			name:     "ordering all corrects",
+			question: models.ItemQuestion{Type: "ordering", Points: 6, Corrects: []int{1, 0, 2}},
+			answer:   models.Answer{Answers: []int{1, 0, 2}},
+			points:   6,
+		},
+		{
+			name:     "ordering with more answers than corrects",
+			question: models.ItemQuestion{Type: "ordering", Points: 6, Corrects: []int{1, 0}},
+			answer:   models.Answer{Answers: []int{1, 0, 2}},
+			points:   6,
+		},
+		{
+			name:     "written is not auto-graded",
+			question: models.ItemQuestion{Type: "written", Points: 10},
+			answer:   models.Answer{Response: "Response..."},
+			points:   0,
+		},
+	}
+
+	w := &WorkSerice{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			points := w.getAnswerPoints(&test.question, &test.answer)
+			if points != test.points {
+				t.Errorf("getAnswerPoints() = %d, want %d", points, test.points)
+			}
+		})
+	}
+}
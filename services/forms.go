@@ -217,6 +217,119 @@ func (f *FormService) GetForm(idForm, idUser string, onlyAuthor bool) ([]models.
 	return form, nil
 }
 
+// Check correct answers of the auto-graded question types
+func (f *FormService) checkQuestion(question *forms.QuestionForm) error {
+	isPermutation := func(indexes []int, length int) bool {
+		if len(indexes) != length {
+			return false
+		}
+		seen := make(map[int]bool)
+		for _, index := range indexes {
+			if index < 0 || index >= length || seen[index] {
+				return false
+			}
+			seen[index] = true
+		}
+		return true
+	}
+
+	switch question.Type {
+	case "true_false":
+		if *question.Correct != 0 && *question.Correct != 1 {
+			return fmt.Errorf("la respuesta correcta de verdadero o falso debe ser 0 o 1")
+		}
+	case "multiple_select":
+		if len(question.Answers) < 2 {
+			return fmt.Errorf("la pregunta de selección múltiple debe tener al menos dos alternativas")
+		}
+		if len(question.Corrects) == 0 {
+			return fmt.Errorf("la pregunta de selección múltiple debe tener al menos una alternativa correcta")
+		}
+		seen := make(map[int]bool)
+		for _, correct := range question.Corrects {
+			if correct < 0 || correct >= len(question.Answers) || seen[correct] {
+				return fmt.Errorf("respuestas correctas de selección múltiple inválidas")
+			}
+			seen[correct] = true
+		}
+	case "matching":
+		if len(question.Answers) == 0 {
+			return fmt.Errorf("la pregunta de términos pareados debe tener al menos un término")
+		}
+		if len(question.Corrects) != len(question.Answers) {
+			return fmt.Errorf("cada término debe tener su opción correcta")
+		}
+		for _, correct := range question.Corrects {
+			if correct < 0 || correct >= len(question.Options) {
+				return fmt.Errorf("opción correcta de términos pareados fuera de rango")
+			}
+		}
+	case "ordering":
+		if len(question.Answers) < 2 {
+			return fmt.Errorf("la pregunta de ordenamiento debe tener al menos dos elementos")
+		}
+		if !isPermutation(question.Corrects, len(question.Answers)) {
+			return fmt.Errorf("el orden correcto debe incluir cada elemento una sola vez")
+		}
+	}
+	return nil
+}
+
+// Set and unset of the question content, points are not included
+func (f *FormService) getQuestionUpdate(questionData *models.ItemQuestion) (bson.M, bson.M) {
+	updateInfo := bson.M{
+		"question": questionData.Question,
+		"type":     questionData.Type,
+		"answers":  questionData.Answers,
+	}
+	unsetInfo := bson.M{}
+	// Set or unset
+	if questionData.Type == "alternatives_correct" || questionData.Type == "true_false" {
+		updateInfo["correct"] = questionData.Correct
+	} else {
+		unsetInfo["correct"] = ""
+	}
+	if questionData.Corrects != nil {
+		updateInfo["corrects"] = questionData.Corrects
+	} else {
+		unsetInfo["corrects"] = ""
+	}
+	if questionData.Options != nil {
+		updateInfo["options"] = questionData.Options
+	} else {
+		unsetInfo["options"] = ""
+	}
+	if questionData.Number != nil {
+		updateInfo["number"] = questionData.Number
+		updateInfo["tolerance"] = questionData.Tolerance
+	} else {
+		unsetInfo["number"] = ""
+		unsetInfo["tolerance"] = ""
+	}
+	if !questionData.Bank.IsZero() {
+		updateInfo["bank"] = questionData.Bank
+	} else {
+		unsetInfo["bank"] = ""
+	}
+	return updateInfo, unsetInfo
+}
+
+// Fill the questions imported from the question bank
+func (f *FormService) importBankQuestions(form *forms.FormForm) *res.ErrorRes {
+	for i := range form.Items {
+		for j := range form.Items[i].Questions {
+			question := &form.Items[i].Questions[j]
+			if question.Bank == "" {
+				continue
+			}
+			if err := questionBankService.importBankQuestion(question); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (f *FormService) UploadForm(form *forms.FormForm, userId string) *res.ErrorRes {
 	userObjId, err := primitive.ObjectIDFromHex(userId)
 	if err != nil {
@@ -225,6 +338,20 @@ func (f *FormService) UploadForm(form *forms.FormForm, userId string) *res.Error
 			StatusCode: http.StatusBadRequest,
 		}
 	}
+	// Check questions
+	if errRes := f.importBankQuestions(form); errRes != nil {
+		return errRes
+	}
+	for _, item := range form.Items {
+		for _, question := range item.Questions {
+			if err := f.checkQuestion(&question); err != nil {
+				return &res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusBadRequest,
+				}
+			}
+		}
+	}
 	// Insert questions
 	var questionsIds [][]primitive.ObjectID
 
@@ -341,6 +468,31 @@ func (f *FormService) UpdateForm(form *forms.FormForm, userId, idForm string) *r
 			}
 		}
 	}
+	// Check questions
+	if errRes := f.importBankQuestions(form); errRes != nil {
+		return errRes
+	}
+	for _, item := range form.Items {
+		for _, question := range item.Questions {
+			if err := f.checkQuestion(&question); err != nil {
+				return &res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusBadRequest,
+				}
+			}
+			if question.Type != "written" {
+				continue
+			}
+			for _, work := range work {
+				if work.Attempts != nil && work.Attempts.Quantity > 1 {
+					return &res.ErrorRes{
+						Err:        fmt.Errorf("este formulario está asignado a un trabajo con múltiples intentos, no admite preguntas de desarrollo"),
+						StatusCode: http.StatusBadRequest,
+					}
+				}
+			}
+		}
+	}
 	// Update form
 	var newItems []models.FormItem
 
@@ -375,6 +527,7 @@ func (f *FormService) UpdateForm(form *forms.FormForm, userId, idForm string) *r
 		}
 		// Insert or update
 		if idObjItem.IsZero() && item.ID == "" {
+			newItem.ID = primitive.NewObjectID()
 			// Insert questions
 			var questions []interface{}
 			for _, question := range item.Questions {
@@ -415,18 +568,8 @@ func (f *FormService) UpdateForm(form *forms.FormForm, userId, idForm string) *r
 				}
 				if idObjQuestion.IsZero() {
 					newId := primitive.NewObjectID()
-					questionData := models.ItemQuestion{
-						ID:       newId,
-						Type:     question.Type,
-						Answers:  question.Answers,
-						Question: question.Question,
-					}
-					if question.Type == "alternatives_correct" {
-						questionData.Correct = *question.Correct
-					}
-					if question.Type != "alternatives" {
-						questionData.Points = individualPoints
-					}
+					questionData := models.NewModelsFormQuestion(&question, &item, individualPoints)
+					questionData.ID = newId
 					_, err := formQuestionModel.NewDocument(questionData)
 					if err != nil {
 						return &res.ErrorRes{
@@ -437,18 +580,8 @@ func (f *FormService) UpdateForm(form *forms.FormForm, userId, idForm string) *r
 
 					questionsIds = append(questionsIds, newId)
 				} else {
-					updateInfo := bson.M{
-						"question": question.Question,
-						"type":     question.Type,
-						"answers":  question.Answers,
-					}
-					unsetInfo := bson.M{}
-					// Set or unset
-					if question.Type == "alternatives_correct" {
-						updateInfo["correct"] = question.Correct
-					} else {
-						unsetInfo["correct"] = ""
-					}
+					questionData := models.NewModelsFormQuestion(&question, &item, individualPoints)
+					updateInfo, unsetInfo := f.getQuestionUpdate(questionData)
 					if question.Type != "alternatives" && form.PointsType == "true" {
 						updateInfo["points"] = individualPoints
 					} else {
@@ -558,6 +691,79 @@ func (f *FormService) DeleteForm(idForm, idUser string) *res.ErrorRes {
 	return nil
 }
 
+// Copy the form and its questions for the author. Returns the new form
+// and the new ID of each item, to remap the references to the items
+func (f *FormService) cloneForm(
+	sessCtx mongo.SessionContext,
+	form *models.Form,
+	author primitive.ObjectID,
+) (*models.Form, map[primitive.ObjectID]primitive.ObjectID, error) {
+	var idQuestions []primitive.ObjectID
+	for _, item := range form.Items {
+		idQuestions = append(idQuestions, item.Questions...)
+	}
+	var questions []models.ItemQuestion
+	cursor, err := formQuestionModel.GetAll(bson.D{{
+		Key: "_id",
+		Value: bson.M{
+			"$in": idQuestions,
+		},
+	}}, &options.FindOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := cursor.All(db.Ctx, &questions); err != nil {
+		return nil, nil, err
+	}
+	// Copy questions
+	copies := make(map[primitive.ObjectID]primitive.ObjectID)
+	var questionsData []interface{}
+	for _, question := range questions {
+		copies[question.ID] = primitive.NewObjectID()
+
+		question.ID = copies[question.ID]
+		questionsData = append(questionsData, question)
+	}
+	if len(questionsData) > 0 {
+		_, err = formQuestionModel.Use().InsertMany(sessCtx, questionsData)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	// Copy form
+	items := make(map[primitive.ObjectID]primitive.ObjectID)
+	now := primitive.NewDateTimeFromTime(time.Now())
+	formData := &models.Form{
+		ID:         primitive.NewObjectID(),
+		Author:     author,
+		Title:      form.Title,
+		HasPoints:  form.HasPoints,
+		UploadDate: now,
+		UpdateDate: now,
+		Status:     true,
+	}
+	for _, item := range form.Items {
+		itemData := models.FormItem{
+			ID:         primitive.NewObjectID(),
+			Title:      item.Title,
+			PointsType: item.PointsType,
+			Questions:  []primitive.ObjectID{},
+		}
+		for _, idQuestion := range item.Questions {
+			if idCopy, ok := copies[idQuestion]; ok {
+				itemData.Questions = append(itemData.Questions, idCopy)
+			}
+		}
+		items[item.ID] = itemData.ID
+		formData.Items = append(formData.Items, itemData)
+	}
+	_, err = formModel.Use().InsertOne(sessCtx, formData)
+	if err != nil {
+		return nil, nil, err
+	}
+	return formData, items, nil
+}
+
 func NewFormService() *FormService {
 	if formService == nil {
 		formService = &FormService{}
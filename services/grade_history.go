@@ -0,0 +1,305 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var gradeHistoryService *GradeHistoryService
+
+type GradeHistoryService struct{}
+
+// Register the change of a grade, changes that keep the same value
+// are not registered
+func (h *GradeHistoryService) registerChange(
+	ctx context.Context,
+	collection string,
+	idObjGrade,
+	idObjModule,
+	idObjStudent primitive.ObjectID,
+	oldGrade *float64,
+	newGrade float64,
+	idObjActor primitive.ObjectID,
+	source,
+	reason string,
+) error {
+	if oldGrade != nil && *oldGrade == newGrade {
+		return nil
+	}
+	modelHistory := models.NewModelGradeHistory(
+		idObjGrade,
+		collection,
+		idObjModule,
+		idObjStudent,
+		oldGrade,
+		newGrade,
+		idObjActor,
+		source,
+		reason,
+	)
+	_, err := gradeHistoryModel.Use().InsertOne(ctx, modelHistory)
+	return err
+}
+
+// Register the grades inserted with InsertMany, insertedIDs must have the
+// same order than grades
+func (h *GradeHistoryService) registerInserted(
+	grades []interface{},
+	insertedIDs []interface{},
+	idObjActor primitive.ObjectID,
+	source string,
+) error {
+	var history []interface{}
+	for i, grade := range grades {
+		idObjGrade := insertedIDs[i].(primitive.ObjectID)
+		switch g := grade.(type) {
+		case models.Grade:
+			history = append(history, models.NewModelGradeHistory(
+				idObjGrade,
+				models.GRADES_COLLECTION,
+				g.Module,
+				g.Student,
+				nil,
+				g.Grade,
+				idObjActor,
+				source,
+				"",
+			))
+		case models.WorkGrade:
+			history = append(history, models.NewModelGradeHistory(
+				idObjGrade,
+				models.WORK_GRADES_COLLECTION,
+				g.Module,
+				g.Student,
+				nil,
+				g.Grade,
+				idObjActor,
+				source,
+				"",
+			))
+		}
+	}
+	if len(history) == 0 {
+		return nil
+	}
+	_, err := gradeHistoryModel.Use().InsertMany(db.Ctx, history)
+	return err
+}
+
+// Update a grade of the grades collection and register the change in
+// a transaction, if there is no grade nothing is done
+func (h *GradeHistoryService) updateGrade(
+	filter bson.D,
+	set bson.M,
+	idObjActor primitive.ObjectID,
+	source,
+	reason string,
+) error {
+	_, err := models.DbConnect.WithTransaction(func(sessCtx mongo.SessionContext) (interface{}, error) {
+		var grade *models.Grade
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.Before)
+		err := gradeModel.Use().FindOneAndUpdate(sessCtx, filter, bson.D{{
+			Key:   "$set",
+			Value: set,
+		}}, opts).Decode(&grade)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return nil, h.registerChange(
+			sessCtx,
+			models.GRADES_COLLECTION,
+			grade.ID,
+			grade.Module,
+			grade.Student,
+			&grade.Grade,
+			set["grade"].(float64),
+			idObjActor,
+			source,
+			reason,
+		)
+	})
+	return err
+}
+
+// Same as updateGrade for the work_grades collection
+func (h *GradeHistoryService) updateWorkGrade(
+	filter bson.D,
+	set bson.M,
+	idObjActor primitive.ObjectID,
+	source string,
+) error {
+	_, err := models.DbConnect.WithTransaction(func(sessCtx mongo.SessionContext) (interface{}, error) {
+		var grade *models.WorkGrade
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.Before)
+		err := workGradeModel.Use().FindOneAndUpdate(sessCtx, filter, bson.D{{
+			Key:   "$set",
+			Value: set,
+		}}, opts).Decode(&grade)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return nil, h.registerChange(
+			sessCtx,
+			models.WORK_GRADES_COLLECTION,
+			grade.ID,
+			grade.Module,
+			grade.Student,
+			&grade.Grade,
+			set["grade"].(float64),
+			idObjActor,
+			source,
+			"",
+		)
+	})
+	return err
+}
+
+func (h *GradeHistoryService) getHistory(filter bson.D) ([]models.GradeHistoryWLookup, error) {
+	var history []models.GradeHistoryWLookup
+
+	match := bson.D{{
+		Key:   "$match",
+		Value: filter,
+	}}
+	sort := bson.D{{
+		Key: "$sort",
+		Value: bson.M{
+			"date": -1,
+		},
+	}}
+	lookup := bson.D{{
+		Key: "$lookup",
+		Value: bson.M{
+			"from":         models.USERS_COLLECTION,
+			"localField":   "actor",
+			"foreignField": "_id",
+			"as":           "actor",
+			"pipeline": bson.A{bson.D{{
+				Key: "$project",
+				Value: bson.M{
+					"_id":            1,
+					"name":           1,
+					"first_lastname": 1,
+				},
+			}}},
+		},
+	}}
+	set := bson.D{{
+		Key: "$set",
+		Value: bson.M{
+			"actor": bson.M{
+				"$first": "$actor",
+			},
+		},
+	}}
+	cursor, err := gradeHistoryModel.Aggreagate(mongo.Pipeline{
+		match,
+		sort,
+		lookup,
+		set,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &history); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+func (h *GradeHistoryService) GetGradeHistory(idModule, idGrade string) ([]models.GradeHistoryWLookup, *res.ErrorRes) {
+	idObjModule, err := primitive.ObjectIDFromHex(idModule)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjGrade, err := primitive.ObjectIDFromHex(idGrade)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	history, err := h.getHistory(bson.D{
+		{
+			Key:   "module",
+			Value: idObjModule,
+		},
+		{
+			Key:   "grade",
+			Value: idObjGrade,
+		},
+	})
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if len(history) == 0 {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("no existe historial para esta calificación"),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	return history, nil
+}
+
+func (h *GradeHistoryService) GetStudentGradeHistory(idModule, idStudent string) ([]models.GradeHistoryWLookup, *res.ErrorRes) {
+	idObjModule, err := primitive.ObjectIDFromHex(idModule)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjStudent, err := primitive.ObjectIDFromHex(idStudent)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	history, err := h.getHistory(bson.D{
+		{
+			Key:   "module",
+			Value: idObjModule,
+		},
+		{
+			Key:   "student",
+			Value: idObjStudent,
+		},
+	})
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return history, nil
+}
+
+func NewGradeHistoryService() *GradeHistoryService {
+	if gradeHistoryService == nil {
+		gradeHistoryService = &GradeHistoryService{}
+	}
+	return gradeHistoryService
+}
@@ -0,0 +1,562 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Subject and course of a template, both are optional
+func getTemplateScope(template *forms.GradeProgramTemplateForm) (subject, course primitive.ObjectID, err error) {
+	if template.Subject != "" {
+		subject, err = primitive.ObjectIDFromHex(template.Subject)
+		if err != nil {
+			return
+		}
+	}
+	if template.Course != "" {
+		course, err = primitive.ObjectIDFromHex(template.Course)
+	}
+	return
+}
+
+func getTemplateScopeFilter(subject, course primitive.ObjectID) bson.D {
+	filter := bson.D{}
+	scope := []bson.E{
+		{Key: "subject", Value: subject},
+		{Key: "course", Value: course},
+	}
+	for _, e := range scope {
+		if e.Value.(primitive.ObjectID).IsZero() {
+			filter = append(filter, bson.E{
+				Key: e.Key,
+				Value: bson.M{
+					"$exists": false,
+				},
+			})
+		} else {
+			filter = append(filter, e)
+		}
+	}
+	return filter
+}
+
+// Template of a module, subject and course is before subject, and
+// subject before course
+func getModuleTemplate(
+	module *models.ModuleWithLookup,
+	templates []models.GradeProgramTemplate,
+) *models.GradeProgramTemplate {
+	var moduleTemplate *models.GradeProgramTemplate
+	specificity := -1
+	for i, template := range templates {
+		if !template.Subject.IsZero() && template.Subject != module.Subject.ID {
+			continue
+		}
+		if !template.Course.IsZero() && template.Course != module.Section.Course.ID {
+			continue
+		}
+		var templateSpecificity int
+		if !template.Subject.IsZero() {
+			templateSpecificity += 2
+		}
+		if !template.Course.IsZero() {
+			templateSpecificity++
+		}
+		if templateSpecificity > specificity {
+			moduleTemplate = &templates[i]
+			specificity = templateSpecificity
+		}
+	}
+	return moduleTemplate
+}
+
+func matchTemplateProgram(program models.TemplateProgram, form *forms.GradeProgramForm) bool {
+	if program.Percentage != form.Percentage || program.IsAcumulative != *form.IsAcumulative {
+		return false
+	}
+	if !program.IsAcumulative {
+		return true
+	}
+	if len(program.Acumulative) != len(form.Acumulative) {
+		return false
+	}
+	for i, acumulative := range program.Acumulative {
+		if acumulative.Number != form.Acumulative[i].Number ||
+			acumulative.Percentage != form.Acumulative[i].Percentage {
+			return false
+		}
+	}
+	return true
+}
+
+// The programs of a module are the programs of the template
+func matchTemplatePrograms(template *models.GradeProgramTemplate, programs []models.GradesProgram) bool {
+	if len(template.Programs) != len(programs) {
+		return false
+	}
+	for _, program := range programs {
+		form := &forms.GradeProgramForm{
+			Number:        program.Number,
+			Percentage:    program.Percentage,
+			IsAcumulative: &program.IsAcumulative,
+		}
+		for _, acumulative := range program.Acumulative {
+			form.Acumulative = append(form.Acumulative, forms.AcumulativeForm{
+				Number:     acumulative.Number,
+				Percentage: acumulative.Percentage,
+			})
+		}
+		var matches bool
+		for _, templateProgram := range template.Programs {
+			if templateProgram.Number == program.Number {
+				matches = matchTemplateProgram(templateProgram, form)
+				break
+			}
+		}
+		if !matches {
+			return false
+		}
+	}
+	return true
+}
+
+func (g *GradesService) validateTemplate(template *forms.GradeProgramTemplateForm) error {
+	var sum float32
+	numbers := make(map[int]bool)
+	for i := range template.Programs {
+		program := &template.Programs[i]
+		if numbers[program.Number] {
+			return fmt.Errorf("la calificación N°%d está repetida", program.Number)
+		}
+		numbers[program.Number] = true
+		if err := g.validateProgram(program, 0); err != nil {
+			return err
+		}
+		sum += program.Percentage
+	}
+	if sum != 100 {
+		return fmt.Errorf(
+			"el porcentaje sumatorio de las calificaciones debe ser exactamente 100 por ciento",
+		)
+	}
+	return nil
+}
+
+func (g *GradesService) getTemplates() ([]models.GradeProgramTemplate, error) {
+	var templates []models.GradeProgramTemplate
+
+	cursor, err := programTemplatesModel.GetAll(bson.D{}, &options.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &templates); err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// A program uploaded by hand must follow the template of the module
+func (g *GradesService) checkModuleTemplate(program *forms.GradeProgramForm, idModule string) *res.ErrorRes {
+	module, errRes := moduleService.GetModule(idModule)
+	if errRes != nil {
+		return errRes
+	}
+	templates, err := g.getTemplates()
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	template := getModuleTemplate(module, templates)
+	if template == nil {
+		return nil
+	}
+	for _, templateProgram := range template.Programs {
+		if templateProgram.Number != program.Number {
+			continue
+		}
+		if !matchTemplateProgram(templateProgram, program) {
+			return &res.ErrorRes{
+				Err: fmt.Errorf(
+					"la calificación no coincide con la plantilla %v del módulo",
+					template.Name,
+				),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		return nil
+	}
+	return &res.ErrorRes{
+		Err: fmt.Errorf(
+			"la plantilla %v del módulo no tiene la calificación N°%d",
+			template.Name,
+			program.Number,
+		),
+		StatusCode: http.StatusBadRequest,
+	}
+}
+
+// The template of a module does not match its programs
+func (g *GradesService) isOutOfTemplate(idModule string) (bool, error) {
+	module, errRes := moduleService.GetModule(idModule)
+	if errRes != nil {
+		return false, errRes.Err
+	}
+	templates, err := g.getTemplates()
+	if err != nil {
+		return false, err
+	}
+	template := getModuleTemplate(module, templates)
+	if template == nil {
+		return false, nil
+	}
+	programs, errRes := g.GetGradePrograms(idModule)
+	if errRes != nil {
+		return false, errRes.Err
+	}
+	return !matchTemplatePrograms(template, programs), nil
+}
+
+func (g *GradesService) checkTemplateScope(
+	template *forms.GradeProgramTemplateForm,
+	idObjTemplate primitive.ObjectID,
+) (subject, course primitive.ObjectID, errRes *res.ErrorRes) {
+	if err := g.validateTemplate(template); err != nil {
+		errRes = &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+		return
+	}
+	subject, course, err := getTemplateScope(template)
+	if err != nil {
+		errRes = &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+		return
+	}
+	// One template by scope
+	var exists *models.GradeProgramTemplate
+	filter := getTemplateScopeFilter(subject, course)
+	filter = append(filter, bson.E{
+		Key: "_id",
+		Value: bson.M{
+			"$ne": idObjTemplate,
+		},
+	})
+	cursor := programTemplatesModel.GetOne(filter)
+	if err := cursor.Decode(&exists); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		errRes = &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+		return
+	}
+	if exists != nil {
+		errRes = &res.ErrorRes{
+			Err:        fmt.Errorf("ya existe una plantilla para esta asignatura y nivel"),
+			StatusCode: http.StatusConflict,
+		}
+	}
+	return
+}
+
+func (g *GradesService) GetProgramTemplates() ([]models.GradeProgramTemplate, *res.ErrorRes) {
+	templates, err := g.getTemplates()
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return templates, nil
+}
+
+func (g *GradesService) UploadProgramTemplate(
+	template *forms.GradeProgramTemplateForm,
+	idUser string,
+) (interface{}, *res.ErrorRes) {
+	idObjUser, err := primitive.ObjectIDFromHex(idUser)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	subject, course, errRes := g.checkTemplateScope(template, primitive.NilObjectID)
+	if errRes != nil {
+		return nil, errRes
+	}
+	// Insert
+	modelTemplate := models.NewModelGradeProgramTemplate(template, subject, course, idObjUser)
+	inserted, err := programTemplatesModel.NewDocument(modelTemplate)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return inserted.InsertedID, nil
+}
+
+func (g *GradesService) UpdateProgramTemplate(
+	template *forms.GradeProgramTemplateForm,
+	idTemplate string,
+) *res.ErrorRes {
+	idObjTemplate, err := primitive.ObjectIDFromHex(idTemplate)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	var oldTemplate *models.GradeProgramTemplate
+	cursor := programTemplatesModel.GetByID(idObjTemplate)
+	if err := cursor.Decode(&oldTemplate); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return &res.ErrorRes{
+				Err:        fmt.Errorf("no existe la plantilla"),
+				StatusCode: http.StatusNotFound,
+			}
+		}
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	subject, course, errRes := g.checkTemplateScope(template, idObjTemplate)
+	if errRes != nil {
+		return errRes
+	}
+	// Replace
+	modelTemplate := models.NewModelGradeProgramTemplate(template, subject, course, oldTemplate.Author)
+	modelTemplate.ID = oldTemplate.ID
+	modelTemplate.Date = oldTemplate.Date
+	modelTemplate.UpdateDate = primitive.NewDateTimeFromTime(time.Now())
+
+	_, err = programTemplatesModel.Use().ReplaceOne(db.Ctx, bson.D{{
+		Key:   "_id",
+		Value: idObjTemplate,
+	}}, modelTemplate)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return nil
+}
+
+func (g *GradesService) DeleteProgramTemplate(idTemplate string) *res.ErrorRes {
+	idObjTemplate, err := primitive.ObjectIDFromHex(idTemplate)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	result, err := programTemplatesModel.Use().DeleteOne(db.Ctx, bson.D{{
+		Key:   "_id",
+		Value: idObjTemplate,
+	}})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if result.DeletedCount == 0 {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("no existe la plantilla"),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	return nil
+}
+
+// Open modules of the semester
+func (g *GradesService) getSemesterModules() ([]models.ModuleWithLookup, error) {
+	var modules []models.ModuleWithLookup
+
+	cursor, err := moduleModel.Aggreagate(mongo.Pipeline{
+		bson.D{{
+			Key: "$match",
+			Value: bson.M{
+				"status": false,
+			},
+		}},
+		getAddFields(),
+		getLookupSection(),
+		getLookupSubject(),
+		getLookupSemester(),
+		getProject(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &modules); err != nil {
+		return nil, err
+	}
+	return modules, nil
+}
+
+// Apply the templates to all the modules of the semester in a
+// transaction. A module with programs keeps them, unless replace and
+// the module has no grades nor works graded in its programs
+func (g *GradesService) ApplyProgramTemplates(
+	apply *forms.ApplyProgramTemplatesForm,
+) (*ApplyTemplatesRes, *res.ErrorRes) {
+	templates, err := g.getTemplates()
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if len(templates) == 0 {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("no hay plantillas de calificaciones"),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	modules, err := g.getSemesterModules()
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	idObjModules := make([]primitive.ObjectID, len(modules))
+	for i, module := range modules {
+		idObjModules[i] = module.ID
+	}
+	inModules := bson.D{{
+		Key: "module",
+		Value: bson.M{
+			"$in": idObjModules,
+		},
+	}}
+	// Modules with programs and with grades
+	withPrograms, err := gradeProgramModel.Use().Distinct(db.Ctx, "module", inModules)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	withGrades, err := gradeModel.Use().Distinct(db.Ctx, "module", inModules)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	withWorks, err := workModel.Use().Distinct(db.Ctx, "module", append(inModules, bson.E{
+		Key: "grade",
+		Value: bson.M{
+			"$exists": true,
+		},
+	}))
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	contains := func(ids []interface{}, idObjModule primitive.ObjectID) bool {
+		for _, id := range ids {
+			if id == idObjModule {
+				return true
+			}
+		}
+		return false
+	}
+
+	applied := &ApplyTemplatesRes{
+		Applied: []AppliedTemplate{},
+		Skipped: []SkippedTemplate{},
+	}
+	var replaced []primitive.ObjectID
+	var programs []interface{}
+	for i := range modules {
+		module := &modules[i]
+		template := getModuleTemplate(module, templates)
+		if template == nil {
+			applied.Skipped = append(applied.Skipped, SkippedTemplate{
+				Module: module.ID.Hex(),
+				Reason: "el módulo no tiene plantilla",
+			})
+			continue
+		}
+		if contains(withPrograms, module.ID) {
+			if !apply.Replace {
+				applied.Skipped = append(applied.Skipped, SkippedTemplate{
+					Module: module.ID.Hex(),
+					Reason: "el módulo ya tiene calificaciones programadas",
+				})
+				continue
+			}
+			if contains(withGrades, module.ID) {
+				applied.Skipped = append(applied.Skipped, SkippedTemplate{
+					Module: module.ID.Hex(),
+					Reason: "el módulo ya tiene calificaciones registradas",
+				})
+				continue
+			}
+			if contains(withWorks, module.ID) {
+				applied.Skipped = append(applied.Skipped, SkippedTemplate{
+					Module: module.ID.Hex(),
+					Reason: "el módulo tiene trabajos asignados a sus calificaciones",
+				})
+				continue
+			}
+			replaced = append(replaced, module.ID)
+		}
+		for _, program := range template.Programs {
+			programs = append(programs, models.NewModelGradesProgramFromTemplate(program, module.ID))
+		}
+		applied.Applied = append(applied.Applied, AppliedTemplate{
+			Module:   module.ID.Hex(),
+			Template: template.ID.Hex(),
+			Programs: len(template.Programs),
+		})
+	}
+	if len(programs) == 0 {
+		return applied, nil
+	}
+	_, err = models.DbConnect.WithTransaction(func(sessCtx mongo.SessionContext) (interface{}, error) {
+		if len(replaced) > 0 {
+			_, err := gradeProgramModel.Use().DeleteMany(sessCtx, bson.D{{
+				Key: "module",
+				Value: bson.M{
+					"$in": replaced,
+				},
+			}})
+			if err != nil {
+				return nil, err
+			}
+		}
+		_, err := gradeProgramModel.Use().InsertMany(sessCtx, programs)
+		return nil, err
+	})
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return applied, nil
+}
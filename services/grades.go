@@ -163,6 +163,7 @@ func (g *GradesService) orderInSliceGradesStudent(
 						Grade:         grade.Grade,
 						IsAcumulative: false,
 						Evaluator:     grade.Evaluator,
+						Draft:         !isGradeReleased(grade.Draft, grade.ReleaseDate),
 						Date:          grade.Date.Time(),
 					}
 				} else if program.IsAcumulative && orderedGrades[i] == nil {
@@ -177,6 +178,7 @@ func (g *GradesService) orderInSliceGradesStudent(
 						ID:        grade.ID.Hex(),
 						Grade:     grade.Grade,
 						Evaluator: &grade.Evaluator,
+						Draft:     !isGradeReleased(grade.Draft, grade.ReleaseDate),
 						Date:      grade.Date.Time(),
 					}
 					// Add to grades
@@ -199,6 +201,7 @@ func (g *GradesService) orderInSliceGradesStudent(
 						Grade:     grade.Grade,
 						Date:      grade.Date.Time(),
 						Evaluator: &grade.Evaluator,
+						Draft:     !isGradeReleased(grade.Draft, grade.ReleaseDate),
 					}
 				}
 			}
@@ -255,6 +258,13 @@ func (g *GradesService) GetStudentsGrades(
 			}
 		}
 	}
+	// Scheduled releases already due
+	if err := g.releaseDueModuleGrades(idObjModule); err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	// Get grades programs
 	programs, errRes := g.GetGradePrograms(idModule)
 	if errRes != nil {
@@ -291,12 +301,18 @@ func (g *GradesService) GetStudentsGrades(
 			// Get grades
 			var grades []models.GradeWLookup
 
+			filter := bson.M{
+				"module":  idObjModule,
+				"student": idObjStudent,
+			}
+			if isParent {
+				for key, value := range releasedFilter() {
+					filter[key] = value
+				}
+			}
 			match := bson.D{{
-				Key: "$match",
-				Value: bson.M{
-					"module":  idObjModule,
-					"student": idObjStudent,
-				},
+				Key:   "$match",
+				Value: filter,
 			}}
 			lookup := bson.D{{
 				Key: "$lookup",
@@ -359,7 +375,11 @@ func (g *GradesService) GetStudentsGrades(
 	return studentsGrades, nil
 }
 
-func (g *GradesService) GetStudentGrades(idModule, idStudent string) ([]*OrderedGrade, *res.ErrorRes) {
+func (g *GradesService) GetStudentGrades(
+	idModule,
+	idStudent string,
+	onlyReleased bool,
+) ([]*OrderedGrade, *res.ErrorRes) {
 	idObjModule, err := primitive.ObjectIDFromHex(idModule)
 	if err != nil {
 		return nil, &res.ErrorRes{
@@ -374,6 +394,13 @@ func (g *GradesService) GetStudentGrades(idModule, idStudent string) ([]*Ordered
 			StatusCode: http.StatusBadRequest,
 		}
 	}
+	// Scheduled releases already due
+	if err := g.releaseDueModuleGrades(idObjModule); err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	// Get grades programs
 	programs, errRes := g.GetGradePrograms(idModule)
 	if errRes != nil {
@@ -382,12 +409,18 @@ func (g *GradesService) GetStudentGrades(idModule, idStudent string) ([]*Ordered
 	// Get grades
 	var grades []models.GradeWLookup
 
+	filter := bson.M{
+		"module":  idObjModule,
+		"student": idObjStudent,
+	}
+	if onlyReleased {
+		for key, value := range releasedFilter() {
+			filter[key] = value
+		}
+	}
 	match := bson.D{{
-		Key: "$match",
-		Value: bson.M{
-			"module":  idObjModule,
-			"student": idObjStudent,
-		},
+		Key:   "$match",
+		Value: filter,
 	}}
 	lookup := bson.D{{
 		Key: "$lookup",
@@ -445,6 +478,32 @@ func (g *GradesService) getProgramGradeById(idObjProgram primitive.ObjectID) (*m
 	return program, nil
 }
 
+// Validate the percentages of a program, used is the percentage of
+// the other programs of the module
+func (g *GradesService) validateProgram(program *forms.GradeProgramForm, used float32) error {
+	if used > 0 && used+program.Percentage > 100 {
+		return fmt.Errorf(
+			"el porcentaje indicado superado el 100 por ciento. Queda %v por ciento libre",
+			100-used,
+		)
+	} else if program.Percentage > 100 {
+		return fmt.Errorf("el porcentaje indicado superado el 100 por ciento")
+	}
+	// Validate acumulative
+	if *program.IsAcumulative {
+		var sum float32
+		for _, acumulative := range program.Acumulative {
+			sum += acumulative.Percentage
+		}
+		if sum != 100 {
+			return fmt.Errorf(
+				"el porcentaje sumatorio de las calificaciones acumulativas debe ser exactamente 100 por cierto",
+			)
+		}
+	}
+	return nil
+}
+
 func (g *GradesService) UploadProgram(program *forms.GradeProgramForm, idModule string) (interface{}, *res.ErrorRes) {
 	idObjModule, err := primitive.ObjectIDFromHex(idModule)
 	if err != nil {
@@ -519,34 +578,19 @@ func (g *GradesService) UploadProgram(program *forms.GradeProgramForm, idModule
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
-	if len(percentage) > 0 && percentage[0].Total+program.Percentage > 100 {
-		return nil, &res.ErrorRes{
-			Err: fmt.Errorf(
-				"el porcentaje indicado superado el 100 por ciento. Queda %v por ciento libre",
-				100-percentage[0].Total,
-			),
-			StatusCode: http.StatusBadRequest,
-		}
-	} else if program.Percentage > 100 {
+	var used float32
+	if len(percentage) > 0 {
+		used = percentage[0].Total
+	}
+	if err := g.validateProgram(program, used); err != nil {
 		return nil, &res.ErrorRes{
-			Err:        fmt.Errorf("el porcentaje indicado superado el 100 por ciento"),
+			Err:        err,
 			StatusCode: http.StatusBadRequest,
 		}
 	}
-	// Validate acumulative
-	if *program.IsAcumulative {
-		var sum float32
-		for _, acumulative := range program.Acumulative {
-			sum += acumulative.Percentage
-		}
-		if sum != 100 {
-			return nil, &res.ErrorRes{
-				Err: fmt.Errorf(
-					"el porcentaje sumatorio de las calificaciones acumulativas debe ser exactamente 100 por cierto",
-				),
-				StatusCode: http.StatusBadRequest,
-			}
-		}
+	// Template of the module
+	if errRes := g.checkModuleTemplate(program, idModule); errRes != nil {
+		return nil, errRes
 	}
 	// Insert
 	model := models.NewModelGradesProgram(program, idObjModule)
@@ -603,16 +647,16 @@ func (g *GradesService) UploadGrade(
 		}
 	}
 	// Evaluate grade
-	min, max, err := GetMinNMaxGrade()
+	scale, err := getGradingScale(module.ID)
 	if err != nil {
 		return nil, &res.ErrorRes{
 			Err:        err,
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
-	if *grade.Grade < float64(min) || *grade.Grade > float64(max) {
+	if *grade.Grade < scale.Min() || *grade.Grade > scale.Max() {
 		return nil, &res.ErrorRes{
-			Err:        fmt.Errorf("calificación inválida. Mín: %v. Máx: %v", min, max),
+			Err:        fmt.Errorf("calificación inválida. Mín: %v. Máx: %v", scale.Min(), scale.Max()),
 			StatusCode: http.StatusBadRequest,
 		}
 	}
@@ -693,6 +737,7 @@ func (g *GradesService) UploadGrade(
 		*grade.Grade,
 		program.IsAcumulative,
 	)
+	modelGrade.Draft = grade.Draft
 	inserted, err := gradeModel.NewDocument(modelGrade)
 	if err != nil {
 		return nil, &res.ErrorRes{
@@ -700,6 +745,27 @@ func (g *GradesService) UploadGrade(
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
+	err = gradeHistoryService.registerChange(
+		db.Ctx,
+		models.GRADES_COLLECTION,
+		inserted.InsertedID.(primitive.ObjectID),
+		idObjModule,
+		idObjStudent,
+		nil,
+		*grade.Grade,
+		idObjUser,
+		models.GRADE_SOURCE_MANUAL,
+		"",
+	)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if grade.Draft {
+		return inserted.InsertedID, nil
+	}
 	// Send notifications
 	nats.PublishEncode("notify/classroom", res.NotifyClassroom{
 		Title: fmt.Sprintf("Calificación N%d° subida", program.Number),
@@ -813,7 +879,12 @@ func (g *GradesService) DeleteGradeProgram(idModule, idProgram string) *res.Erro
 	return nil
 }
 
-func (g *GradesService) UpdateGrade(grade *forms.UpdateGradeForm, idModule, idGrade string) *res.ErrorRes {
+func (g *GradesService) UpdateGrade(
+	grade *forms.UpdateGradeForm,
+	idModule,
+	idGrade,
+	idUser string,
+) *res.ErrorRes {
 	idObjModule, err := primitive.ObjectIDFromHex(idModule)
 	if err != nil {
 		return &res.ErrorRes{
@@ -821,6 +892,13 @@ func (g *GradesService) UpdateGrade(grade *forms.UpdateGradeForm, idModule, idGr
 			StatusCode: http.StatusBadRequest,
 		}
 	}
+	idObjUser, err := primitive.ObjectIDFromHex(idUser)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
 	idObjGrade, err := primitive.ObjectIDFromHex(idGrade)
 	if err != nil {
 		return &res.ErrorRes{
@@ -867,32 +945,41 @@ func (g *GradesService) UpdateGrade(grade *forms.UpdateGradeForm, idModule, idGr
 		}
 	}
 	// Min max
-	min, max, err := GetMinNMaxGrade()
+	scale, err := getGradingScale(module.ID)
 	if err != nil {
 		return &res.ErrorRes{
 			Err:        err,
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
-	if float64(min) > *grade.Grade || float64(max) < *grade.Grade {
+	if scale.Min() > *grade.Grade || scale.Max() < *grade.Grade {
 		return &res.ErrorRes{
-			Err:        fmt.Errorf("calificación inválida. Mín: %v. Máx: %v", min, max),
+			Err:        fmt.Errorf("calificación inválida. Mín: %v. Máx: %v", scale.Min(), scale.Max()),
 			StatusCode: http.StatusBadRequest,
 		}
 	}
 	// Update
-	_, err = gradeModel.Use().UpdateByID(db.Ctx, idObjGrade, bson.D{{
-		Key: "$set",
-		Value: bson.M{
+	err = gradeHistoryService.updateGrade(
+		bson.D{{
+			Key:   "_id",
+			Value: idObjGrade,
+		}},
+		bson.M{
 			"grade": *grade.Grade,
 		},
-	}})
+		idObjUser,
+		models.GRADE_SOURCE_MANUAL,
+		grade.Reason,
+	)
 	if err != nil {
 		return &res.ErrorRes{
 			Err:        err,
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
+	if !isGradeReleased(gradeData.Draft, gradeData.ReleaseDate) {
+		return nil
+	}
 	// Send notifications
 	nats.PublishEncode("notify/classroom", res.NotifyClassroom{
 		Title: fmt.Sprintf("Calificación N%d° actualizada", gradeProgram.Number),
@@ -0,0 +1,251 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const MAX_HISTOGRAM_BINS = 10
+
+// Bins of one grade for small scales (1 - 7), else ten bins
+func getHistogram(grades []float64, scale GradingScale) []HistogramBin {
+	min, max := scale.Min(), scale.Max()
+	count := int(math.Ceil(max - min))
+	if count > MAX_HISTOGRAM_BINS {
+		count = MAX_HISTOGRAM_BINS
+	}
+	if count < 1 {
+		count = 1
+	}
+	width := (max - min) / float64(count)
+
+	histogram := make([]HistogramBin, count)
+	for i := range histogram {
+		histogram[i] = HistogramBin{
+			From: min + width*float64(i),
+			To:   min + width*float64(i+1),
+		}
+	}
+	for _, grade := range grades {
+		i := count - 1
+		if width > 0 {
+			i = int((grade - min) / width)
+		}
+		// The max grade is in the last bin
+		if i >= count {
+			i = count - 1
+		}
+		if i < 0 {
+			i = 0
+		}
+		histogram[i].Count++
+	}
+	return histogram
+}
+
+func getGradesStats(grades []float64, scale GradingScale) GradesStats {
+	stats := GradesStats{
+		Count:     len(grades),
+		Histogram: getHistogram(grades, scale),
+	}
+	if len(grades) == 0 {
+		return stats
+	}
+	sorted := make([]float64, len(grades))
+	copy(sorted, grades)
+	sort.Float64s(sorted)
+
+	var sum float64
+	for _, grade := range sorted {
+		sum += grade
+		if grade >= scale.PassGrade() {
+			stats.Passed++
+		} else {
+			stats.Failed++
+		}
+	}
+	mean := sum / float64(len(sorted))
+	var variance float64
+	for _, grade := range sorted {
+		variance += math.Pow(grade-mean, 2)
+	}
+	stdDev := math.Sqrt(variance / float64(len(sorted)))
+
+	middle := len(sorted) / 2
+	median := sorted[middle]
+	if len(sorted)%2 == 0 {
+		median = (sorted[middle-1] + sorted[middle]) / 2
+	}
+	passRate := float64(stats.Passed) / float64(len(sorted)) * 100
+
+	stats.Mean = &mean
+	stats.Median = &median
+	stats.StdDev = &stdDev
+	stats.Min = &sorted[0]
+	stats.Max = &sorted[len(sorted)-1]
+	stats.PassRate = &passRate
+	return stats
+}
+
+// Weighted average over the programs already graded, a missing grade
+// does not lower the average
+func getPartialAverage(grades []*OrderedGrade, programs []models.GradesProgram) (*float64, int) {
+	var sum, percentages float64
+	var missing int
+	for i, program := range programs {
+		if i >= len(grades) || grades[i] == nil {
+			missing++
+			continue
+		}
+		sum += grades[i].Grade * float64(program.Percentage)
+		percentages += float64(program.Percentage)
+	}
+	if percentages == 0 {
+		return nil, missing
+	}
+	average := sum / percentages
+	return &average, missing
+}
+
+func getSectionName(module *models.ModuleWithLookup) string {
+	return fmt.Sprintf("%v %v", module.Section.Course.Course, module.Section.Section)
+}
+
+// Modules of the same subject in the semester of the module
+func (g *GradesService) getSubjectModules(module *models.ModuleWithLookup) ([]models.ModuleWithLookup, error) {
+	var modules []models.ModuleWithLookup
+
+	cursor, err := moduleModel.Aggreagate(mongo.Pipeline{
+		getAddFields(),
+		bson.D{{
+			Key: "$match",
+			Value: bson.M{
+				"subject":  module.Subject.ID,
+				"semester": module.Semester.ID,
+			},
+		}},
+		getLookupSection(),
+		getLookupSubject(),
+		getLookupSemester(),
+		getProject(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &modules); err != nil {
+		return nil, err
+	}
+	return modules, nil
+}
+
+func (g *GradesService) getModuleAnalytics(module *models.ModuleWithLookup) (*ModuleAnalytics, *res.ErrorRes) {
+	scale, err := getGradingScale(module.ID)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	programs, errRes := g.GetGradePrograms(module.ID.Hex())
+	if errRes != nil {
+		return nil, errRes
+	}
+	studentsGrades, errRes := g.GetStudentsGrades(module.ID.Hex(), false, nil)
+	if errRes != nil {
+		return nil, errRes
+	}
+	analytics := &ModuleAnalytics{
+		Module:    module.ID.Hex(),
+		Section:   getSectionName(module),
+		PassGrade: scale.PassGrade(),
+		Students:  len(studentsGrades),
+		AtRisk:    []AtRiskStudent{},
+	}
+	// Programs
+	for i, program := range programs {
+		var grades []float64
+		for _, studentGrades := range studentsGrades {
+			if i < len(studentGrades.Grades) && studentGrades.Grades[i] != nil {
+				grades = append(grades, studentGrades.Grades[i].Grade)
+			}
+		}
+		analytics.Programs = append(analytics.Programs, ProgramAnalytics{
+			ID:            program.ID.Hex(),
+			Number:        program.Number,
+			Percentage:    program.Percentage,
+			IsAcumulative: program.IsAcumulative,
+			Stats:         getGradesStats(grades, scale),
+		})
+	}
+	// Averages
+	var averages []float64
+	for _, studentGrades := range studentsGrades {
+		average, missing := getPartialAverage(studentGrades.Grades, programs)
+		if average == nil {
+			continue
+		}
+		averages = append(averages, *average)
+		if *average < scale.PassGrade() {
+			analytics.AtRisk = append(analytics.AtRisk, AtRiskStudent{
+				Student: studentGrades.Student,
+				Average: *average,
+				Missing: missing,
+			})
+		}
+	}
+	sort.Slice(analytics.AtRisk, func(i, j int) bool {
+		return analytics.AtRisk[i].Average < analytics.AtRisk[j].Average
+	})
+	analytics.Averages = getGradesStats(averages, scale)
+	return analytics, nil
+}
+
+func (g *GradesService) GetGradesAnalytics(idModule string) (*GradesAnalyticsRes, *res.ErrorRes) {
+	module, errRes := moduleService.GetModule(idModule)
+	if errRes != nil {
+		return nil, errRes
+	}
+	analytics, errRes := g.getModuleAnalytics(module)
+	if errRes != nil {
+		return nil, errRes
+	}
+	// Compare with the other sections of the subject
+	modules, err := g.getSubjectModules(module)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	var sections []SectionAnalytics
+	for i := range modules {
+		sectionAnalytics := analytics
+		current := modules[i].ID == module.ID
+		if !current {
+			sectionAnalytics, errRes = g.getModuleAnalytics(&modules[i])
+			if errRes != nil {
+				return nil, errRes
+			}
+		}
+		sections = append(sections, SectionAnalytics{
+			Module:   sectionAnalytics.Module,
+			Section:  sectionAnalytics.Section,
+			Students: sectionAnalytics.Students,
+			Averages: sectionAnalytics.Averages,
+			AtRisk:   len(sectionAnalytics.AtRisk),
+			Current:  current,
+		})
+	}
+	return &GradesAnalyticsRes{
+		Module:   *analytics,
+		Sections: sections,
+	}, nil
+}
@@ -0,0 +1,580 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"github.com/xuri/excelize/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Export formats of the grades
+const (
+	EXPORT_XLSX = "xlsx"
+	EXPORT_ODS  = "ods"
+	EXPORT_CSV  = "csv"
+	EXPORT_JSON = "json"
+)
+
+var ExportContentTypes = map[string]string{
+	EXPORT_XLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	EXPORT_ODS:  "application/vnd.oasis.opendocument.spreadsheet",
+	EXPORT_CSV:  "text/csv",
+	EXPORT_JSON: "application/json",
+}
+
+const (
+	EXPORT_GRADES_SHEET  = "Calificaciones"
+	EXPORT_SUMMARY_SHEET = "Resumen"
+	// Columns before the grades
+	EXPORT_STUDENT_COLUMNS = 2
+)
+
+// Column of the export, a program without acumulative grades has one
+// column. An acumulative program has a column per acumulative grade
+// followed by the column of its weighted grade
+type exportColumn struct {
+	title       string
+	program     int
+	acumulative int
+	average     bool
+}
+
+func (c exportColumn) isAcumulative() bool {
+	return c.acumulative >= 0
+}
+
+func (g *GradesService) getExportColumns(programs []models.GradesProgram) []exportColumn {
+	var columns []exportColumn
+	for i, program := range programs {
+		for j, acumulative := range program.Acumulative {
+			columns = append(columns, exportColumn{
+				title: fmt.Sprintf(
+					"%v.%v (%v%%)",
+					program.Number,
+					acumulative.Number,
+					acumulative.Percentage,
+				),
+				program:     i,
+				acumulative: j,
+			})
+		}
+		columns = append(columns, exportColumn{
+			title:       fmt.Sprintf("%v (%v%%)", program.Number, program.Percentage),
+			program:     i,
+			acumulative: -1,
+		})
+	}
+	columns = append(columns, exportColumn{
+		title:       "Promedio",
+		program:     -1,
+		acumulative: -1,
+		average:     true,
+	})
+	return columns
+}
+
+// Weighted average of the grades, a grade without value counts as zero
+func (g *GradesService) getWeightedAverage(
+	grades []*OrderedGrade,
+	programs []models.GradesProgram,
+) *float64 {
+	var average float64
+	var hasGrades bool
+	for i, grade := range grades {
+		if grade != nil {
+			average += grade.Grade * float64(programs[i].Percentage) / 100
+			hasGrades = true
+		}
+	}
+	if !hasGrades {
+		return nil
+	}
+	return &average
+}
+
+func (g *GradesService) getExportValue(
+	column exportColumn,
+	grades []*OrderedGrade,
+	programs []models.GradesProgram,
+) *float64 {
+	if column.average {
+		return g.getWeightedAverage(grades, programs)
+	}
+	grade := grades[column.program]
+	if grade == nil {
+		return nil
+	}
+	if column.isAcumulative() {
+		if column.acumulative >= len(grade.Acumulative) || grade.Acumulative[column.acumulative] == nil {
+			return nil
+		}
+		return &grade.Acumulative[column.acumulative].Grade
+	}
+	return &grade.Grade
+}
+
+func cellName(column, row int) string {
+	name, _ := excelize.CoordinatesToCellName(column, row)
+	return name
+}
+
+// Weighted sum of the cells with N(), so empty cells count as zero like
+// in the average of the grades. Without values the cell is empty
+func weightedFormula(cells []string, percentages []float32) string {
+	var terms []string
+	for i, cell := range cells {
+		terms = append(terms, fmt.Sprintf("N(%s)*%v", cell, percentages[i]))
+	}
+	return fmt.Sprintf(
+		"IF(COUNT(%s)=0,\"\",(%s)/100)",
+		strings.Join(cells, ","),
+		strings.Join(terms, "+"),
+	)
+}
+
+func (g *GradesService) writeGradesSheet(
+	file *excelize.File,
+	columns []exportColumn,
+	students []StudentGrade,
+	programs []models.GradesProgram,
+) error {
+	style, err := file.NewStyle(&excelize.Style{
+		NumFmt: 2,
+	})
+	if err != nil {
+		return err
+	}
+	file.SetCellValue(EXPORT_GRADES_SHEET, "A1", "RUT")
+	file.SetCellValue(EXPORT_GRADES_SHEET, "B1", "Estudiante")
+	for i, column := range columns {
+		file.SetCellValue(EXPORT_GRADES_SHEET, cellName(i+EXPORT_STUDENT_COLUMNS+1, 1), column.title)
+	}
+	for i, student := range students {
+		row := i + 2
+		file.SetCellValue(EXPORT_GRADES_SHEET, cellName(1, row), student.Student.Rut)
+		file.SetCellValue(EXPORT_GRADES_SHEET, cellName(2, row), fmt.Sprintf(
+			"%v %v",
+			student.Student.Name,
+			student.Student.FirstLastname,
+		))
+		// Cells of the grade of each program
+		programCells := make([]string, len(programs))
+		var acumulativeCells []string
+		for j, column := range columns {
+			cell := cellName(j+EXPORT_STUDENT_COLUMNS+1, row)
+			if column.isAcumulative() {
+				acumulativeCells = append(acumulativeCells, cell)
+				if value := g.getExportValue(column, student.Grades, programs); value != nil {
+					file.SetCellValue(EXPORT_GRADES_SHEET, cell, *value)
+				}
+				continue
+			}
+			if column.average {
+				var percentages []float32
+				for _, program := range programs {
+					percentages = append(percentages, program.Percentage)
+				}
+				file.SetCellFormula(EXPORT_GRADES_SHEET, cell, weightedFormula(programCells, percentages))
+				continue
+			}
+			program := programs[column.program]
+			programCells[column.program] = cell
+			if program.IsAcumulative {
+				var percentages []float32
+				for _, acumulative := range program.Acumulative {
+					percentages = append(percentages, acumulative.Percentage)
+				}
+				file.SetCellFormula(EXPORT_GRADES_SHEET, cell, weightedFormula(acumulativeCells, percentages))
+				acumulativeCells = nil
+				continue
+			}
+			if value := g.getExportValue(column, student.Grades, programs); value != nil {
+				file.SetCellValue(EXPORT_GRADES_SHEET, cell, *value)
+			}
+		}
+	}
+	lastCell := cellName(len(columns)+EXPORT_STUDENT_COLUMNS, len(students)+1)
+	return file.SetCellStyle(EXPORT_GRADES_SHEET, cellName(EXPORT_STUDENT_COLUMNS+1, 2), lastCell, style)
+}
+
+// Statistics of each column of the grades sheet, as formulas
+// to be updated with the grades
+func (g *GradesService) writeSummarySheet(
+	file *excelize.File,
+	columns []exportColumn,
+	nStudents int,
+) error {
+	if _, err := file.NewSheet(EXPORT_SUMMARY_SHEET); err != nil {
+		return err
+	}
+	style, err := file.NewStyle(&excelize.Style{
+		NumFmt: 2,
+	})
+	if err != nil {
+		return err
+	}
+	titles := []string{
+		"Calificación",
+		"Calificados",
+		"Promedio",
+		"Mínimo",
+		"Máximo",
+		"Desviación estándar",
+	}
+	for i, title := range titles {
+		file.SetCellValue(EXPORT_SUMMARY_SHEET, cellName(i+1, 1), title)
+	}
+	for i, column := range columns {
+		row := i + 2
+		columnName, _ := excelize.ColumnNumberToName(i + EXPORT_STUDENT_COLUMNS + 1)
+		cells := fmt.Sprintf(
+			"%s!%s2:%s%d",
+			EXPORT_GRADES_SHEET,
+			columnName,
+			columnName,
+			nStudents+1,
+		)
+		file.SetCellValue(EXPORT_SUMMARY_SHEET, cellName(1, row), column.title)
+		file.SetCellFormula(EXPORT_SUMMARY_SHEET, cellName(2, row), fmt.Sprintf("COUNT(%s)", cells))
+		file.SetCellFormula(EXPORT_SUMMARY_SHEET, cellName(3, row), fmt.Sprintf("IFERROR(AVERAGE(%s),\"\")", cells))
+		file.SetCellFormula(EXPORT_SUMMARY_SHEET, cellName(4, row), fmt.Sprintf("IF(COUNT(%s)=0,\"\",MIN(%s))", cells, cells))
+		file.SetCellFormula(EXPORT_SUMMARY_SHEET, cellName(5, row), fmt.Sprintf("IF(COUNT(%s)=0,\"\",MAX(%s))", cells, cells))
+		file.SetCellFormula(EXPORT_SUMMARY_SHEET, cellName(6, row), fmt.Sprintf("IFERROR(STDEV(%s),\"\")", cells))
+	}
+	return file.SetCellStyle(EXPORT_SUMMARY_SHEET, "C2", cellName(len(titles), len(columns)+1), style)
+}
+
+func (g *GradesService) newExportFile(
+	columns []exportColumn,
+	students []StudentGrade,
+	programs []models.GradesProgram,
+) (*excelize.File, error) {
+	file := excelize.NewFile()
+
+	file.SetSheetName("Sheet1", EXPORT_GRADES_SHEET)
+	if err := g.writeGradesSheet(file, columns, students, programs); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if err := g.writeSummarySheet(file, columns, len(students)); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return file, nil
+}
+
+func (g *GradesService) exportXlsx(
+	w io.Writer,
+	columns []exportColumn,
+	students []StudentGrade,
+	programs []models.GradesProgram,
+) error {
+	file, err := g.newExportFile(columns, students, programs)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return file.Write(w)
+}
+
+const odsManifest = `<?xml version="1.0" encoding="UTF-8"?>
+<manifest:manifest xmlns:manifest="urn:oasis:names:tc:opendocument:xmlns:manifest:1.0" manifest:version="1.2">
+<manifest:file-entry manifest:full-path="/" manifest:version="1.2" manifest:media-type="application/vnd.oasis.opendocument.spreadsheet"/>
+<manifest:file-entry manifest:full-path="content.xml" manifest:media-type="text/xml"/>
+</manifest:manifest>`
+
+const odsContentHeader = `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" xmlns:style="urn:oasis:names:tc:opendocument:xmlns:style:1.0" xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0" xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0" xmlns:number="urn:oasis:names:tc:opendocument:xmlns:datastyle:1.0" xmlns:of="urn:oasis:names:tc:opendocument:xmlns:of:1.2" office:version="1.2">
+<office:automatic-styles>
+<number:number-style style:name="N2"><number:number number:decimal-places="2" number:min-integer-digits="1"/></number:number-style>
+<style:style style:name="ce1" style:family="table-cell" style:data-style-name="N2"/>
+</office:automatic-styles>
+<office:body>
+<office:spreadsheet>
+`
+
+const odsContentFooter = `</office:spreadsheet>
+</office:body>
+</office:document-content>`
+
+// Cell references of the formulas of the xlsx, as Calificaciones!C2:C10 or C2
+var odsReference = regexp.MustCompile(`\b(?:(\w+)!)?([A-Z]+[0-9]+)(?::([A-Z]+[0-9]+))?\b`)
+
+// OpenFormula syntax of a formula of the xlsx, references go between
+// brackets and the arguments are separated by semicolons
+func odsFormula(formula string) string {
+	formula = odsReference.ReplaceAllStringFunc(formula, func(reference string) string {
+		match := odsReference.FindStringSubmatch(reference)
+		odsReference := "[." + match[2]
+		if match[1] != "" {
+			odsReference = fmt.Sprintf("[$%s.%s", match[1], match[2])
+		}
+		if match[3] != "" {
+			odsReference += ":." + match[3]
+		}
+		return odsReference + "]"
+	})
+	return "of:=" + strings.ReplaceAll(formula, ",", ";")
+}
+
+func escapeXml(value string) string {
+	var escaped bytes.Buffer
+	xml.EscapeText(&escaped, []byte(value))
+	return escaped.String()
+}
+
+// Cell of the xlsx as a cell of the ods. The formulas keep their
+// calculated value, so the file is right even before it is recalculated
+func writeOdsCell(w io.Writer, file *excelize.File, sheet, cell string) error {
+	var attrs []string
+	if style, err := file.GetCellStyle(sheet, cell); err == nil && style != 0 {
+		attrs = append(attrs, `table:style-name="ce1"`)
+	}
+	formula, err := file.GetCellFormula(sheet, cell)
+	if err != nil {
+		return err
+	}
+	var value string
+	var isNumber bool
+	if formula != "" {
+		attrs = append(attrs, fmt.Sprintf(`table:formula="%s"`, escapeXml(odsFormula(formula))))
+		value, err = file.CalcCellValue(sheet, cell)
+		if err != nil {
+			value = ""
+		}
+		_, errNumber := strconv.ParseFloat(value, 64)
+		isNumber = value != "" && errNumber == nil
+	} else {
+		value, err = file.GetCellValue(sheet, cell, excelize.Options{RawCellValue: true})
+		if err != nil {
+			return err
+		}
+		cellType, err := file.GetCellType(sheet, cell)
+		if err != nil {
+			return err
+		}
+		isNumber = value != "" && cellType == excelize.CellTypeUnset
+	}
+	if isNumber {
+		attrs = append(attrs, `office:value-type="float"`, fmt.Sprintf(`office:value="%s"`, value))
+	} else if value != "" || formula != "" {
+		attrs = append(attrs, `office:value-type="string"`)
+	}
+	tag := strings.Join(append([]string{"table:table-cell"}, attrs...), " ")
+	if value == "" {
+		_, err = fmt.Fprintf(w, "<%s/>", tag)
+		return err
+	}
+	_, err = fmt.Fprintf(w, "<%s><text:p>%s</text:p></table:table-cell>", tag, escapeXml(value))
+	return err
+}
+
+func writeOdsTable(w io.Writer, file *excelize.File, sheet string, columns, rows int) error {
+	if _, err := fmt.Fprintf(w, "<table:table table:name=\"%s\">\n", escapeXml(sheet)); err != nil {
+		return err
+	}
+	for row := 1; row <= rows; row++ {
+		if _, err := io.WriteString(w, "<table:table-row>"); err != nil {
+			return err
+		}
+		for column := 1; column <= columns; column++ {
+			if err := writeOdsCell(w, file, sheet, cellName(column, row)); err != nil {
+				return err
+			}
+		}
+		if _, err := io.WriteString(w, "</table:table-row>\n"); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "</table:table>\n")
+	return err
+}
+
+// An ods is a zip of XML files, the sheets are the same of the xlsx.
+// The mimetype must be the first file and without compression
+func (g *GradesService) exportOds(
+	w io.Writer,
+	columns []exportColumn,
+	students []StudentGrade,
+	programs []models.GradesProgram,
+) error {
+	file, err := g.newExportFile(columns, students, programs)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	archive := zip.NewWriter(w)
+	mimetype, err := archive.CreateHeader(&zip.FileHeader{
+		Name:   "mimetype",
+		Method: zip.Store,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(mimetype, ExportContentTypes[EXPORT_ODS]); err != nil {
+		return err
+	}
+	manifest, err := archive.Create("META-INF/manifest.xml")
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(manifest, odsManifest); err != nil {
+		return err
+	}
+	content, err := archive.Create("content.xml")
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(content, odsContentHeader); err != nil {
+		return err
+	}
+	err = writeOdsTable(
+		content,
+		file,
+		EXPORT_GRADES_SHEET,
+		len(columns)+EXPORT_STUDENT_COLUMNS,
+		len(students)+1,
+	)
+	if err != nil {
+		return err
+	}
+	if err := writeOdsTable(content, file, EXPORT_SUMMARY_SHEET, 6, len(columns)+1); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(content, odsContentFooter); err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+func (g *GradesService) exportCsv(
+	w io.Writer,
+	columns []exportColumn,
+	students []StudentGrade,
+	programs []models.GradesProgram,
+) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"RUT", "Estudiante"}
+	for _, column := range columns {
+		header = append(header, column.title)
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, student := range students {
+		record := []string{
+			student.Student.Rut,
+			fmt.Sprintf("%v %v", student.Student.Name, student.Student.FirstLastname),
+		}
+		for _, column := range columns {
+			value := g.getExportValue(column, student.Grades, programs)
+			if value == nil {
+				record = append(record, "")
+			} else {
+				record = append(record, strconv.FormatFloat(*value, 'f', -1, 64))
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func (g *GradesService) exportJson(
+	w io.Writer,
+	students []StudentGrade,
+	programs []models.GradesProgram,
+) error {
+	exported := make([]ExportedStudentGrades, len(students))
+	for i, student := range students {
+		exported[i] = ExportedStudentGrades{
+			Student: student.Student,
+			Average: g.getWeightedAverage(student.Grades, programs),
+		}
+		for j, program := range programs {
+			grade := ExportedGrade{
+				Program:    program.Number,
+				Percentage: program.Percentage,
+			}
+			if student.Grades[j] != nil {
+				grade.Grade = &student.Grades[j].Grade
+			}
+			for k, acumulative := range program.Acumulative {
+				exportedAcumulative := ExportedAcumulative{
+					Number:     acumulative.Number,
+					Percentage: acumulative.Percentage,
+				}
+				if student.Grades[j] != nil &&
+					k < len(student.Grades[j].Acumulative) &&
+					student.Grades[j].Acumulative[k] != nil {
+					exportedAcumulative.Grade = &student.Grades[j].Acumulative[k].Grade
+				}
+				grade.Acumulative = append(grade.Acumulative, exportedAcumulative)
+			}
+			exported[i].Grades = append(exported[i].Grades, grade)
+		}
+	}
+	return json.NewEncoder(w).Encode(exported)
+}
+
+func (g *GradesService) ExportGrades(idModule, format string, w io.Writer) *res.ErrorRes {
+	_, err := primitive.ObjectIDFromHex(idModule)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	if _, exists := ExportContentTypes[format]; !exists {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("formato de exportación inválido"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get grades
+	students, errRes := g.GetStudentsGrades(idModule, false, nil)
+	if errRes != nil {
+		return errRes
+	}
+	// Get programs
+	programs, errRes := g.GetGradePrograms(idModule)
+	if errRes != nil {
+		return errRes
+	}
+	columns := g.getExportColumns(programs)
+	// Export
+	switch format {
+	case EXPORT_XLSX:
+		err = g.exportXlsx(w, columns, students, programs)
+	case EXPORT_ODS:
+		err = g.exportOds(w, columns, students, programs)
+	case EXPORT_CSV:
+		err = g.exportCsv(w, columns, students, programs)
+	case EXPORT_JSON:
+		err = g.exportJson(w, students, programs)
+	}
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusNotExtended,
+		}
+	}
+	return nil
+}
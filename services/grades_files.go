@@ -6,117 +6,121 @@ import (
 	"io"
 	"math"
 	"net/http"
+	"sort"
 	"strconv"
 	"time"
 
 	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/funct"
 	"github.com/CPU-commits/Intranet_BClassroom/models"
 	"github.com/CPU-commits/Intranet_BClassroom/res"
 	"github.com/CPU-commits/Intranet_BClassroom/stack"
 	"github.com/jung-kurt/gofpdf"
-	"github.com/xuri/excelize/v2"
+	"github.com/klauspost/compress/zip"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
-func (g *GradesService) ExportGrades(idModule string, w io.Writer) (*excelize.File, *res.ErrorRes) {
-	_, err := primitive.ObjectIDFromHex(idModule)
-	if err != nil {
-		return nil, &res.ErrorRes{
-			Err:        err,
-			StatusCode: http.StatusBadRequest,
-		}
-	}
-	// Get grades
-	data, errRes := g.GetStudentsGrades(idModule, false, nil)
-	if errRes != nil {
-		return nil, errRes
-	}
-	// Get programs
-	programs, errRes := g.GetGradePrograms(idModule)
-	if errRes != nil {
-		return nil, errRes
-	}
-	// Init file
-	file := excelize.NewFile()
-	sheetName := "Calificaciones"
-	file.SetSheetName("Sheet1", sheetName)
-	// Set columns
-	file.SetCellValue(sheetName, "A1", "Estudiante")
-	for i, program := range programs {
-		value := fmt.Sprintf("%v (%v%v)", program.Number, program.Percentage, string('%'))
-		column := fmt.Sprintf("%v1", string(rune('A'+i+1)))
-		file.SetCellValue(sheetName, column, value)
-	}
-	// Set values
-	for i, student := range data {
-		studentName := fmt.Sprintf(
-			"%v %v %v",
-			student.Student.Name,
-			student.Student.FirstLastname,
-			student.Student.Rut,
-		)
+const REPORT_CARD_FONT = "times_utf8"
 
-		column := fmt.Sprintf("A%v", i+2)
-		file.SetCellValue(sheetName, column, studentName)
-		for j, grade := range student.Grades {
-			if grade != nil {
-				column := fmt.Sprintf("%v%v", string(rune('A'+j+1)), i+2)
-				if !grade.IsAcumulative {
-					file.SetCellValue(sheetName, column, grade.Grade)
-				} else {
-					value := ""
-					for k, acumulative := range grade.Acumulative {
-						if acumulative != nil {
-							value += fmt.Sprintf(
-								"%v (%v%v) - ",
-								acumulative.Grade,
-								programs[j].Acumulative[k].Percentage,
-								string('%'),
-							)
-						}
-					}
-					file.SetCellValue(sheetName, column, value)
-				}
-			}
-		}
-	}
+type reportCardModule struct {
+	Subject  string
+	Programs []models.GradesProgram
+	Grades   []*OrderedGrade
+	Average  *float64
+	Scale    GradingScale
+}
 
-	if err := file.Write(w); err != nil {
-		return nil, &res.ErrorRes{
-			Err:        err,
-			StatusCode: http.StatusNotExtended,
-		}
+type reportCardWork struct {
+	Module primitive.ObjectID `bson:"module"`
+	Title  string             `bson:"title"`
+	Grade  float64            `bson:"grade"`
+	Date   primitive.DateTime `bson:"date"`
+}
+
+type reportCardSession struct {
+	Work     primitive.ObjectID `bson:"work"`
+	Module   primitive.ObjectID `bson:"module"`
+	Title    string             `bson:"title"`
+	InDate   primitive.DateTime `bson:"in_date"`
+	PreGrade float64            `bson:"pregrade"`
+}
+
+type reportCard struct {
+	Student             models.SimpleUser
+	Semester            *models.Semester
+	Modules             []reportCardModule
+	Subjects            map[primitive.ObjectID]string
+	Works               []reportCardWork
+	Sessions            []reportCardSession
+	Average             *float64
+	IsPartialAverage    bool
+	LastSemesterAverage *float64
+}
+
+// Bar of the grade evolution chart
+type reportCardBar struct {
+	module int
+	number int
+	grade  float64
+	date   time.Time
+}
+
+// Colors of the modules in the chart
+var reportCardColors = [][3]int{
+	{52, 101, 164},
+	{204, 0, 0},
+	{78, 154, 6},
+	{245, 121, 0},
+	{117, 80, 123},
+	{193, 125, 17},
+	{6, 152, 154},
+	{136, 138, 133},
+}
+
+func formatReportGrade(grade float64) string {
+	return strconv.FormatFloat(math.Round(grade*10)/10, 'f', 1, 64)
+}
+
+func formatReportName(user models.SimpleUser) string {
+	if user.FirstLastname == "" {
+		return user.Name
 	}
-	return file, nil
+	return fmt.Sprintf("%v %v %v", user.Name, user.FirstLastname, user.SecondLastname)
 }
 
-func (g *GradesService) ExportGradesStudent(claims *Claims, idSemester string, w io.Writer) *res.ErrorRes {
-	// Init PDF
-	pdf := gofpdf.New("L", "mm", "A4", "")
-	pdf.AddUTF8Font("times_utf8", "", "./fonts/times.ttf")
-	defer pdf.Close()
+// Cut the text to the width of the cell
+func fitReportText(pdf *gofpdf.Fpdf, text string, width float64) string {
+	if pdf.GetStringWidth(text) <= width-2 {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 && pdf.GetStringWidth(string(runes)+"...") > width-2 {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "..."
+}
 
+func getCollegeData() (map[string]string, *res.ErrorRes) {
 	data, err := formatRequestToNestjsNats("")
 	if err != nil {
-		return &res.ErrorRes{
+		return nil, &res.ErrorRes{
 			Err:        err,
 			StatusCode: http.StatusBadRequest,
 		}
 	}
 	msg, err := nats.Request("get_college_data", data)
 	if err != nil {
-		return &res.ErrorRes{
+		return nil, &res.ErrorRes{
 			Err:        err,
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
-
-	// Get college data
 	var response stack.NatsNestJSRes
 	err = json.Unmarshal(msg.Data, &response)
 	if err != nil {
-		return &res.ErrorRes{
+		return nil, &res.ErrorRes{
 			Err:        err,
 			StatusCode: http.StatusInternalServerError,
 		}
@@ -125,138 +129,375 @@ func (g *GradesService) ExportGradesStudent(claims *Claims, idSemester string, w
 	var collegeData map[string]string
 	jsonString, err := json.Marshal(response.Response)
 	if err != nil {
-		return &res.ErrorRes{
+		return nil, &res.ErrorRes{
 			Err:        err,
 			StatusCode: http.StatusInternalServerError,
 		}
 	}
 	err = json.Unmarshal(jsonString, &collegeData)
 	if err != nil {
-		return &res.ErrorRes{
+		return nil, &res.ErrorRes{
 			Err:        err,
 			StatusCode: http.StatusInternalServerError,
 		}
 	}
-	// Get semester
+	return collegeData, nil
+}
+
+func getSemesterOrCurrent(idSemester string) (*models.Semester, *res.ErrorRes) {
 	var semester *models.Semester
+	var err error
 	if idSemester == "" {
 		semester, err = getCurrentSemester()
-		if err != nil {
-			return &res.ErrorRes{
-				Err:        err,
-				StatusCode: http.StatusServiceUnavailable,
-			}
-		}
 	} else {
 		semester, err = getSemester(idSemester)
+	}
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return semester, nil
+}
+
+// Modules of the student in the current semester, or in the history
+// of a past semester
+func (g *GradesService) getStudentSemesterModules(idStudent, idSemester string) ([]models.ModuleWithLookup, *res.ErrorRes) {
+	if idSemester == "" {
+		courses, err := FindCourses(&Claims{
+			ID:       idStudent,
+			UserType: models.STUDENT,
+		})
 		if err != nil {
-			return &res.ErrorRes{
-				Err:        err,
-				StatusCode: http.StatusServiceUnavailable,
-			}
+			return nil, err
 		}
+		return moduleService.GetModules(courses, models.STUDENT, true)
 	}
-	pdf.SetFont("times_utf8", "", 10)
-	pdf.AddPage()
-	// Set college data
-	contact := fmt.Sprintf("%v - %v", collegeData["phone"], collegeData["email"])
-	pdf.Text(5, 10, settingsData.COLLEGE_NAME)
-	pdf.Text(5, 15, collegeData["direction"])
-	pdf.Text(5, 20, contact)
-	// Set semester data
-	width, height := pdf.GetPageSize()
-	rightMargin := width - 5
-
-	semesterString := fmt.Sprintf("%v° Semestre - %v", semester.Semester, semester.Year)
-
-	pdf.Text(rightMargin-pdf.GetStringWidth(claims.Name), 10, claims.Name)
-	pdf.Text(rightMargin-pdf.GetStringWidth(semesterString), 15, semesterString)
-	// Footer
-	date := fmt.Sprintf("Emitido el %s", time.Now().Format("2006-01-02"))
-	pdf.Text(5, height-5, date)
-	// Table
-	// Grades numbers
-	pdf.SetXY(5, 30)
-	pdf.CellFormat(
-		55,
-		4,
-		"Materia",
-		"1",
+	modules, _, err := moduleService.GetModulesHistory(
+		idStudent,
 		0,
-		"",
-		false,
 		0,
-		"",
+		false,
+		true,
+		idSemester,
 	)
-	var sum float64 = 60
-	for i := 0; i < MAX_GRADES; i++ {
-		pdf.SetXY(sum, 30)
+	return modules, err
+}
 
-		grade := strconv.Itoa(i + 1)
-		pdf.CellFormat(
-			7,
-			4,
-			grade,
-			"1",
-			0,
-			"C",
-			false,
-			0,
-			"",
-		)
-		sum += 7
+func (g *GradesService) getSemesterAverage(idObjSemester, idObjStudent primitive.ObjectID) (*float64, error) {
+	var average *models.Average
+	cursor := averageModel.GetOne(bson.D{
+		{
+			Key:   "semester",
+			Value: idObjSemester,
+		},
+		{
+			Key:   "student",
+			Value: idObjStudent,
+		},
+	})
+	if err := cursor.Decode(&average); err != nil {
+		if err.Error() == db.NO_SINGLE_DOCUMENT {
+			return nil, nil
+		}
+		return nil, err
 	}
-	pdf.SetXY(sum, 30)
+	return &average.Average, nil
+}
 
-	promString := "Prom."
-	widthPromString := pdf.GetStringWidth(promString) + 4
-	pdf.CellFormat(
-		widthPromString,
-		4,
-		promString,
-		"1",
-		0,
-		"C",
-		false,
-		0,
-		"",
-	)
+// Released grades of the works out of the grade programs
+func (g *GradesService) getReportCardWorks(
+	idObjModules []primitive.ObjectID,
+	idObjStudent primitive.ObjectID,
+) ([]reportCardWork, error) {
+	var works []reportCardWork
 
-	// Subjects
-	var modulesData []models.ModuleWithLookup
-	if idSemester == "" {
-		courses, err := FindCourses(claims)
+	match := releasedFilter()
+	match["student"] = idObjStudent
+	match["module"] = bson.M{
+		"$in": idObjModules,
+	}
+	cursor, err := workGradeModel.Aggreagate(mongo.Pipeline{
+		bson.D{{
+			Key:   "$match",
+			Value: match,
+		}},
+		bson.D{{
+			Key: "$lookup",
+			Value: bson.M{
+				"from":         models.WORKS_COLLECTION,
+				"localField":   "work",
+				"foreignField": "_id",
+				"as":           "work",
+				"pipeline": bson.A{bson.D{{
+					Key: "$project",
+					Value: bson.M{
+						"title": 1,
+					},
+				}}},
+			},
+		}},
+		bson.D{{
+			Key: "$project",
+			Value: bson.M{
+				"module": 1,
+				"grade":  1,
+				"date":   1,
+				"title": bson.M{
+					"$arrayElemAt": bson.A{"$work.title", 0},
+				},
+			},
+		}},
+		bson.D{{
+			Key: "$sort",
+			Value: bson.M{
+				"date": 1,
+			},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &works); err != nil {
+		return nil, err
+	}
+	return works, nil
+}
+
+// In-person sessions of the student in works already revised
+func (g *GradesService) getReportCardSessions(
+	idObjModules []primitive.ObjectID,
+	idObjStudent primitive.ObjectID,
+) ([]reportCardSession, error) {
+	var sessions []reportCardSession
+
+	cursor, err := sessionModel.Aggreagate(mongo.Pipeline{
+		bson.D{{
+			Key: "$match",
+			Value: bson.M{
+				"student": idObjStudent,
+			},
+		}},
+		bson.D{{
+			Key: "$lookup",
+			Value: bson.M{
+				"from":         models.WORKS_COLLECTION,
+				"localField":   "work",
+				"foreignField": "_id",
+				"as":           "work",
+				"pipeline": bson.A{bson.D{{
+					Key: "$project",
+					Value: bson.M{
+						"title":      1,
+						"module":     1,
+						"is_revised": 1,
+					},
+				}}},
+			},
+		}},
+		bson.D{{
+			Key: "$match",
+			Value: bson.M{
+				"work.module": bson.M{
+					"$in": idObjModules,
+				},
+				"work.is_revised": true,
+			},
+		}},
+		bson.D{{
+			Key: "$project",
+			Value: bson.M{
+				"in_date":  1,
+				"pregrade": 1,
+				"work": bson.M{
+					"$arrayElemAt": bson.A{"$work._id", 0},
+				},
+				"title": bson.M{
+					"$arrayElemAt": bson.A{"$work.title", 0},
+				},
+				"module": bson.M{
+					"$arrayElemAt": bson.A{"$work.module", 0},
+				},
+			},
+		}},
+		bson.D{{
+			Key: "$sort",
+			Value: bson.M{
+				"in_date": 1,
+			},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &sessions); err != nil {
+		return nil, err
+	}
+	// The pregrades are shown with the released grade of the work
+	var releasedSessions []reportCardSession
+	releasedWorks := make(map[primitive.ObjectID]bool)
+	for _, session := range sessions {
+		released, exists := releasedWorks[session.Work]
+		if !exists {
+			work, err := workRepository.GetWorkFromId(session.Work)
+			if err != nil {
+				return nil, err
+			}
+			released, err = workService.isStudentGradeReleased(work, idObjStudent)
+			if err != nil {
+				return nil, err
+			}
+			releasedWorks[session.Work] = released
+		}
+		if released {
+			releasedSessions = append(releasedSessions, session)
+		}
+	}
+	return releasedSessions, nil
+}
+
+func (g *GradesService) getReportCard(
+	student models.SimpleUser,
+	semester *models.Semester,
+	modules []models.ModuleWithLookup,
+) (*reportCard, *res.ErrorRes) {
+	idObjStudent, err := primitive.ObjectIDFromHex(student.ID)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	card := &reportCard{
+		Student:  student,
+		Semester: semester,
+		Subjects: make(map[primitive.ObjectID]string),
+	}
+	// Modules
+	var idObjModules []primitive.ObjectID
+	var averages []float64
+	for _, module := range modules {
+		programs, errRes := g.GetGradePrograms(module.ID.Hex())
+		if errRes != nil {
+			return nil, errRes
+		}
+		grades, errRes := g.GetStudentGrades(module.ID.Hex(), student.ID, true)
+		if errRes != nil {
+			return nil, errRes
+		}
+		scale, err := getGradingScale(module.ID)
 		if err != nil {
-			return err
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
 		}
-		modulesData, err = moduleService.GetModules(courses, claims.UserType, true)
+		average, _ := getModuleAverage(grades, programs)
+		if average != nil {
+			averages = append(averages, *average)
+		}
+		card.Modules = append(card.Modules, reportCardModule{
+			Subject:  module.Subject.Subject,
+			Programs: programs,
+			Grades:   grades,
+			Average:  average,
+			Scale:    scale,
+		})
+		card.Subjects[module.ID] = module.Subject.Subject
+		idObjModules = append(idObjModules, module.ID)
+	}
+	if len(idObjModules) > 0 {
+		card.Works, err = g.getReportCardWorks(idObjModules, idObjStudent)
 		if err != nil {
-			return err
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
 		}
-	} else {
-		var errRes *res.ErrorRes
-		modulesData, _, errRes = moduleService.GetModulesHistory(
-			claims.ID,
-			0,
-			0,
-			false,
-			true,
-			idSemester,
-		)
-		if errRes != nil {
-			return errRes
+		card.Sessions, err = g.getReportCardSessions(idObjModules, idObjStudent)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+	}
+	// Semester average, until the semester is closed it is the
+	// average of the modules
+	card.Average, err = g.getSemesterAverage(semester.ID, idObjStudent)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if card.Average == nil && len(averages) > 0 {
+		card.Average = getMeanAverage(averages)
+		card.IsPartialAverage = true
+	}
+	if semester.Semester == 2 {
+		lastSemester, err := getLastSemester(semester.ID.Hex())
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		if lastSemester != nil {
+			card.LastSemesterAverage, err = g.getSemesterAverage(lastSemester.ID, idObjStudent)
+			if err != nil {
+				return nil, &res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusServiceUnavailable,
+				}
+			}
 		}
 	}
+	return card, nil
+}
 
-	var sumHeight float64 = 34
-	var averages []float64
-	for _, module := range modulesData {
-		pdf.SetXY(5, sumHeight)
+func (g *GradesService) writeReportCardTitle(pdf *gofpdf.Fpdf, title string) {
+	pdf.Ln(4)
+	pdf.SetFontSize(12)
+	pdf.CellFormat(0, 7, title, "", 1, "L", false, 0, "")
+	pdf.SetFontSize(9)
+}
 
+func (g *GradesService) writeReportCardGrades(pdf *gofpdf.Fpdf, card *reportCard) {
+	g.writeReportCardTitle(pdf, "Calificaciones")
+	// Columns
+	maxPrograms := 1
+	for _, module := range card.Modules {
+		for _, program := range module.Programs {
+			if program.Number > maxPrograms {
+				maxPrograms = program.Number
+			}
+		}
+	}
+	width, _ := pdf.GetPageSize()
+	left, _, right, _ := pdf.GetMargins()
+	subjectWidth, averageWidth := 60.0, 18.0
+	gradeWidth := math.Min(12, (width-left-right-subjectWidth-averageWidth)/float64(maxPrograms))
+	// Header
+	pdf.SetFillColor(230, 230, 230)
+	pdf.CellFormat(subjectWidth, 6, "Materia", "1", 0, "", true, 0, "")
+	for i := 0; i < maxPrograms; i++ {
+		pdf.CellFormat(gradeWidth, 6, strconv.Itoa(i+1), "1", 0, "C", true, 0, "")
+	}
+	pdf.CellFormat(averageWidth, 6, "Prom.", "1", 1, "C", true, 0, "")
+	// Modules
+	for _, module := range card.Modules {
+		grades := make(map[int]string)
+		for i, program := range module.Programs {
+			if i < len(module.Grades) && module.Grades[i] != nil {
+				grades[program.Number] = formatReportGrade(module.Grades[i].Grade)
+			}
+		}
 		pdf.CellFormat(
-			55,
-			4,
-			module.Subject.Subject,
+			subjectWidth,
+			6,
+			fitReportText(pdf, module.Subject, subjectWidth),
 			"1",
 			0,
 			"",
@@ -264,174 +505,435 @@ func (g *GradesService) ExportGradesStudent(claims *Claims, idSemester string, w
 			0,
 			"",
 		)
-		sumHeight += 4
-		// Get grades
-		grades, err := g.GetStudentGrades(module.ID.Hex(), claims.ID)
-		if err != nil {
-			return err
+		for i := 0; i < maxPrograms; i++ {
+			pdf.CellFormat(gradeWidth, 6, grades[i+1], "1", 0, "C", false, 0, "")
 		}
-		// Get program grades
-		program, errRes := g.GetGradePrograms(module.ID.Hex())
-		if errRes != nil {
-			return err
-		}
-		// Print grades
-		var sum float64 = 60
-		var average float64
-
-		for i := 0; i < MAX_GRADES; i++ {
-			pdf.SetXY(sum, 34)
-
-			var toPrint string
-			// Get grade
-			for j, p := range program {
-				if p.Number == i+1 {
-					if grades[j] != nil {
-						if !grades[j].IsAcumulative {
-							toPrint = strconv.Itoa(int(grades[j].Grade))
-							average += (grades[j].Grade * float64(p.Percentage)) / 100
-						} else if grades[j].IsAcumulative && len(grades[j].Acumulative) == len(p.Acumulative) {
-							var grade float64
-							for k, acu := range grades[j].Acumulative {
-								if acu != nil {
-									grade += (acu.Grade * float64(p.Acumulative[k].Percentage)) / 100
-								}
-							}
-							grade = math.Round(grade)
-							toPrint = strconv.Itoa(int(grade))
-							average += (grade * float64(p.Percentage)) / 100
-						}
-						break
-					}
+		var average string
+		if module.Average != nil {
+			average = formatReportGrade(*module.Average)
+		}
+		pdf.CellFormat(averageWidth, 6, average, "1", 1, "C", false, 0, "")
+	}
+	// Averages
+	x := left + subjectWidth + gradeWidth*float64(maxPrograms)
+	writeAverage := func(title string, average float64) {
+		pdf.SetX(x - 45)
+		pdf.CellFormat(45, 6, title, "1", 0, "R", true, 0, "")
+		pdf.CellFormat(averageWidth, 6, formatReportGrade(average), "1", 1, "C", false, 0, "")
+	}
+	if card.Average != nil {
+		title := fmt.Sprintf("Promedio %v° semestre", card.Semester.Semester)
+		if card.IsPartialAverage {
+			title = "Promedio parcial"
+		}
+		writeAverage(title, *card.Average)
+	}
+	if card.LastSemesterAverage != nil {
+		writeAverage("Promedio 1° semestre", *card.LastSemesterAverage)
+		if card.Average != nil && !card.IsPartialAverage {
+			writeAverage("Promedio anual", (*card.Average+*card.LastSemesterAverage)/2)
+		}
+	}
+}
+
+func (g *GradesService) writeReportCardWorks(pdf *gofpdf.Fpdf, card *reportCard) {
+	if len(card.Works) == 0 {
+		return
+	}
+	g.writeReportCardTitle(pdf, "Trabajos evaluados")
+	pdf.CellFormat(60, 6, "Materia", "1", 0, "", true, 0, "")
+	pdf.CellFormat(150, 6, "Trabajo", "1", 0, "", true, 0, "")
+	pdf.CellFormat(35, 6, "Fecha", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(32, 6, "Calificación", "1", 1, "C", true, 0, "")
+	for _, work := range card.Works {
+		pdf.CellFormat(60, 6, fitReportText(pdf, card.Subjects[work.Module], 60), "1", 0, "", false, 0, "")
+		pdf.CellFormat(150, 6, fitReportText(pdf, work.Title, 150), "1", 0, "", false, 0, "")
+		pdf.CellFormat(35, 6, work.Date.Time().Format("2006-01-02"), "1", 0, "C", false, 0, "")
+		pdf.CellFormat(32, 6, formatReportGrade(work.Grade), "1", 1, "C", false, 0, "")
+	}
+}
+
+func (g *GradesService) writeReportCardSessions(pdf *gofpdf.Fpdf, card *reportCard) {
+	if len(card.Sessions) == 0 {
+		return
+	}
+	g.writeReportCardTitle(pdf, "Sesiones presenciales")
+	pdf.CellFormat(60, 6, "Materia", "1", 0, "", true, 0, "")
+	pdf.CellFormat(130, 6, "Trabajo", "1", 0, "", true, 0, "")
+	pdf.CellFormat(55, 6, "Ingreso", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(32, 6, "Pre-calificación", "1", 1, "C", true, 0, "")
+	for _, session := range card.Sessions {
+		pdf.CellFormat(60, 6, fitReportText(pdf, card.Subjects[session.Module], 60), "1", 0, "", false, 0, "")
+		pdf.CellFormat(130, 6, fitReportText(pdf, session.Title, 130), "1", 0, "", false, 0, "")
+		pdf.CellFormat(55, 6, session.InDate.Time().Format("2006-01-02 15:04"), "1", 0, "C", false, 0, "")
+		pdf.CellFormat(32, 6, formatReportGrade(session.PreGrade), "1", 1, "C", false, 0, "")
+	}
+}
+
+// Grades of the semester sorted by date, the date of an acumulative
+// grade is the one of its last grade
+func (g *GradesService) getReportCardBars(card *reportCard) []reportCardBar {
+	var bars []reportCardBar
+	for i, module := range card.Modules {
+		for j, grade := range module.Grades {
+			if grade == nil || j >= len(module.Programs) {
+				continue
+			}
+			date := grade.Date
+			for _, acumulative := range grade.Acumulative {
+				if acumulative != nil && acumulative.Date.After(date) {
+					date = acumulative.Date
 				}
 			}
-			pdf.CellFormat(
-				7,
-				4,
-				toPrint,
-				"1",
-				0,
-				"C",
-				false,
-				0,
-				"",
-			)
-			sum += 7
-		}
-		averageRound := math.Round(average)
-		// Print average
-		pdf.CellFormat(
-			widthPromString,
-			4,
-			strconv.Itoa(int(averageRound)),
-			"1",
-			0,
-			"C",
-			false,
-			0,
-			"",
-		)
-		// Append average
-		averages = append(averages, averageRound)
+			bars = append(bars, reportCardBar{
+				module: i,
+				number: module.Programs[j].Number,
+				grade:  grade.Grade,
+				date:   date,
+			})
+		}
 	}
+	sort.SliceStable(bars, func(i, j int) bool {
+		return bars[i].date.Before(bars[j].date)
+	})
+	return bars
+}
 
-	var averageFinal float64
-	for _, average := range averages {
-		averageFinal += average
+// Bar chart of the grade evolution drawn with the primitives of the PDF
+func (g *GradesService) writeReportCardChart(pdf *gofpdf.Fpdf, card *reportCard) {
+	bars := g.getReportCardBars(card)
+	if len(bars) == 0 {
+		return
 	}
-	averageFinal /= float64(len(averages))
-	if semester.Semester == 2 {
-		idObjStudent, err := primitive.ObjectIDFromHex(claims.ID)
-		if err != nil {
-			return &res.ErrorRes{
-				Err:        err,
-				StatusCode: http.StatusBadRequest,
+	width, height := pdf.GetPageSize()
+	left, _, right, bottom := pdf.GetMargins()
+	legendRows := math.Ceil(float64(len(card.Modules)) / 4)
+	chartHeight := 70.0
+	if pdf.GetY()+chartHeight+30+legendRows*6 > height-bottom {
+		pdf.AddPage()
+	}
+	g.writeReportCardTitle(pdf, "Evolución de calificaciones")
+	// Scale
+	min, max := math.MaxFloat64, 0.0
+	for _, module := range card.Modules {
+		min = math.Min(min, module.Scale.Min())
+		max = math.Max(max, module.Scale.Max())
+	}
+	if min >= max {
+		min = 0
+	}
+	// Axes
+	axisX := left + 12
+	top := pdf.GetY() + 2
+	base := top + chartHeight
+	chartWidth := width - right - axisX
+	pdf.SetDrawColor(180, 180, 180)
+	pdf.SetFontSize(7)
+	for i := 0; i <= 4; i++ {
+		value := min + (max-min)*float64(i)/4
+		y := base - chartHeight*float64(i)/4
+		pdf.Line(axisX, y, axisX+chartWidth, y)
+		label := formatReportGrade(value)
+		pdf.Text(axisX-pdf.GetStringWidth(label)-2, y+1, label)
+	}
+	pdf.SetDrawColor(0, 0, 0)
+	pdf.Line(axisX, top, axisX, base)
+	pdf.Line(axisX, base, axisX+chartWidth, base)
+	// Bars
+	step := chartWidth / float64(len(bars))
+	barWidth := math.Min(10, step*0.7)
+	for i, bar := range bars {
+		color := reportCardColors[bar.module%len(reportCardColors)]
+		barHeight := chartHeight * (math.Max(bar.grade, min) - min) / (max - min)
+		x := axisX + step*float64(i) + (step-barWidth)/2
+
+		pdf.SetFillColor(color[0], color[1], color[2])
+		pdf.Rect(x, base-barHeight, barWidth, barHeight, "F")
+		label := formatReportGrade(bar.grade)
+		pdf.Text(x+(barWidth-pdf.GetStringWidth(label))/2, base-barHeight-1, label)
+		number := fmt.Sprintf("N%d", bar.number)
+		pdf.Text(x+(barWidth-pdf.GetStringWidth(number))/2, base+4, number)
+	}
+	// Legend
+	pdf.SetFontSize(8)
+	legendWidth := (width - left - right) / 4
+	for i, module := range card.Modules {
+		color := reportCardColors[i%len(reportCardColors)]
+		x := left + legendWidth*float64(i%4)
+		y := base + 8 + 6*float64(i/4)
+
+		pdf.SetFillColor(color[0], color[1], color[2])
+		pdf.Rect(x, y, 4, 4, "F")
+		pdf.Text(x+6, y+3, fitReportText(pdf, module.Subject, legendWidth-6))
+	}
+	pdf.SetY(base + 8 + 6*legendRows)
+	pdf.SetFontSize(9)
+}
+
+func (g *GradesService) writeReportCard(
+	w io.Writer,
+	card *reportCard,
+	collegeData map[string]string,
+) error {
+	pdf := gofpdf.New("L", "mm", "A4", "")
+	pdf.AddUTF8Font(REPORT_CARD_FONT, "", "./fonts/times.ttf")
+	pdf.SetMargins(10, 30, 10)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.AliasNbPages("")
+	defer pdf.Close()
+
+	studentName := formatReportName(card.Student)
+	semesterString := fmt.Sprintf("%v° Semestre - %v", card.Semester.Semester, card.Semester.Year)
+	contact := fmt.Sprintf("%v - %v", collegeData["phone"], collegeData["email"])
+	// Header and footer of each page
+	pdf.SetHeaderFunc(func() {
+		width, _ := pdf.GetPageSize()
+		rightMargin := width - 10
+
+		pdf.SetFontSize(10)
+		pdf.Text(10, 10, settingsData.COLLEGE_NAME)
+		pdf.Text(10, 15, collegeData["direction"])
+		pdf.Text(10, 20, contact)
+
+		pdf.Text(rightMargin-pdf.GetStringWidth(studentName), 10, studentName)
+		if card.Student.Rut != "" {
+			pdf.Text(rightMargin-pdf.GetStringWidth(card.Student.Rut), 15, card.Student.Rut)
+		}
+		pdf.Text(rightMargin-pdf.GetStringWidth(semesterString), 20, semesterString)
+		pdf.Line(10, 24, rightMargin, 24)
+	})
+	pdf.SetFooterFunc(func() {
+		width, height := pdf.GetPageSize()
+		date := fmt.Sprintf("Emitido el %s", time.Now().Format("2006-01-02"))
+		page := fmt.Sprintf("Página %d/{nb}", pdf.PageNo())
+
+		pdf.SetFontSize(8)
+		pdf.Text(10, height-7, date)
+		pdf.Text(width-10-pdf.GetStringWidth(page), height-7, page)
+	})
+	pdf.SetFont(REPORT_CARD_FONT, "", 9)
+	pdf.AddPage()
+	// Title
+	pdf.SetFontSize(14)
+	pdf.CellFormat(0, 8, "Informe de calificaciones", "", 1, "C", false, 0, "")
+	pdf.SetFontSize(9)
+	// Content
+	g.writeReportCardGrades(pdf, card)
+	g.writeReportCardChart(pdf, card)
+	g.writeReportCardWorks(pdf, card)
+	g.writeReportCardSessions(pdf, card)
+
+	return pdf.Output(w)
+}
+
+// Student of the request, an attorney must indicate one of its
+// students and a directive any student
+func (g *GradesService) getClaimsStudent(claims *Claims, idStudent string) (*models.SimpleUser, *res.ErrorRes) {
+	if claims.UserType == models.STUDENT || claims.UserType == models.STUDENT_DIRECTIVE {
+		return &models.SimpleUser{
+			ID:   claims.ID,
+			Name: claims.Name,
+		}, nil
+	}
+	idObjStudent, err := primitive.ObjectIDFromHex(idStudent)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("se debe indicar el estudiante"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	if claims.UserType == models.ATTORNEY {
+		students, errRes := getParentStudents(claims.IDObj)
+		if errRes != nil {
+			return nil, errRes
+		}
+		if !funct.Some(students, func(id primitive.ObjectID) bool {
+			return id == idObjStudent
+		}) {
+			return nil, &res.ErrorRes{
+				Err:        fmt.Errorf("no eres apoderado de este estudiante"),
+				StatusCode: http.StatusUnauthorized,
+			}
+		}
+	}
+	studentsData, err := workService.getStudents([]primitive.ObjectID{idObjStudent})
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if len(studentsData) == 0 {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("no existe el estudiante"),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	student := studentsData[0].User
+	student.ID = idStudent
+	return &student, nil
+}
+
+func (g *GradesService) ExportGradesStudent(
+	claims *Claims,
+	idSemester,
+	idStudent string,
+	w io.Writer,
+) *res.ErrorRes {
+	student, errRes := g.getClaimsStudent(claims, idStudent)
+	if errRes != nil {
+		return errRes
+	}
+	collegeData, errRes := getCollegeData()
+	if errRes != nil {
+		return errRes
+	}
+	semester, errRes := getSemesterOrCurrent(idSemester)
+	if errRes != nil {
+		return errRes
+	}
+	modules, errRes := g.getStudentSemesterModules(student.ID, idSemester)
+	if errRes != nil {
+		return errRes
+	}
+	card, errRes := g.getReportCard(*student, semester, modules)
+	if errRes != nil {
+		return errRes
+	}
+	if err := g.writeReportCard(w, card, collegeData); err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return nil
+}
+
+// Students of the section, in a past semester the students of its
+// modules history
+func (g *GradesService) getSectionSemesterStudents(
+	sectionModules []models.ModuleWithLookup,
+	idSemester string,
+) ([]Student, error) {
+	if idSemester == "" {
+		return workService.getStudentsFromIdModule(sectionModules[0].ID.Hex())
+	}
+	idObjSemester, err := primitive.ObjectIDFromHex(idSemester)
+	if err != nil {
+		return nil, err
+	}
+	var idObjModules []primitive.ObjectID
+	for _, module := range sectionModules {
+		idObjModules = append(idObjModules, module.ID)
+	}
+	idStudents, err := moduleHistoryModel.Use().Distinct(db.Ctx, "students", bson.D{
+		{
+			Key: "module",
+			Value: bson.M{
+				"$in": idObjModules,
+			},
+		},
+		{
+			Key:   "semester",
+			Value: idObjSemester,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var idObjStudents []primitive.ObjectID
+	for _, idStudent := range idStudents {
+		if idObjStudent, ok := idStudent.(primitive.ObjectID); ok {
+			idObjStudents = append(idObjStudents, idObjStudent)
+		}
+	}
+	if len(idObjStudents) == 0 {
+		return nil, nil
+	}
+	return workService.getStudents(idObjStudents)
+}
+
+// Report cards of all the students of a section in a zip, one PDF
+// per student
+func (g *GradesService) ExportSectionGrades(idSection, idSemester string, w io.Writer) *res.ErrorRes {
+	idObjSection, err := primitive.ObjectIDFromHex(idSection)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Students of the section
+	sectionModules, errRes := moduleService.GetModules([]ModuleIDs{{
+		IDCourse: idObjSection,
+	}}, models.DIRECTOR, true)
+	if errRes != nil {
+		return errRes
+	}
+	if len(sectionModules) == 0 {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("esta sección no tiene módulos"),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	students, err := g.getSectionSemesterStudents(sectionModules, idSemester)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if len(students) == 0 {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("esta sección no tiene estudiantes"),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	collegeData, errRes := getCollegeData()
+	if errRes != nil {
+		return errRes
+	}
+	semester, errRes := getSemesterOrCurrent(idSemester)
+	if errRes != nil {
+		return errRes
+	}
+	// Zip
+	zipWritter := zip.NewWriter(w)
+	for _, student := range students {
+		// Current modules are the same for all the students
+		modules := sectionModules
+		if idSemester != "" {
+			modules, errRes = g.getStudentSemesterModules(student.User.ID, idSemester)
+			if errRes != nil {
+				return errRes
 			}
 		}
-		// Get last semester
-		var _idSemester string
-		if idSemester == "" {
-			_idSemester = semester.ID.Hex()
-		} else {
-			_idSemester = idSemester
+		card, errRes := g.getReportCard(student.User, semester, modules)
+		if errRes != nil {
+			return errRes
 		}
-		lastSemester, err := getLastSemester(_idSemester)
+		file, err := zipWritter.Create(fmt.Sprintf(
+			"%s - %s.pdf",
+			student.User.Rut,
+			formatReportName(student.User),
+		))
 		if err != nil {
 			return &res.ErrorRes{
 				Err:        err,
-				StatusCode: http.StatusServiceUnavailable,
+				StatusCode: http.StatusInternalServerError,
 			}
 		}
-		if lastSemester != nil {
-			// Get average
-			var average *models.Average
-			cursor := averageModel.GetOne(bson.D{
-				{
-					Key:   "semester",
-					Value: lastSemester.ID,
-				},
-				{
-					Key:   "student",
-					Value: idObjStudent,
-				},
-			})
-			if err := cursor.Decode(&average); err != nil && err.Error() != db.NO_SINGLE_DOCUMENT {
-				return &res.ErrorRes{
-					Err:        err,
-					StatusCode: http.StatusServiceUnavailable,
-				}
-			}
-			if average != nil {
-				pdf.SetY(sumHeight)
-
-				x := float64(5 + 42 + 30*7)
-				pdf.SetX(x)
-				pdf.CellFormat(
-					widthPromString,
-					4,
-					strconv.Itoa(int(average.Average)),
-					"1",
-					0,
-					"C",
-					false,
-					0,
-					"",
-				)
-
-				pdf.SetX(x - 15)
-				pdf.CellFormat(
-					15,
-					4,
-					"1° Sem:",
-					"1",
-					0,
-					"C",
-					false,
-					0,
-					"",
-				)
+		if err := g.writeReportCard(file, card, collegeData); err != nil {
+			return &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
 			}
 		}
 	}
-	// 5 First margin, 55 subject, 30 times * 7 width grades
-	x := float64(5 + 55 + 30*7)
-	pdf.SetXY(x, sumHeight)
-	pdf.CellFormat(
-		widthPromString,
-		4,
-		strconv.Itoa(int(math.Round(averageFinal))),
-		"1",
-		0,
-		"C",
-		false,
-		0,
-		"",
-	)
-
-	if err := pdf.Output(w); err != nil {
+	if err := zipWritter.Close(); err != nil {
 		return &res.ErrorRes{
 			Err:        err,
-			StatusCode: http.StatusServiceUnavailable,
+			StatusCode: http.StatusInternalServerError,
 		}
 	}
 	return nil
@@ -0,0 +1,587 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"github.com/xuri/excelize/v2"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const IMPORT_GRADES_REASON = "Importación de calificaciones"
+
+type importedGrade struct {
+	student     primitive.ObjectID
+	program     *models.GradesProgram
+	acumulative primitive.ObjectID
+	grade       float64
+	old         *models.Grade
+}
+
+func gradeKey(student, program, acumulative primitive.ObjectID) string {
+	return student.Hex() + program.Hex() + acumulative.Hex()
+}
+
+func normalizeRut(rut string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(rut), ".", ""))
+}
+
+// The student column of the export is "name lastname rut",
+// the rut is the last word
+func rutFromCell(cell string) string {
+	words := strings.Fields(cell)
+	if len(words) == 0 {
+		return ""
+	}
+	return normalizeRut(words[len(words)-1])
+}
+
+func parseImportedGrade(value string) (float64, error) {
+	value = strings.ReplaceAll(strings.TrimSpace(value), ",", ".")
+	grade, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s no es una calificación válida", value)
+	}
+	return grade, nil
+}
+
+// Read the rows of a xlsx (first sheet) or csv file, csv files
+// can be separated by commas or semicolons
+func (g *GradesService) readGradesFile(file *multipart.FileHeader) ([][]string, error) {
+	f, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(file.Filename)) {
+	case ".xlsx":
+		book, err := excelize.OpenReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer book.Close()
+		return book.GetRows(book.GetSheetName(0))
+	case ".csv":
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return nil, err
+		}
+		reader := csv.NewReader(bytes.NewReader(data))
+		reader.FieldsPerRecord = -1
+		firstLine, _, _ := strings.Cut(string(data), "\n")
+		if strings.Contains(firstLine, ";") {
+			reader.Comma = ';'
+		}
+		return reader.ReadAll()
+	}
+	return nil, fmt.Errorf("el archivo debe ser xlsx o csv")
+}
+
+// Grade column of the import, acumulative grades can be imported in one
+// column per acumulative grade or in one column with all of them
+type importColumn struct {
+	program     *models.GradesProgram
+	acumulative *models.Acumulative
+}
+
+// The columns of the export are "number (percentage%)" and
+// "number.acumulative (percentage%)". Returns the index of the rut
+// column, -1 if the rut is in the student column
+func (g *GradesService) getImportColumns(
+	header []string,
+	programs []models.GradesProgram,
+	idObjProgram primitive.ObjectID,
+) (map[int]importColumn, int, []ImportGradeError) {
+	columns := make(map[int]importColumn)
+	rutColumn := -1
+	var columnErrors []ImportGradeError
+
+	for i := 0; i < len(header); i++ {
+		title := strings.TrimSpace(header[i])
+		if strings.EqualFold(title, "rut") {
+			rutColumn = i
+			continue
+		}
+		if title == "" || title == "Estudiante" || title == "Promedio" {
+			continue
+		}
+		column, _ := excelize.ColumnNumberToName(i + 1)
+		var number, acumulativeNumber int
+		n, _ := fmt.Sscanf(title, "%d.%d", &number, &acumulativeNumber)
+		if n == 0 {
+			columnErrors = append(columnErrors, ImportGradeError{
+				Row:     1,
+				Column:  column,
+				Message: fmt.Sprintf("la columna %s no es una calificación", title),
+			})
+			continue
+		}
+		var program *models.GradesProgram
+		for j := range programs {
+			if programs[j].Number == number {
+				program = &programs[j]
+				break
+			}
+		}
+		if program == nil {
+			columnErrors = append(columnErrors, ImportGradeError{
+				Row:     1,
+				Column:  column,
+				Message: fmt.Sprintf("no existe la calificación N%d° en el módulo", number),
+			})
+			continue
+		}
+		if !idObjProgram.IsZero() && program.ID != idObjProgram {
+			continue
+		}
+		if n == 1 {
+			columns[i] = importColumn{
+				program: program,
+			}
+			continue
+		}
+		var acumulative *models.Acumulative
+		for j := range program.Acumulative {
+			if program.Acumulative[j].Number == acumulativeNumber {
+				acumulative = &program.Acumulative[j]
+				break
+			}
+		}
+		if acumulative == nil {
+			columnErrors = append(columnErrors, ImportGradeError{
+				Row:    1,
+				Column: column,
+				Message: fmt.Sprintf(
+					"no existe la acumulativa N%d° en la calificación N%d°",
+					acumulativeNumber,
+					number,
+				),
+			})
+			continue
+		}
+		columns[i] = importColumn{
+			program:     program,
+			acumulative: acumulative,
+		}
+	}
+	// With a column per acumulative grade, the column of the
+	// program is its weighted grade
+	for i, column := range columns {
+		if column.acumulative != nil {
+			continue
+		}
+		for _, other := range columns {
+			if other.acumulative != nil && other.program.ID == column.program.ID {
+				delete(columns, i)
+				break
+			}
+		}
+	}
+	return columns, rutColumn, columnErrors
+}
+
+// An acumulative cell is "grade (percentage%) - grade (percentage%) - ...",
+// in the order of the acumulative grades of the program. The percentage is
+// optional and skips the acumulative grades that don't match with it
+func (g *GradesService) parseAcumulativeCell(
+	value string,
+	program *models.GradesProgram,
+) (map[primitive.ObjectID]float64, error) {
+	grades := make(map[primitive.ObjectID]float64)
+
+	k := 0
+	for _, part := range strings.Split(value, "-") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		gradeValue, percentageValue, hasPercentage := strings.Cut(part, "(")
+		grade, err := parseImportedGrade(gradeValue)
+		if err != nil {
+			return nil, err
+		}
+		if hasPercentage {
+			percentageValue = strings.TrimSpace(strings.TrimSuffix(
+				strings.TrimSpace(percentageValue),
+				")",
+			))
+			percentage, err := strconv.ParseFloat(strings.TrimSuffix(percentageValue, "%"), 32)
+			if err != nil {
+				return nil, fmt.Errorf("%s no es un porcentaje válido", percentageValue)
+			}
+			for k < len(program.Acumulative) && program.Acumulative[k].Percentage != float32(percentage) {
+				k++
+			}
+		}
+		if k >= len(program.Acumulative) {
+			return nil, fmt.Errorf(
+				"%s no coincide con las acumulativas de la calificación N%d°",
+				part,
+				program.Number,
+			)
+		}
+		grades[program.Acumulative[k].ID] = grade
+		k++
+	}
+	return grades, nil
+}
+
+func (g *GradesService) getModuleGrades(idObjModule primitive.ObjectID) (map[string]*models.Grade, error) {
+	var grades []models.Grade
+
+	cursor, err := gradeModel.GetAll(bson.D{{
+		Key:   "module",
+		Value: idObjModule,
+	}}, &options.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &grades); err != nil {
+		return nil, err
+	}
+	gradesMap := make(map[string]*models.Grade)
+	for i, grade := range grades {
+		gradesMap[gradeKey(grade.Student, grade.Program, grade.Acumulative)] = &grades[i]
+	}
+	return gradesMap, nil
+}
+
+// Insert and update the grades in a transaction with its history,
+// if something fails nothing is imported
+func (g *GradesService) commitImportedGrades(
+	grades []importedGrade,
+	idObjModule,
+	idObjUser primitive.ObjectID,
+) error {
+	_, err := models.DbConnect.WithTransaction(func(sessCtx mongo.SessionContext) (interface{}, error) {
+		var inserts []importedGrade
+		var modelsGrades []interface{}
+		var history []interface{}
+
+		for _, grade := range grades {
+			if grade.old == nil {
+				modelGrade := models.NewModelGrade(
+					idObjModule,
+					grade.student,
+					grade.acumulative,
+					grade.program.ID,
+					idObjUser,
+					grade.grade,
+					grade.program.IsAcumulative,
+				)
+				inserts = append(inserts, grade)
+				modelsGrades = append(modelsGrades, modelGrade)
+				continue
+			}
+			_, err := gradeModel.Use().UpdateByID(sessCtx, grade.old.ID, bson.D{{
+				Key: "$set",
+				Value: bson.M{
+					"grade": grade.grade,
+				},
+			}})
+			if err != nil {
+				return nil, err
+			}
+			history = append(history, models.NewModelGradeHistory(
+				grade.old.ID,
+				models.GRADES_COLLECTION,
+				idObjModule,
+				grade.student,
+				&grade.old.Grade,
+				grade.grade,
+				idObjUser,
+				models.GRADE_SOURCE_MANUAL,
+				IMPORT_GRADES_REASON,
+			))
+		}
+		if len(modelsGrades) > 0 {
+			inserted, err := gradeModel.Use().InsertMany(sessCtx, modelsGrades)
+			if err != nil {
+				return nil, err
+			}
+			for i, grade := range inserts {
+				history = append(history, models.NewModelGradeHistory(
+					inserted.InsertedIDs[i].(primitive.ObjectID),
+					models.GRADES_COLLECTION,
+					idObjModule,
+					grade.student,
+					nil,
+					grade.grade,
+					idObjUser,
+					models.GRADE_SOURCE_MANUAL,
+					IMPORT_GRADES_REASON,
+				))
+			}
+		}
+		if len(history) > 0 {
+			if _, err := gradeHistoryModel.Use().InsertMany(sessCtx, history); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	return err
+}
+
+// Import the grades of a file with the layout of ExportGrades, students are
+// matched by rut. All the rows are validated before importing them, with dry
+// run or any error nothing is imported and only the report is returned
+func (g *GradesService) ImportGrades(
+	file *multipart.FileHeader,
+	idModule,
+	idProgram,
+	idUser string,
+	dryRun bool,
+) (*ImportGradesRes, *res.ErrorRes) {
+	idObjModule, err := primitive.ObjectIDFromHex(idModule)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjUser, err := primitive.ObjectIDFromHex(idUser)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjProgram := primitive.NilObjectID
+	if idProgram != "" {
+		idObjProgram, err = primitive.ObjectIDFromHex(idProgram)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+	}
+	// Read file
+	rows, err := g.readGradesFile(file)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	if len(rows) < 2 {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("el archivo no tiene calificaciones"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get module
+	module, err := moduleService.GetModuleFromID(idModule)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	scale, err := getGradingScale(module.ID)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	// Get programs
+	programs, errRes := g.GetGradePrograms(idModule)
+	if errRes != nil {
+		return nil, errRes
+	}
+	if len(programs) == 0 {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("el módulo no tiene calificaciones programadas"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get students
+	students, err := workService.getStudentsFromIdModule(idModule)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	studentsRut := make(map[string]primitive.ObjectID)
+	for _, student := range students {
+		idObjStudent, err := primitive.ObjectIDFromHex(student.User.ID)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		studentsRut[normalizeRut(student.User.Rut)] = idObjStudent
+	}
+	// Get grades
+	moduleGrades, err := g.getModuleGrades(idObjModule)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	// Validate rows
+	columns, rutColumn, columnErrors := g.getImportColumns(rows[0], programs, idObjProgram)
+	rutColumnName := "A"
+	if rutColumn > 0 {
+		rutColumnName, _ = excelize.ColumnNumberToName(rutColumn + 1)
+	}
+	report := &ImportGradesRes{
+		Errors: columnErrors,
+	}
+	importedRuts := make(map[string]int)
+	var grades []importedGrade
+	for r := 1; r < len(rows); r++ {
+		row := rows[r]
+		if len(row) == 0 || strings.TrimSpace(strings.Join(row, "")) == "" {
+			continue
+		}
+		report.Rows++
+		nRow := r + 1
+		// Student
+		var rut string
+		if rutColumn >= 0 && rutColumn < len(row) {
+			rut = normalizeRut(row[rutColumn])
+		} else if rutColumn < 0 {
+			rut = rutFromCell(row[0])
+		}
+		idObjStudent, exists := studentsRut[rut]
+		if !exists {
+			report.Errors = append(report.Errors, ImportGradeError{
+				Row:     nRow,
+				Column:  rutColumnName,
+				Rut:     rut,
+				Message: "el estudiante no pertenece al módulo",
+			})
+			continue
+		}
+		if firstRow, imported := importedRuts[rut]; imported {
+			report.Errors = append(report.Errors, ImportGradeError{
+				Row:     nRow,
+				Column:  rutColumnName,
+				Rut:     rut,
+				Message: fmt.Sprintf("el estudiante ya está en la fila %d", firstRow),
+			})
+			continue
+		}
+		importedRuts[rut] = nRow
+		// Grades
+		for i := 0; i < len(row); i++ {
+			importColumn, exists := columns[i]
+			value := strings.TrimSpace(row[i])
+			if !exists || value == "" {
+				continue
+			}
+			program := importColumn.program
+			column, _ := excelize.ColumnNumberToName(i + 1)
+			rowGrades := make(map[primitive.ObjectID]float64)
+			if importColumn.acumulative != nil {
+				rowGrades[importColumn.acumulative.ID], err = parseImportedGrade(value)
+			} else if program.IsAcumulative {
+				rowGrades, err = g.parseAcumulativeCell(value, program)
+			} else {
+				rowGrades[primitive.NilObjectID], err = parseImportedGrade(value)
+			}
+			if err != nil {
+				report.Errors = append(report.Errors, ImportGradeError{
+					Row:     nRow,
+					Column:  column,
+					Rut:     rut,
+					Message: err.Error(),
+				})
+				continue
+			}
+			for idAcumulative, grade := range rowGrades {
+				if grade < scale.Min() || grade > scale.Max() {
+					report.Errors = append(report.Errors, ImportGradeError{
+						Row:     nRow,
+						Column:  column,
+						Rut:     rut,
+						Message: fmt.Sprintf("calificación inválida. Mín: %v. Máx: %v", scale.Min(), scale.Max()),
+					})
+					continue
+				}
+				old := moduleGrades[gradeKey(idObjStudent, program.ID, idAcumulative)]
+				if old != nil && old.Grade == grade {
+					report.Unchanged++
+					continue
+				}
+				if old == nil {
+					report.Inserted++
+				} else {
+					report.Updated++
+				}
+				grades = append(grades, importedGrade{
+					student:     idObjStudent,
+					program:     program,
+					acumulative: idAcumulative,
+					grade:       grade,
+					old:         old,
+				})
+			}
+		}
+	}
+	if dryRun || len(report.Errors) > 0 || len(grades) == 0 {
+		return report, nil
+	}
+	// Import
+	if err := g.commitImportedGrades(grades, idObjModule, idObjUser); err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	report.Committed = true
+	// Send notifications, one for each student
+	var notified []primitive.ObjectID
+	titles := make(map[primitive.ObjectID][]string)
+	for _, grade := range grades {
+		title := fmt.Sprintf("Calificación N%d° subida", grade.program.Number)
+		if grade.old != nil {
+			if !isGradeReleased(grade.old.Draft, grade.old.ReleaseDate) {
+				continue
+			}
+			title = fmt.Sprintf("Calificación N%d° actualizada", grade.program.Number)
+		}
+		if _, exists := titles[grade.student]; !exists {
+			notified = append(notified, grade.student)
+		}
+		titles[grade.student] = append(titles[grade.student], title)
+	}
+	for _, idObjStudent := range notified {
+		title := titles[idObjStudent][0]
+		if len(titles[idObjStudent]) > 1 {
+			title = fmt.Sprintf("%d calificaciones subidas o actualizadas", len(titles[idObjStudent]))
+		}
+		nats.PublishEncode("notify/classroom", res.NotifyClassroom{
+			Title: title,
+			Link: fmt.Sprintf(
+				"/aula_virtual/clase/%s/calificaciones",
+				idModule,
+			),
+			Where:  module.Subject.Hex(),
+			Room:   module.Section.Hex(),
+			Type:   res.GRADE,
+			IDUser: idObjStudent.Hex(),
+		})
+	}
+	return report, nil
+}
@@ -0,0 +1,255 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestParseImportedGrade(t *testing.T) {
+	tests := []struct {
+		value string
+		grade float64
+		err   bool
+	}{
+		{"5.5", 5.5, false},
+		{"5,5", 5.5, false},
+		{" 6 ", 6, false},
+		{"7.0", 7, false},
+		{"", 0, true},
+		{"A", 0, true},
+		{"5.5.5", 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			grade, err := parseImportedGrade(test.value)
+			if (err != nil) != test.err {
+				t.Fatalf("parseImportedGrade(%q) error = %v, want error %v", test.value, err, test.err)
+			}
+			if grade != test.grade {
+				t.Errorf("parseImportedGrade(%q) = %v, want %v", test.value, grade, test.grade)
+			}
+		})
+	}
+}
+
+func TestRutFromCell(t *testing.T) {
+	tests := []struct {
+		cell string
+		rut  string
+	}{
+		{"Juan Pérez 12.345.678-k", "12345678-K"},
+		{"  Ana  Soto   9876543-2 ", "9876543-2"},
+		{"12345678-9", "12345678-9"},
+		{"", ""},
+		{"   ", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.cell, func(t *testing.T) {
+			if rut := rutFromCell(test.cell); rut != test.rut {
+				t.Errorf("rutFromCell(%q) = %q, want %q", test.cell, rut, test.rut)
+			}
+		})
+	}
+}
+
+func newImportProgram(number int, percentages ...float32) models.GradesProgram {
+	program := models.GradesProgram{
+		ID:            primitive.NewObjectID(),
+		Number:        number,
+		IsAcumulative: len(percentages) > 0,
+	}
+	for i, percentage := range percentages {
+		program.Acumulative = append(program.Acumulative, models.Acumulative{
+			ID:         primitive.NewObjectID(),
+			Number:     i + 1,
+			Percentage: percentage,
+		})
+	}
+	return program
+}
+
+func TestParseAcumulativeCell(t *testing.T) {
+	program := newImportProgram(2, 20, 30, 50)
+	acumulatives := program.Acumulative
+
+	tests := []struct {
+		name   string
+		value  string
+		grades map[primitive.ObjectID]float64
+		err    bool
+	}{
+		{
+			name:  "in order",
+			value: "5.0 - 6,5 - 7",
+			grades: map[primitive.ObjectID]float64{
+				acumulatives[0].ID: 5,
+				acumulatives[1].ID: 6.5,
+				acumulatives[2].ID: 7,
+			},
+		},
+		{
+			name:  "as exported",
+			value: "5.0 (20%) - 6.5 (30%) - 7.0 (50%)",
+			grades: map[primitive.ObjectID]float64{
+				acumulatives[0].ID: 5,
+				acumulatives[1].ID: 6.5,
+				acumulatives[2].ID: 7,
+			},
+		},
+		{
+			name:  "percentage skips acumulatives",
+			value: "6.0 (50%)",
+			grades: map[primitive.ObjectID]float64{
+				acumulatives[2].ID: 6,
+			},
+		},
+		{
+			name:  "less grades than acumulatives",
+			value: "4.5",
+			grades: map[primitive.ObjectID]float64{
+				acumulatives[0].ID: 4.5,
+			},
+		},
+		{
+			name:   "empty",
+			value:  "",
+			grades: map[primitive.ObjectID]float64{},
+		},
+		{
+			name:  "more grades than acumulatives",
+			value: "5 - 5 - 5 - 5",
+			err:   true,
+		},
+		{
+			name:  "percentage of no acumulative",
+			value: "5.0 (25%)",
+			err:   true,
+		},
+		{
+			name:  "percentage out of order",
+			value: "5.0 (50%) - 6.0 (20%)",
+			err:   true,
+		},
+		{
+			name:  "invalid percentage",
+			value: "5.0 (a%)",
+			err:   true,
+		},
+		{
+			name:  "invalid grade",
+			value: "5.0 - A",
+			err:   true,
+		},
+	}
+
+	g := &GradesService{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			grades, err := g.parseAcumulativeCell(test.value, &program)
+			if (err != nil) != test.err {
+				t.Fatalf("parseAcumulativeCell(%q) error = %v, want error %v", test.value, err, test.err)
+			}
+			if test.err {
+				return
+			}
+			if !reflect.DeepEqual(grades, test.grades) {
+				t.Errorf("parseAcumulativeCell(%q) = %v, want %v", test.value, grades, test.grades)
+			}
+		})
+	}
+}
+
+func TestGetImportColumns(t *testing.T) {
+	programs := []models.GradesProgram{
+		newImportProgram(1),
+		newImportProgram(2, 40, 60),
+	}
+
+	type column struct {
+		program     int
+		acumulative int
+	}
+	tests := []struct {
+		name      string
+		header    []string
+		program   primitive.ObjectID
+		columns   map[int]column
+		rutColumn int
+		errors    int
+	}{
+		{
+			name:   "as exported",
+			header: []string{"Estudiante", "1 (50%)", "2 (50%)", "Promedio"},
+			columns: map[int]column{
+				1: {program: 1},
+				2: {program: 2},
+			},
+			rutColumn: -1,
+		},
+		{
+			name:   "rut column",
+			header: []string{"RUT", "1"},
+			columns: map[int]column{
+				1: {program: 1},
+			},
+			rutColumn: 0,
+		},
+		{
+			name:   "acumulative columns replace the program column",
+			header: []string{"Estudiante", "2 (50%)", "2.1 (40%)", "2.2 (60%)"},
+			columns: map[int]column{
+				2: {program: 2, acumulative: 1},
+				3: {program: 2, acumulative: 2},
+			},
+			rutColumn: -1,
+		},
+		{
+			name:    "only the columns of the program",
+			header:  []string{"Estudiante", "1", "2"},
+			program: programs[1].ID,
+			columns: map[int]column{
+				2: {program: 2},
+			},
+			rutColumn: -1,
+		},
+		{
+			name:      "invalid columns",
+			header:    []string{"Estudiante", "Notas", "3", "2.5"},
+			columns:   map[int]column{},
+			rutColumn: -1,
+			errors:    3,
+		},
+	}
+
+	g := &GradesService{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			columns, rutColumn, columnErrors := g.getImportColumns(test.header, programs, test.program)
+			if rutColumn != test.rutColumn {
+				t.Errorf("rut column = %d, want %d", rutColumn, test.rutColumn)
+			}
+			if len(columnErrors) != test.errors {
+				t.Errorf("errors = %v, want %d errors", columnErrors, test.errors)
+			}
+			got := make(map[int]column)
+			for i, c := range columns {
+				var acumulative int
+				if c.acumulative != nil {
+					acumulative = c.acumulative.Number
+				}
+				got[i] = column{
+					program:     c.program.Number,
+					acumulative: acumulative,
+				}
+			}
+			if !reflect.DeepEqual(got, test.columns) {
+				t.Errorf("columns = %v, want %v", got, test.columns)
+			}
+		})
+	}
+}
@@ -0,0 +1,435 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	natsPackage "github.com/nats-io/nats.go"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Draft grades are hidden to students and attorneys until they are released,
+// a scheduled grade is visible from its release date even if the scheduler
+// has not released it yet
+func releasedFilter() bson.M {
+	return bson.M{
+		"$or": bson.A{
+			bson.M{
+				"draft": bson.M{
+					"$ne": true,
+				},
+			},
+			bson.M{
+				"release_date": bson.M{
+					"$lte": primitive.NewDateTimeFromTime(time.Now()),
+				},
+			},
+		},
+	}
+}
+
+func isGradeReleased(draft bool, releaseDate primitive.DateTime) bool {
+	if !draft {
+		return true
+	}
+	return releaseDate != 0 && !releaseDate.Time().After(time.Now())
+}
+
+func parseReleaseDate(form *forms.ReleaseGradesForm) (*time.Time, error) {
+	if form.Date == "" {
+		return nil, nil
+	}
+	date, err := time.Parse("2006-01-02 15:04", form.Date)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
+
+// Release the draft grades of the filter. With a future date the release is
+// scheduled, returns true if some grade was released now
+func releaseGrades(
+	collection models.Collection,
+	filter bson.D,
+	date *time.Time,
+	payload ReleaseGrades,
+) (bool, error) {
+	filter = append(filter, bson.E{
+		Key:   "draft",
+		Value: true,
+	})
+	if date != nil && date.After(time.Now()) {
+		_, err := collection.Use().UpdateMany(db.Ctx, filter, bson.D{{
+			Key: "$set",
+			Value: bson.M{
+				"release_date": primitive.NewDateTimeFromTime(*date),
+			},
+		}})
+		if err != nil {
+			return false, err
+		}
+		payload.Diff = time.Until(*date).Hours()
+		return false, nats.PublishEncode("schedule_release_grades", &payload)
+	}
+	result, err := collection.Use().UpdateMany(db.Ctx, filter, bson.D{{
+		Key: "$unset",
+		Value: bson.M{
+			"draft":        "",
+			"release_date": "",
+		},
+	}})
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
+
+// Release only the grades whose release date has passed, a release
+// rescheduled to a later date keeps waiting its own message
+func releaseDueGrades(collection models.Collection, filter bson.D) (bool, error) {
+	filter = append(filter, bson.E{
+		Key: "release_date",
+		Value: bson.M{
+			"$lte": primitive.NewDateTimeFromTime(time.Now()),
+		},
+	})
+	return releaseGrades(collection, filter, nil, ReleaseGrades{})
+}
+
+// A release date passed and not released yet by the scheduler is released
+// when the grades of the module are read, and notified only once
+func (g *GradesService) releaseDueModuleGrades(idObjModule primitive.ObjectID) error {
+	idsPrograms, err := gradeModel.Use().Distinct(db.Ctx, "program", bson.D{
+		{
+			Key:   "module",
+			Value: idObjModule,
+		},
+		{
+			Key:   "draft",
+			Value: true,
+		},
+		{
+			Key: "release_date",
+			Value: bson.M{
+				"$lte": primitive.NewDateTimeFromTime(time.Now()),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	for _, idProgram := range idsPrograms {
+		idObjProgram, ok := idProgram.(primitive.ObjectID)
+		if !ok {
+			continue
+		}
+		program, err := g.getProgramGradeById(idObjProgram)
+		if err != nil {
+			return err
+		}
+		released, err := releaseDueGrades(gradeModel, bson.D{
+			{
+				Key:   "module",
+				Value: idObjModule,
+			},
+			{
+				Key:   "program",
+				Value: idObjProgram,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if released {
+			if err := g.notifyReleasedProgram(idObjModule.Hex(), program); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (g *GradesService) getProgramFilter(
+	idObjModule primitive.ObjectID,
+	program *models.GradesProgram,
+	idAcumulative string,
+) (bson.D, error) {
+	filter := bson.D{
+		{
+			Key:   "module",
+			Value: idObjModule,
+		},
+		{
+			Key:   "program",
+			Value: program.ID,
+		},
+	}
+	if program.IsAcumulative && idAcumulative != "" {
+		idObjAcumulative, err := primitive.ObjectIDFromHex(idAcumulative)
+		if err != nil {
+			return nil, err
+		}
+		filter = append(filter, bson.E{
+			Key:   "acumulative",
+			Value: idObjAcumulative,
+		})
+	}
+	return filter, nil
+}
+
+func (g *GradesService) notifyReleasedProgram(idModule string, program *models.GradesProgram) error {
+	module, err := moduleService.GetModuleFromID(idModule)
+	if err != nil {
+		return err
+	}
+	nats.PublishEncode("notify/classroom", res.NotifyClassroom{
+		Title: fmt.Sprintf("Calificación N%d° publicada", program.Number),
+		Link: fmt.Sprintf(
+			"/aula_virtual/clase/%s/calificaciones",
+			idModule,
+		),
+		Where: module.Subject.Hex(),
+		Room:  module.Section.Hex(),
+		Type:  res.GRADE,
+	})
+	return nil
+}
+
+func (g *GradesService) ReleaseProgram(
+	form *forms.ReleaseGradesForm,
+	idModule,
+	idProgram string,
+) *res.ErrorRes {
+	idObjModule, err := primitive.ObjectIDFromHex(idModule)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjProgram, err := primitive.ObjectIDFromHex(idProgram)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	date, err := parseReleaseDate(form)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get program
+	program, err := g.getProgramGradeById(idObjProgram)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if program.Module != idObjModule {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("la calificación no pertenece a este módulo"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	filter, err := g.getProgramFilter(idObjModule, program, form.Acumulative)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Release
+	released, err := releaseGrades(gradeModel, filter, date, ReleaseGrades{
+		Module:      idModule,
+		Program:     idProgram,
+		Acumulative: form.Acumulative,
+	})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if released {
+		if err := g.notifyReleasedProgram(idModule, program); err != nil {
+			return &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+	}
+	return nil
+}
+
+// Filter of the grades of a work, qualified works are graded in the
+// grades of its program and the others in the work grades
+func (w *WorkSerice) getWorkGradesFilter(work *models.Work) (models.Collection, bson.D) {
+	if work.IsQualified {
+		filter := bson.D{
+			{
+				Key:   "module",
+				Value: work.Module,
+			},
+			{
+				Key:   "program",
+				Value: work.Grade,
+			},
+		}
+		if !work.Acumulative.IsZero() {
+			filter = append(filter, bson.E{
+				Key:   "acumulative",
+				Value: work.Acumulative,
+			})
+		}
+		return gradeModel, filter
+	}
+	return workGradeModel, bson.D{{
+		Key:   "work",
+		Value: work.ID,
+	}}
+}
+
+func (w *WorkSerice) notifyReleasedWork(work *models.Work) error {
+	module, err := moduleService.GetModuleFromID(work.Module.Hex())
+	if err != nil {
+		return err
+	}
+	nats.PublishEncode("notify/classroom", res.NotifyClassroom{
+		Title: fmt.Sprintf("Trabajo evaluado %v", work.Title),
+		Link:  w.getWorkLink(work),
+		Where: module.Subject.Hex(),
+		Room:  module.Section.Hex(),
+		Type:  res.GRADE,
+	})
+	return w.publishFeedback(work)
+}
+
+// As the grades of a module, the due grades of the work are released
+// when the work is read
+func (w *WorkSerice) releaseDueWorkGrades(idObjWork primitive.ObjectID) error {
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return err
+	}
+	if !work.IsRevised {
+		return nil
+	}
+	released, err := releaseDueGrades(w.getWorkGradesFilter(work))
+	if err != nil {
+		return err
+	}
+	if released {
+		return w.notifyReleasedWork(work)
+	}
+	return nil
+}
+
+func (w *WorkSerice) ReleaseWorkGrades(form *forms.ReleaseGradesForm, idWork string) *res.ErrorRes {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	date, err := parseReleaseDate(form)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get work
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if !work.IsRevised {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("este trabajo todavía no está evaluado"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Release
+	collection, filter := w.getWorkGradesFilter(work)
+	released, err := releaseGrades(collection, filter, date, ReleaseGrades{
+		Work: idWork,
+	})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if released {
+		if err := w.notifyReleasedWork(work); err != nil {
+			return &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+	}
+	return nil
+}
+
+// Message of the scheduler once the release date of the grades is reached
+func releaseScheduledGrades() {
+	nats.Queue("release_grades", func(m *natsPackage.Msg) {
+		var payload ReleaseGrades
+		if err := nats.ExtractPayload(m.Data, &payload); err != nil {
+			return
+		}
+		if payload.Work != "" {
+			idObjWork, err := primitive.ObjectIDFromHex(payload.Work)
+			if err != nil {
+				return
+			}
+			workService.releaseDueWorkGrades(idObjWork)
+			return
+		}
+		idObjModule, err := primitive.ObjectIDFromHex(payload.Module)
+		if err != nil {
+			return
+		}
+		idObjProgram, err := primitive.ObjectIDFromHex(payload.Program)
+		if err != nil {
+			return
+		}
+		program, err := gradesService.getProgramGradeById(idObjProgram)
+		if err != nil {
+			return
+		}
+		filter, err := gradesService.getProgramFilter(idObjModule, program, payload.Acumulative)
+		if err != nil {
+			return
+		}
+		released, err := releaseDueGrades(gradeModel, filter)
+		if err != nil || !released {
+			return
+		}
+		gradesService.notifyReleasedProgram(payload.Module, program)
+	})
+}
+
+// Without grade or with a draft grade the student is not notified
+// of its re-evaluation
+func (w *WorkSerice) isStudentGradeReleased(work *models.Work, idObjStudent primitive.ObjectID) (bool, error) {
+	grade, err := w.getGradeStudent(work, idObjStudent)
+	if err != nil {
+		return false, err
+	}
+	return grade != nil && isGradeReleased(grade.Draft, grade.ReleaseDate), nil
+}
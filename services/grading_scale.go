@@ -0,0 +1,368 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var gradingScaleService *GradingScaleService
+
+type GradingScaleService struct{}
+
+// Transforms the points of a student into a grade
+type GradingScale interface {
+	Grade(points, maxPoints int) float64
+	Min() float64
+	Max() float64
+	PassGrade() float64
+}
+
+type linearScale struct {
+	min float64
+	max float64
+}
+
+func (s *linearScale) Grade(points, maxPoints int) float64 {
+	if points <= 0 || maxPoints <= 0 {
+		return s.min
+	}
+	ratio := math.Min(float64(points)/float64(maxPoints), 1)
+	return roundGrade(s, s.min+(s.max-s.min)*ratio)
+}
+
+func (s *linearScale) Min() float64 {
+	return s.min
+}
+
+func (s *linearScale) Max() float64 {
+	return s.max
+}
+
+// The pass grade of the settings, without it (or out of the scale)
+// it is the middle of the scale
+func (s *linearScale) PassGrade() float64 {
+	if settingsData.PASS_GRADE > s.min && settingsData.PASS_GRADE < s.max {
+		return settingsData.PASS_GRADE
+	}
+	return s.min + (s.max-s.min)/2
+}
+
+// Two slopes, below the threshold (percentage) the grade goes from min to
+// passGrade and over it from passGrade to max
+type thresholdScale struct {
+	linearScale
+	threshold float64
+	passGrade float64
+}
+
+func (s *thresholdScale) Grade(points, maxPoints int) float64 {
+	if points <= 0 || maxPoints <= 0 {
+		return s.min
+	}
+	percentage := math.Min(float64(points)/float64(maxPoints), 1) * 100
+	var grade float64
+	if percentage < s.threshold {
+		grade = s.min + (s.passGrade-s.min)*percentage/s.threshold
+	} else {
+		grade = s.passGrade + (s.max-s.passGrade)*(percentage-s.threshold)/(100-s.threshold)
+	}
+	return roundGrade(s, grade)
+}
+
+func (s *thresholdScale) PassGrade() float64 {
+	return s.passGrade
+}
+
+// Ranges sorted by from (percentage), the grade is the one of the last
+// range reached
+type tableScale struct {
+	linearScale
+	table []models.GradingScaleRange
+}
+
+func (s *tableScale) Grade(points, maxPoints int) float64 {
+	if maxPoints <= 0 {
+		return s.min
+	}
+	percentage := float64(points) / float64(maxPoints) * 100
+	grade := s.min
+	for _, r := range s.table {
+		if percentage < r.From {
+			break
+		}
+		grade = r.Grade
+	}
+	return roundGrade(s, grade)
+}
+
+func roundGrade(scale GradingScale, grade float64) float64 {
+	grade = math.Max(math.Min(grade, scale.Max()), scale.Min())
+	return math.Round(grade*10) / 10
+}
+
+func newGradingScale(scale *models.GradingScale) GradingScale {
+	linear := linearScale{
+		min: scale.Min,
+		max: scale.Max,
+	}
+	switch scale.Type {
+	case "threshold":
+		return &thresholdScale{
+			linearScale: linear,
+			threshold:   scale.Threshold,
+			passGrade:   scale.PassGrade,
+		}
+	case "table":
+		return &tableScale{
+			linearScale: linear,
+			table:       scale.Table,
+		}
+	}
+	return &linear
+}
+
+func (g *GradingScaleService) getScaleFromFilter(filter bson.D) (*models.GradingScale, error) {
+	var scale *models.GradingScale
+	cursor := gradingScalesModel.GetOne(filter)
+	if err := cursor.Decode(&scale); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return scale, nil
+}
+
+// Scale of the module, else the one of its semester, else the linear
+// scale between the min and max grades of the system
+func (g *GradingScaleService) getModuleScale(module *models.Module) (*models.GradingScale, error) {
+	scale, err := g.getScaleFromFilter(bson.D{{
+		Key:   "module",
+		Value: module.ID,
+	}})
+	if err != nil || scale != nil {
+		return scale, err
+	}
+	if !module.Semester.IsZero() {
+		scale, err = g.getScaleFromFilter(bson.D{{
+			Key:   "semester",
+			Value: module.Semester,
+		}})
+		if err != nil || scale != nil {
+			return scale, err
+		}
+	}
+	min, max, err := GetMinNMaxGrade()
+	if err != nil {
+		return nil, err
+	}
+	return &models.GradingScale{
+		Type: "linear",
+		Min:  float64(min),
+		Max:  float64(max),
+	}, nil
+}
+
+func getGradingScale(idObjModule primitive.ObjectID) (GradingScale, error) {
+	module, err := moduleService.GetModuleFromID(idObjModule.Hex())
+	if err != nil {
+		return nil, err
+	}
+	scale, err := gradingScaleService.getModuleScale(module)
+	if err != nil {
+		return nil, err
+	}
+	return newGradingScale(scale), nil
+}
+
+func (g *GradingScaleService) checkScale(scale *models.GradingScale) error {
+	if scale.Min >= scale.Max {
+		return fmt.Errorf("la calificación mínima debe ser menor a la máxima")
+	}
+	if scale.Type == "threshold" && (scale.PassGrade <= scale.Min || scale.PassGrade >= scale.Max) {
+		return fmt.Errorf("la calificación de aprobación debe estar entre %v y %v", scale.Min, scale.Max)
+	}
+	if scale.Type == "table" {
+		if scale.Table[0].From != 0 {
+			return fmt.Errorf("la tabla debe comenzar desde 0%%")
+		}
+		for i, r := range scale.Table {
+			if r.Grade < scale.Min || r.Grade > scale.Max {
+				return fmt.Errorf("las calificaciones de la tabla deben estar entre %v y %v", scale.Min, scale.Max)
+			}
+			if i > 0 && r.From == scale.Table[i-1].From {
+				return fmt.Errorf("la tabla no puede repetir porcentajes")
+			}
+			if i > 0 && r.Grade < scale.Table[i-1].Grade {
+				return fmt.Errorf("las calificaciones de la tabla deben crecer con el porcentaje")
+			}
+		}
+	}
+	return nil
+}
+
+func (g *GradingScaleService) uploadScale(scale *models.GradingScale, filter bson.D) *res.ErrorRes {
+	if err := g.checkScale(scale); err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	opts := options.Replace().SetUpsert(true)
+	_, err := gradingScalesModel.Use().ReplaceOne(db.Ctx, filter, scale, opts)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return nil
+}
+
+func (g *GradingScaleService) GetGradingScale(idModule string) (*models.GradingScale, *res.ErrorRes) {
+	module, err := moduleService.GetModuleFromID(idModule)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, &res.ErrorRes{
+				Err:        fmt.Errorf("no existe el módulo"),
+				StatusCode: http.StatusNotFound,
+			}
+		}
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	scale, err := g.getModuleScale(module)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return scale, nil
+}
+
+func (g *GradingScaleService) UploadModuleScale(
+	scale *forms.GradingScaleForm,
+	idModule,
+	idUser string,
+) *res.ErrorRes {
+	idObjModule, err := primitive.ObjectIDFromHex(idModule)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjUser, err := primitive.ObjectIDFromHex(idUser)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	modelScale := models.NewModelGradingScale(
+		scale,
+		idObjModule,
+		primitive.NilObjectID,
+		idObjUser,
+	)
+	return g.uploadScale(&modelScale, bson.D{{
+		Key:   "module",
+		Value: idObjModule,
+	}})
+}
+
+func (g *GradingScaleService) UploadSemesterScale(
+	scale *forms.GradingScaleForm,
+	idUser string,
+) *res.ErrorRes {
+	idObjUser, err := primitive.ObjectIDFromHex(idUser)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	semester, err := getCurrentSemester()
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	modelScale := models.NewModelGradingScale(
+		scale,
+		primitive.NilObjectID,
+		semester.ID,
+		idObjUser,
+	)
+	return g.uploadScale(&modelScale, bson.D{{
+		Key:   "semester",
+		Value: semester.ID,
+	}})
+}
+
+func (g *GradingScaleService) deleteScale(filter bson.D) *res.ErrorRes {
+	result, err := gradingScalesModel.Use().DeleteOne(db.Ctx, filter)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if result.DeletedCount == 0 {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("no existe una escala de calificación configurada"),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	return nil
+}
+
+func (g *GradingScaleService) DeleteModuleScale(idModule string) *res.ErrorRes {
+	idObjModule, err := primitive.ObjectIDFromHex(idModule)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	return g.deleteScale(bson.D{{
+		Key:   "module",
+		Value: idObjModule,
+	}})
+}
+
+func (g *GradingScaleService) DeleteSemesterScale() *res.ErrorRes {
+	semester, err := getCurrentSemester()
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return g.deleteScale(bson.D{{
+		Key:   "semester",
+		Value: semester.ID,
+	}})
+}
+
+func NewGradingScaleService() *GradingScaleService {
+	if gradingScaleService == nil {
+		gradingScaleService = &GradingScaleService{}
+	}
+	return gradingScaleService
+}
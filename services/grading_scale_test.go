@@ -0,0 +1,143 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+)
+
+func TestLinearScaleGrade(t *testing.T) {
+	scale := &linearScale{min: 1, max: 7}
+
+	tests := []struct {
+		name      string
+		points    int
+		maxPoints int
+		grade     float64
+	}{
+		{"without points", 0, 20, 1},
+		{"negative points", -5, 20, 1},
+		{"without max points", 10, 0, 1},
+		{"half", 10, 20, 4},
+		{"all", 20, 20, 7},
+		{"over max points", 25, 20, 7},
+		{"rounded to one decimal", 1, 3, 3},
+		{"rounded up", 7, 20, 3.1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			grade := scale.Grade(test.points, test.maxPoints)
+			if grade != test.grade {
+				t.Errorf("Grade(%d, %d) = %v, want %v", test.points, test.maxPoints, grade, test.grade)
+			}
+		})
+	}
+}
+
+func TestLinearScalePassGrade(t *testing.T) {
+	passGrade := settingsData.PASS_GRADE
+	defer func() {
+		settingsData.PASS_GRADE = passGrade
+	}()
+
+	tests := []struct {
+		name         string
+		settingsPass float64
+		passGrade    float64
+	}{
+		{"from the settings", 4, 4},
+		{"without settings", 0, 4},
+		{"settings over the scale", 8, 4},
+		{"settings in the min of the scale", 1, 4},
+	}
+
+	scale := &linearScale{min: 1, max: 7}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			settingsData.PASS_GRADE = test.settingsPass
+			if passGrade := scale.PassGrade(); passGrade != test.passGrade {
+				t.Errorf("PassGrade() = %v, want %v", passGrade, test.passGrade)
+			}
+		})
+	}
+}
+
+func TestThresholdScaleGrade(t *testing.T) {
+	scale := &thresholdScale{
+		linearScale: linearScale{min: 1, max: 7},
+		threshold:   60,
+		passGrade:   4,
+	}
+
+	tests := []struct {
+		name      string
+		points    int
+		maxPoints int
+		grade     float64
+	}{
+		{"without points", 0, 100, 1},
+		{"without max points", 10, 0, 1},
+		{"half of the threshold", 30, 100, 2.5},
+		{"threshold", 60, 100, 4},
+		{"over the threshold", 80, 100, 5.5},
+		{"all", 100, 100, 7},
+		{"over max points", 120, 100, 7},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			grade := scale.Grade(test.points, test.maxPoints)
+			if grade != test.grade {
+				t.Errorf("Grade(%d, %d) = %v, want %v", test.points, test.maxPoints, grade, test.grade)
+			}
+		})
+	}
+}
+
+func TestTableScaleGrade(t *testing.T) {
+	scale := &tableScale{
+		linearScale: linearScale{min: 1, max: 7},
+		table: []models.GradingScaleRange{
+			{From: 0, Grade: 2},
+			{From: 50, Grade: 4},
+			{From: 80, Grade: 6},
+			{From: 100, Grade: 7},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		points    int
+		maxPoints int
+		grade     float64
+	}{
+		{"without max points", 10, 0, 1},
+		{"first range", 10, 100, 2},
+		{"from of a range", 50, 100, 4},
+		{"below the from of a range", 79, 100, 4},
+		{"last range", 100, 100, 7},
+		{"over max points", 120, 100, 7},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			grade := scale.Grade(test.points, test.maxPoints)
+			if grade != test.grade {
+				t.Errorf("Grade(%d, %d) = %v, want %v", test.points, test.maxPoints, grade, test.grade)
+			}
+		})
+	}
+}
+
+func TestTableScaleGradeBelowFirstRange(t *testing.T) {
+	scale := &tableScale{
+		linearScale: linearScale{min: 1, max: 7},
+		table: []models.GradingScaleRange{
+			{From: 40, Grade: 4},
+		},
+	}
+	if grade := scale.Grade(10, 100); grade != 1 {
+		t.Errorf("Grade(10, 100) = %v, want 1", grade)
+	}
+}
@@ -0,0 +1,710 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"github.com/CPU-commits/Intranet_BClassroom/funct"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"github.com/klauspost/compress/zip"
+	natsPackage "github.com/nats-io/nats.go"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// The review window must end after the submissions are closed
+func (w *WorkSerice) checkPeerReview(work *models.Work) error {
+	if work.PeerReview == nil {
+		return nil
+	}
+	if work.Groups != nil {
+		return errors.New("la revisión de pares no está disponible en trabajos en grupo")
+	}
+	if !work.PeerReview.DateLimit.Time().After(w.getLateDateLimit(work)) {
+		return errors.New("la revisión de pares debe terminar después de la fecha límite del trabajo")
+	}
+	return nil
+}
+
+// Teachers can't evaluate the work until the review window is closed
+func (w *WorkSerice) isPeerReviewOpen(work *models.Work) bool {
+	return work.PeerReview != nil && time.Now().Before(work.PeerReview.DateLimit.Time())
+}
+
+// The reviews are assigned by the scheduler once the submissions are
+// closed, or by the first request to the reviews if it is late
+func (w *WorkSerice) schedulePeerReviews(work *models.Work) error {
+	if work.PeerReview == nil || work.PeerReview.Assigned {
+		return nil
+	}
+	return nats.PublishEncode("schedule_assign_peer_reviews", &AssignPeerReviews{
+		Work: work.ID.Hex(),
+		Diff: time.Until(w.getLateDateLimit(work)).Hours(),
+	})
+}
+
+func (w *WorkSerice) notifyPeerReviews(work *models.Work, reviewers []primitive.ObjectID) error {
+	module, err := moduleService.GetModuleFromID(work.Module.Hex())
+	if err != nil {
+		return err
+	}
+	var notified []primitive.ObjectID
+	for _, idReviewer := range reviewers {
+		if funct.Some(notified, func(id primitive.ObjectID) bool {
+			return id == idReviewer
+		}) {
+			continue
+		}
+		nats.PublishEncode("notify/classroom", res.NotifyClassroom{
+			Title:  fmt.Sprintf("Revisión de pares asignada %v", work.Title),
+			Link:   w.getWorkLink(work),
+			Where:  module.Subject.Hex(),
+			Room:   module.Section.Hex(),
+			Type:   res.WORK,
+			IDUser: idReviewer.Hex(),
+		})
+		notified = append(notified, idReviewer)
+	}
+	return nil
+}
+
+// Assign the submissions to the reviewers once the submissions are closed.
+// The submissions are shuffled and the submission i is reviewed by the
+// authors of the submissions i+1..i+n, so nobody reviews their own
+// submission and everyone reviews n submissions. The submissions are
+// closed with the late limit of the work, a submission uploaded later
+// with an extension is not peer reviewed, only the teacher evaluates it
+func (w *WorkSerice) assignPeerReviews(work *models.Work) error {
+	if work.PeerReview == nil || work.PeerReview.Assigned {
+		return nil
+	}
+	if time.Now().Before(w.getLateDateLimit(work)) {
+		return nil
+	}
+	var reviewers []primitive.ObjectID
+	_, err := models.DbConnect.WithTransaction(func(sessCtx mongo.SessionContext) (interface{}, error) {
+		reviewers = nil
+		// Only a request assigns the reviews
+		result, err := workModel.Use().UpdateOne(sessCtx, bson.D{
+			{
+				Key:   "_id",
+				Value: work.ID,
+			},
+			{
+				Key:   "peer_review.assigned",
+				Value: false,
+			},
+		}, bson.D{{
+			Key: "$set",
+			Value: bson.M{
+				"peer_review.assigned": true,
+			},
+		}})
+		if err != nil || result.ModifiedCount == 0 {
+			return nil, err
+		}
+		var fUCs []models.FileUploadedClassroom
+		cursor, err := fileUCModel.Use().Find(sessCtx, bson.D{{
+			Key:   "work",
+			Value: work.ID,
+		}})
+		if err != nil {
+			return nil, err
+		}
+		if err := cursor.All(sessCtx, &fUCs); err != nil {
+			return nil, err
+		}
+		nReviewers := work.PeerReview.Reviewers
+		if nReviewers > len(fUCs)-1 {
+			nReviewers = len(fUCs) - 1
+		}
+		if nReviewers < 1 {
+			return nil, nil
+		}
+		rand.Shuffle(len(fUCs), func(i, j int) {
+			fUCs[i], fUCs[j] = fUCs[j], fUCs[i]
+		})
+		var peerReviews []interface{}
+		for i, fUC := range fUCs {
+			for j := 1; j <= nReviewers; j++ {
+				peerReviews = append(peerReviews, models.NewModelPeerReview(
+					work.ID,
+					fUC.ID,
+					fUC.Student,
+					fUCs[(i+j)%len(fUCs)].Student,
+				))
+			}
+			reviewers = append(reviewers, fUC.Student)
+		}
+		_, err = peerReviewsModel.Use().InsertMany(sessCtx, peerReviews)
+		return nil, err
+	})
+	if err != nil {
+		return err
+	}
+	work.PeerReview.Assigned = true
+	if len(reviewers) > 0 {
+		return w.notifyPeerReviews(work, reviewers)
+	}
+	return nil
+}
+
+// Message of the scheduler once the submissions of the work are closed
+func assignScheduledPeerReviews() {
+	nats.Queue("assign_peer_reviews", func(m *natsPackage.Msg) {
+		var payload AssignPeerReviews
+		if err := nats.ExtractPayload(m.Data, &payload); err != nil {
+			return
+		}
+		idObjWork, err := primitive.ObjectIDFromHex(payload.Work)
+		if err != nil {
+			return
+		}
+		work, err := workRepository.GetWorkFromId(idObjWork)
+		if err != nil {
+			return
+		}
+		workService.assignPeerReviews(work)
+	})
+}
+
+func (w *WorkSerice) getPeerReviews(filter bson.D) ([]models.PeerReview, error) {
+	var peerReviews []models.PeerReview
+	cursor, err := peerReviewsModel.GetAll(filter, options.Find().SetSort(bson.D{{
+		Key:   "_id",
+		Value: 1,
+	}}))
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &peerReviews); err != nil {
+		return nil, err
+	}
+	return peerReviews, nil
+}
+
+// Files work with peer review whose submissions are closed
+func (w *WorkSerice) getPeerReviewWork(idObjWork primitive.ObjectID) (*models.Work, *res.ErrorRes) {
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if work.Type != "files" || work.PeerReview == nil {
+		return nil, &res.ErrorRes{
+			Err:        errors.New("este trabajo no tiene revisión de pares"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	if time.Now().Before(w.getLateDateLimit(work)) {
+		return nil, &res.ErrorRes{
+			Err:        errors.New("la revisión de pares todavía no comienza"),
+			StatusCode: http.StatusUnauthorized,
+		}
+	}
+	if err := w.assignPeerReviews(work); err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return work, nil
+}
+
+func (w *WorkSerice) getPeerReviewReviewer(
+	idReview,
+	idReviewer string,
+) (*models.PeerReview, *res.ErrorRes) {
+	idObjReview, err := primitive.ObjectIDFromHex(idReview)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjReviewer, err := primitive.ObjectIDFromHex(idReviewer)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	var peerReview *models.PeerReview
+	cursor := peerReviewsModel.GetOne(bson.D{
+		{
+			Key:   "_id",
+			Value: idObjReview,
+		},
+		{
+			Key:   "reviewer",
+			Value: idObjReviewer,
+		},
+	})
+	if err := cursor.Decode(&peerReview); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, &res.ErrorRes{
+				Err:        errors.New("no existe la revisión indicada"),
+				StatusCode: http.StatusNotFound,
+			}
+		}
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return peerReview, nil
+}
+
+// Submissions assigned to the reviewer, without their students
+func (w *WorkSerice) GetReviewerPeerReviews(idWork, idReviewer string) (*ReviewerPeerReviewsRes, *res.ErrorRes) {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjReviewer, err := primitive.ObjectIDFromHex(idReviewer)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	work, errRes := w.getPeerReviewWork(idObjWork)
+	if errRes != nil {
+		return nil, errRes
+	}
+	if errRes := w.checkPublishedWork(work); errRes != nil {
+		return nil, errRes
+	}
+	peerReviews, err := w.getPeerReviews(bson.D{
+		{
+			Key:   "work",
+			Value: idObjWork,
+		},
+		{
+			Key:   "reviewer",
+			Value: idObjReviewer,
+		},
+	})
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	reviewsRes := &ReviewerPeerReviewsRes{
+		Pattern:   work.Pattern,
+		DateLimit: work.PeerReview.DateLimit,
+		Reviews:   []ReviewerPeerReviewRes{},
+	}
+	for i, peerReview := range peerReviews {
+		reviewsRes.Reviews = append(reviewsRes.Reviews, ReviewerPeerReviewRes{
+			ID:         peerReview.ID.Hex(),
+			Number:     i + 1,
+			Evaluate:   peerReview.Evaluate,
+			Points:     peerReview.Points,
+			DateReview: peerReview.DateReview,
+		})
+	}
+	return reviewsRes, nil
+}
+
+func (w *WorkSerice) DownloadPeerReviewFiles(
+	idWork,
+	idReview,
+	idReviewer string,
+	writter io.Writer,
+) (*zip.Writer, *res.ErrorRes) {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	peerReview, errRes := w.getPeerReviewReviewer(idReview, idReviewer)
+	if errRes != nil {
+		return nil, errRes
+	}
+	if peerReview.Work != idObjWork {
+		return nil, &res.ErrorRes{
+			Err:        errors.New("no existe la revisión indicada"),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if errRes := w.checkPublishedWork(work); errRes != nil {
+		return nil, errRes
+	}
+	return w.downloadFilesUploaded(idObjWork, peerReview.Student, true, writter)
+}
+
+func (w *WorkSerice) UploadPeerReview(
+	evaluate []forms.EvaluateFilesForm,
+	idWork,
+	idReview,
+	idReviewer string,
+) *res.ErrorRes {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	work, errRes := w.getPeerReviewWork(idObjWork)
+	if errRes != nil {
+		return errRes
+	}
+	if errRes := w.checkPublishedWork(work); errRes != nil {
+		return errRes
+	}
+	if !w.isPeerReviewOpen(work) || work.IsRevised {
+		return &res.ErrorRes{
+			Err:        errors.New("la revisión de pares de este trabajo ya terminó"),
+			StatusCode: http.StatusUnauthorized,
+		}
+	}
+	peerReview, errRes := w.getPeerReviewReviewer(idReview, idReviewer)
+	if errRes != nil {
+		return errRes
+	}
+	if peerReview.Work != idObjWork {
+		return &res.ErrorRes{
+			Err:        errors.New("no existe la revisión indicada"),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	// All the items of the pattern are reviewed
+	if len(evaluate) != len(work.Pattern) {
+		return &res.ErrorRes{
+			Err:        errors.New("la revisión debe evaluar todos los items de la pauta"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	var evaluateFiles []models.EvaluatedFiles
+	pointsTotal := 0
+	for _, ev := range evaluate {
+		idObjPattern, err := primitive.ObjectIDFromHex(ev.Pattern)
+		if err != nil {
+			return &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		var patternItem *models.WorkPattern
+		for i := range work.Pattern {
+			if work.Pattern[i].ID == idObjPattern {
+				patternItem = &work.Pattern[i]
+				break
+			}
+		}
+		if patternItem == nil {
+			return &res.ErrorRes{
+				Err:        fmt.Errorf("no existe el item #%s en este trabajo", ev.Pattern),
+				StatusCode: http.StatusNotFound,
+			}
+		}
+		for _, evaluated := range evaluateFiles {
+			if evaluated.Pattern == idObjPattern {
+				return &res.ErrorRes{
+					Err:        fmt.Errorf("el item #%s está evaluado más de una vez", ev.Pattern),
+					StatusCode: http.StatusBadRequest,
+				}
+			}
+		}
+		points, idObjLevel, errRes := w.getPatternPoints(patternItem, &ev)
+		if errRes != nil {
+			return errRes
+		}
+		pointsTotal += points
+		evaluateFiles = append(evaluateFiles, models.EvaluatedFiles{
+			ID:      primitive.NewObjectID(),
+			Pattern: idObjPattern,
+			Points:  points,
+			Level:   idObjLevel,
+			Comment: ev.Comment,
+		})
+	}
+	_, err = peerReviewsModel.Use().UpdateByID(db.Ctx, peerReview.ID, bson.D{{
+		Key: "$set",
+		Value: bson.M{
+			"evaluate":    evaluateFiles,
+			"points":      pointsTotal,
+			"date_review": primitive.NewDateTimeFromTime(time.Now()),
+		},
+	}})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return nil
+}
+
+// Mean of the reviewed points of each item of the pattern
+func (w *WorkSerice) getPeerReviewsMean(
+	pattern []models.WorkPattern,
+	peerReviews []models.PeerReview,
+) map[primitive.ObjectID]float64 {
+	means := make(map[primitive.ObjectID]float64)
+	for _, item := range pattern {
+		var sum float64
+		var reviews int
+		for _, peerReview := range peerReviews {
+			for _, evaluated := range peerReview.Evaluate {
+				if evaluated.Pattern == item.ID {
+					sum += float64(evaluated.Points)
+					reviews++
+					break
+				}
+			}
+		}
+		if reviews > 0 {
+			means[item.ID] = sum / float64(reviews)
+		}
+	}
+	return means
+}
+
+// Reviews of the submission of a student, with their reviewers
+func (w *WorkSerice) GetStudentPeerReviews(idWork, idStudent string) (*StudentPeerReviewsRes, *res.ErrorRes) {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjStudent, err := primitive.ObjectIDFromHex(idStudent)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	work, errRes := w.getPeerReviewWork(idObjWork)
+	if errRes != nil {
+		return nil, errRes
+	}
+	peerReviews, err := w.getPeerReviews(bson.D{
+		{
+			Key:   "work",
+			Value: idObjWork,
+		},
+		{
+			Key:   "student",
+			Value: idObjStudent,
+		},
+	})
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	reviewsRes := &StudentPeerReviewsRes{
+		Reviews: []StudentPeerReviewRes{},
+		Means:   []PeerReviewMeanRes{},
+	}
+	var reviewed []models.PeerReview
+	var idReviewers []primitive.ObjectID
+	for _, peerReview := range peerReviews {
+		idReviewers = append(idReviewers, peerReview.Reviewer)
+		if !peerReview.DateReview.Time().IsZero() && len(peerReview.Evaluate) > 0 {
+			reviewed = append(reviewed, peerReview)
+		}
+	}
+	reviewers := make(map[string]models.SimpleUser)
+	if len(idReviewers) > 0 {
+		students, err := w.getStudents(idReviewers)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		for _, student := range students {
+			reviewers[student.User.ID] = student.User
+		}
+	}
+	for _, peerReview := range peerReviews {
+		reviewsRes.Reviews = append(reviewsRes.Reviews, StudentPeerReviewRes{
+			Review:   peerReview,
+			Reviewer: reviewers[peerReview.Reviewer.Hex()],
+		})
+	}
+	// Mean of the reviews
+	means := w.getPeerReviewsMean(work.Pattern, reviewed)
+	for _, item := range work.Pattern {
+		mean, exists := means[item.ID]
+		if !exists {
+			continue
+		}
+		mean = math.Round(mean*100) / 100
+		reviewsRes.Means = append(reviewsRes.Means, PeerReviewMeanRes{
+			Pattern: item.ID.Hex(),
+			Points:  mean,
+		})
+		reviewsRes.Points += mean
+	}
+	return reviewsRes, nil
+}
+
+// Points of the item nearest to the score, with levels the points of the
+// nearest level
+func (w *WorkSerice) getPeerReviewEvaluate(
+	item models.WorkPattern,
+	points float64,
+	comment string,
+) forms.EvaluateFilesForm {
+	evaluate := forms.EvaluateFilesForm{
+		Pattern: item.ID.Hex(),
+		Comment: comment,
+	}
+	if len(item.Levels) == 0 {
+		itemPoints := int(math.Round(points))
+		evaluate.Points = &itemPoints
+		return evaluate
+	}
+	nearest := item.Levels[0]
+	for _, level := range item.Levels {
+		if math.Abs(float64(level.Points)-points) < math.Abs(float64(nearest.Points)-points) {
+			nearest = level
+		}
+	}
+	evaluate.Level = nearest.ID.Hex()
+	return evaluate
+}
+
+// Evaluate the submission of a student from the peer reviews: accept takes
+// the mean of the reviews, blend weights the mean with the evaluation of
+// the teacher and override takes the evaluation of the teacher
+func (w *WorkSerice) ResolvePeerReview(
+	resolve *forms.ResolvePeerReviewForm,
+	idWork,
+	idEvaluator,
+	idStudent string,
+) *res.ErrorRes {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjStudent, err := primitive.ObjectIDFromHex(idStudent)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	work, errRes := w.getPeerReviewWork(idObjWork)
+	if errRes != nil {
+		return errRes
+	}
+	if w.isPeerReviewOpen(work) {
+		return &res.ErrorRes{
+			Err:        errors.New("la revisión de pares de este trabajo todavía no termina"),
+			StatusCode: http.StatusUnauthorized,
+		}
+	}
+	if resolve.Mode == "override" {
+		return w.UploadEvaluateFiles(resolve.Evaluate, idWork, idEvaluator, idStudent, work.IsRevised)
+	}
+	peerReviews, err := w.getPeerReviews(bson.D{
+		{
+			Key:   "work",
+			Value: idObjWork,
+		},
+		{
+			Key:   "student",
+			Value: idObjStudent,
+		},
+		{
+			Key: "date_review",
+			Value: bson.M{
+				"$exists": true,
+			},
+		},
+	})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if len(peerReviews) == 0 {
+		return &res.ErrorRes{
+			Err:        errors.New("la entrega del alumno no tiene revisiones de pares"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	means := w.getPeerReviewsMean(work.Pattern, peerReviews)
+
+	var evaluate []forms.EvaluateFilesForm
+	for _, item := range work.Pattern {
+		mean, reviewed := means[item.ID]
+		if resolve.Mode == "accept" {
+			if reviewed {
+				evaluate = append(evaluate, w.getPeerReviewEvaluate(
+					item,
+					mean,
+					fmt.Sprintf("Promedio de %d revisiones de pares", len(peerReviews)),
+				))
+			}
+			continue
+		}
+		// Blend
+		idx := -1
+		for i, ev := range resolve.Evaluate {
+			if ev.Pattern == item.ID.Hex() {
+				idx = i
+				break
+			}
+		}
+		if idx == -1 {
+			return &res.ErrorRes{
+				Err:        fmt.Errorf("indique la evaluación del item #%s", item.ID.Hex()),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		points, _, errRes := w.getPatternPoints(&item, &resolve.Evaluate[idx])
+		if errRes != nil {
+			return errRes
+		}
+		blend := float64(points)
+		if reviewed {
+			peerWeight := float64(resolve.PeerWeight) / 100
+			blend = mean*peerWeight + float64(points)*(1-peerWeight)
+		}
+		evaluate = append(evaluate, w.getPeerReviewEvaluate(
+			item,
+			blend,
+			resolve.Evaluate[idx].Comment,
+		))
+	}
+	return w.UploadEvaluateFiles(evaluate, idWork, idEvaluator, idStudent, work.IsRevised)
+}
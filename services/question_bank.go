@@ -0,0 +1,511 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"github.com/CPU-commits/Intranet_BClassroom/funct"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var questionBankService *QuestionBankService
+
+type QuestionBankService struct{}
+
+type BankSearch struct {
+	Search     string
+	Subject    string
+	Difficulty string
+	Type       string
+	Tags       []string
+	OnlyAuthor bool
+}
+
+func (b *QuestionBankService) getBankQuestion(idObjQuestion primitive.ObjectID) (*models.BankQuestion, *res.ErrorRes) {
+	var bankQuestion *models.BankQuestion
+	cursor := questionBankModel.GetOne(bson.D{
+		{
+			Key:   "_id",
+			Value: idObjQuestion,
+		},
+		{
+			Key:   "status",
+			Value: true,
+		},
+	})
+	if err := cursor.Decode(&bankQuestion); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, &res.ErrorRes{
+				Err:        fmt.Errorf("no existe la pregunta en el banco de preguntas"),
+				StatusCode: http.StatusNotFound,
+			}
+		}
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return bankQuestion, nil
+}
+
+// Fill the question form with the bank question content
+func (b *QuestionBankService) importBankQuestion(question *forms.QuestionForm) *res.ErrorRes {
+	idObjBank, err := primitive.ObjectIDFromHex(question.Bank)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	bankQuestion, errRes := b.getBankQuestion(idObjBank)
+	if errRes != nil {
+		return errRes
+	}
+	correct := bankQuestion.Question.Correct
+
+	question.Question = bankQuestion.Question.Question
+	question.Type = bankQuestion.Question.Type
+	question.Answers = bankQuestion.Question.Answers
+	question.Options = bankQuestion.Question.Options
+	question.Correct = &correct
+	question.Corrects = bankQuestion.Question.Corrects
+	question.Number = bankQuestion.Question.Number
+	question.Tolerance = bankQuestion.Question.Tolerance
+	if question.Points == 0 {
+		question.Points = bankQuestion.Question.Points
+	}
+	return nil
+}
+
+// Questions of the forms assigned to a work, a change in the bank
+// must not alter a form that the students can see
+func (b *QuestionBankService) getQuestionsInWorks(idsQuestions bson.A) (bson.A, error) {
+	var formsData []models.Form
+	cursor, err := formModel.GetAll(bson.D{{
+		Key: "items.questions",
+		Value: bson.M{
+			"$in": idsQuestions,
+		},
+	}}, options.Find().SetProjection(bson.M{"items": 1}))
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &formsData); err != nil {
+		return nil, err
+	}
+	idsForms := bson.A{}
+	for _, form := range formsData {
+		idsForms = append(idsForms, form.ID)
+	}
+	usedForms, err := workModel.Use().Distinct(db.Ctx, "form", bson.D{{
+		Key: "form",
+		Value: bson.M{
+			"$in": idsForms,
+		},
+	}})
+	if err != nil {
+		return nil, err
+	}
+	inWorks := bson.A{}
+	for _, form := range formsData {
+		if !funct.Some(usedForms, func(idForm interface{}) bool {
+			return idForm == form.ID
+		}) {
+			continue
+		}
+		for _, item := range form.Items {
+			for _, idQuestion := range item.Questions {
+				inWorks = append(inWorks, idQuestion)
+			}
+		}
+	}
+	return inWorks, nil
+}
+
+// Update the form questions imported by reference that have not been answered
+// yet and whose form is not assigned to a work
+func (b *QuestionBankService) propagateBankQuestion(bankQuestion *models.BankQuestion) error {
+	var questions []models.ItemQuestion
+	cursor, err := formQuestionModel.GetAll(bson.D{{
+		Key:   "bank",
+		Value: bankQuestion.ID,
+	}}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return err
+	}
+	if err := cursor.All(db.Ctx, &questions); err != nil {
+		return err
+	}
+	if len(questions) == 0 {
+		return nil
+	}
+	idsQuestions := bson.A{}
+	for _, question := range questions {
+		idsQuestions = append(idsQuestions, question.ID)
+	}
+	answered, err := answerModel.Use().Distinct(db.Ctx, "question", bson.D{{
+		Key: "question",
+		Value: bson.M{
+			"$in": idsQuestions,
+		},
+	}})
+	if err != nil {
+		return err
+	}
+	inWorks, err := b.getQuestionsInWorks(idsQuestions)
+	if err != nil {
+		return err
+	}
+	toUpdate := bson.A{}
+	for _, question := range questions {
+		isQuestion := func(idQuestion interface{}) bool {
+			return idQuestion == question.ID
+		}
+		if !funct.Some(answered, isQuestion) && !funct.Some(inWorks, isQuestion) {
+			toUpdate = append(toUpdate, question.ID)
+		}
+	}
+	if len(toUpdate) == 0 {
+		return nil
+	}
+	questionData := bankQuestion.Question
+	questionData.Bank = bankQuestion.ID
+	updateInfo, unsetInfo := formService.getQuestionUpdate(&questionData)
+	_, err = formQuestionModel.Use().UpdateMany(
+		db.Ctx,
+		bson.D{{
+			Key: "_id",
+			Value: bson.M{
+				"$in": toUpdate,
+			},
+		}},
+		bson.D{
+			{
+				Key:   "$set",
+				Value: updateInfo,
+			},
+			{
+				Key:   "$unset",
+				Value: unsetInfo,
+			},
+		},
+	)
+	return err
+}
+
+func (b *QuestionBankService) SearchQuestions(
+	search *BankSearch,
+	idUser string,
+	limit,
+	skip int,
+	total bool,
+) ([]models.BankQuestionWLookup, int, *res.ErrorRes) {
+	var totalQuestions int
+
+	idObjUser, err := primitive.ObjectIDFromHex(idUser)
+	if err != nil {
+		return nil, totalQuestions, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Filter
+	filter := bson.M{
+		"status": true,
+	}
+	if search.Subject != "" {
+		idObjSubject, err := primitive.ObjectIDFromHex(search.Subject)
+		if err != nil {
+			return nil, totalQuestions, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		filter["subject"] = idObjSubject
+	}
+	if search.Difficulty != "" {
+		filter["difficulty"] = search.Difficulty
+	}
+	if search.Type != "" {
+		filter["question.type"] = search.Type
+	}
+	if len(search.Tags) > 0 {
+		filter["tags"] = bson.M{
+			"$all": search.Tags,
+		}
+	}
+	if search.Search != "" {
+		filter["question.question"] = bson.M{
+			"$regex":   regexp.QuoteMeta(search.Search),
+			"$options": "i",
+		}
+	}
+	if search.OnlyAuthor {
+		filter["author"] = idObjUser
+	}
+	// Get questions
+	var questions []models.BankQuestionWLookup
+
+	match := bson.D{{
+		Key:   "$match",
+		Value: filter,
+	}}
+	sort := bson.D{{
+		Key: "$sort",
+		Value: bson.M{
+			"update_date": -1,
+		},
+	}}
+	skipPl := bson.D{{
+		Key:   "$skip",
+		Value: skip,
+	}}
+	limitPl := bson.D{{
+		Key:   "$limit",
+		Value: limit,
+	}}
+	lookupAuthor := bson.D{{
+		Key: "$lookup",
+		Value: bson.M{
+			"from":         models.USERS_COLLECTION,
+			"localField":   "author",
+			"foreignField": "_id",
+			"as":           "author",
+			"pipeline": bson.A{bson.D{{
+				Key: "$project",
+				Value: bson.M{
+					"name":            1,
+					"first_lastname":  1,
+					"second_lastname": 1,
+				},
+			}}},
+		},
+	}}
+	lookupSubject := bson.D{{
+		Key: "$lookup",
+		Value: bson.M{
+			"from":         models.SUBJECT_COLLECTION,
+			"localField":   "subject",
+			"foreignField": "_id",
+			"as":           "subject",
+		},
+	}}
+	set := bson.D{{
+		Key: "$set",
+		Value: bson.M{
+			"author": bson.M{
+				"$first": "$author",
+			},
+			"subject": bson.M{
+				"$first": "$subject",
+			},
+		},
+	}}
+
+	pipeline := mongo.Pipeline{
+		match,
+		sort,
+		skipPl,
+	}
+	if limit != 0 {
+		pipeline = append(pipeline, limitPl)
+	}
+	pipeline = append(pipeline, lookupAuthor, lookupSubject, set)
+	cursor, err := questionBankModel.Aggreagate(pipeline)
+	if err != nil {
+		return nil, totalQuestions, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if err := cursor.All(db.Ctx, &questions); err != nil {
+		return nil, totalQuestions, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	// Get total of questions
+	if total {
+		totalOfDocuments, err := questionBankModel.Use().CountDocuments(db.Ctx, filter)
+		if err != nil {
+			return nil, totalQuestions, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		totalQuestions = int(totalOfDocuments)
+	}
+	return questions, totalQuestions, nil
+}
+
+func (b *QuestionBankService) checkBankQuestion(question *forms.BankQuestionForm) (primitive.ObjectID, *res.ErrorRes) {
+	idObjSubject, err := primitive.ObjectIDFromHex(question.Subject)
+	if err != nil {
+		return idObjSubject, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	if question.Question.Bank != "" {
+		return idObjSubject, &res.ErrorRes{
+			Err:        fmt.Errorf("una pregunta del banco no puede importar otra pregunta del banco"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	if err := formService.checkQuestion(&question.Question); err != nil {
+		return idObjSubject, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	return idObjSubject, nil
+}
+
+func (b *QuestionBankService) UploadQuestion(
+	question *forms.BankQuestionForm,
+	idUser string,
+) (interface{}, *res.ErrorRes) {
+	idObjUser, err := primitive.ObjectIDFromHex(idUser)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjSubject, errRes := b.checkBankQuestion(question)
+	if errRes != nil {
+		return nil, errRes
+	}
+	// Insert
+	modelQuestion := models.NewModelBankQuestion(question, idObjUser, idObjSubject)
+	inserted, err := questionBankModel.NewDocument(modelQuestion)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return inserted.InsertedID, nil
+}
+
+func (b *QuestionBankService) UpdateQuestion(
+	question *forms.BankQuestionForm,
+	idQuestion,
+	idUser string,
+) *res.ErrorRes {
+	idObjUser, err := primitive.ObjectIDFromHex(idUser)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjQuestion, err := primitive.ObjectIDFromHex(idQuestion)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjSubject, errRes := b.checkBankQuestion(question)
+	if errRes != nil {
+		return errRes
+	}
+	// Get question
+	bankQuestion, errRes := b.getBankQuestion(idObjQuestion)
+	if errRes != nil {
+		return errRes
+	}
+	if bankQuestion.Author != idObjUser {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("no tienes acceso para editar esta pregunta"),
+			StatusCode: http.StatusUnauthorized,
+		}
+	}
+	// Update
+	modelQuestion := models.NewModelBankQuestion(question, idObjUser, idObjSubject)
+	_, err = questionBankModel.Use().UpdateByID(db.Ctx, idObjQuestion, bson.D{{
+		Key: "$set",
+		Value: bson.M{
+			"subject":     modelQuestion.Subject,
+			"tags":        modelQuestion.Tags,
+			"difficulty":  modelQuestion.Difficulty,
+			"question":    modelQuestion.Question,
+			"update_date": primitive.NewDateTimeFromTime(time.Now()),
+		},
+	}})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	// Update forms
+	modelQuestion.ID = idObjQuestion
+	if err := b.propagateBankQuestion(&modelQuestion); err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return nil
+}
+
+func (b *QuestionBankService) DeleteQuestion(idQuestion, idUser string) *res.ErrorRes {
+	idObjUser, err := primitive.ObjectIDFromHex(idUser)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjQuestion, err := primitive.ObjectIDFromHex(idQuestion)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get question
+	bankQuestion, errRes := b.getBankQuestion(idObjQuestion)
+	if errRes != nil {
+		return errRes
+	}
+	if bankQuestion.Author != idObjUser {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("no estás autorizado a eliminar esta pregunta"),
+			StatusCode: http.StatusUnauthorized,
+		}
+	}
+	// Delete (soft), form questions keep their content
+	_, err = questionBankModel.Use().UpdateByID(db.Ctx, idObjQuestion, bson.D{{
+		Key: "$set",
+		Value: bson.M{
+			"status": false,
+		},
+	}})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return nil
+}
+
+func NewQuestionBankService() *QuestionBankService {
+	if questionBankService == nil {
+		questionBankService = &QuestionBankService{}
+	}
+	return questionBankService
+}
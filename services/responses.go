@@ -17,6 +17,7 @@ type Acumulative struct {
 	ID        string             `json:"_id" example:"63785424db1efbc237faecca"`
 	Grade     float64            `json:"grade" example:"70"`
 	Evaluator *models.SimpleUser `json:"evaluator"`
+	Draft     bool               `json:"draft,omitempty" extensions:"x-omitempty"`
 	Date      time.Time          `json:"date"`
 }
 
@@ -26,6 +27,7 @@ type OrderedGrade struct {
 	IsAcumulative bool              `json:"is_acumulative"`
 	Acumulative   []*Acumulative    `json:"acumulative,omitempty" extensions:"x-omitempty"`
 	Evaluator     models.SimpleUser `json:"evaluator,omitempty" extensions:"x-omitempty"`
+	Draft         bool              `json:"draft,omitempty" extensions:"x-omitempty"`
 	Date          time.Time         `json:"date,omitempty" extensions:"x-omitempty"`
 }
 
@@ -50,12 +52,47 @@ type PublicationsRes struct {
 	UpdateDate primitive.DateTime `json:"update_date" bson:"update_date" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
 }
 
+type RubricCriterionRes struct {
+	Criterion models.WorkPattern       `json:"criterion"`
+	Level     *models.WorkPatternLevel `json:"level,omitempty" extensions:"x-omitempty"`
+	Points    int                      `json:"points" example:"20"`
+	Comment   string                   `json:"comment,omitempty" example:"Buen análisis, faltan fuentes" extensions:"x-omitempty"`
+}
+
 type CloseForm struct {
 	Work    string
 	Student string
 	Diff    float64
 }
 
+// Close of a form with multiple attempts, the access of the student must
+// only be closed if it is still in this attempt (a missing attempt in the
+// access is the first one)
+type CloseFormAttempt struct {
+	Work    string
+	Student string
+	Diff    float64
+	Attempt int
+}
+
+type ReleaseGrades struct {
+	Module      string
+	Program     string
+	Acumulative string
+	Work        string
+	Diff        float64
+}
+
+type PublishWork struct {
+	Work string
+	Diff float64
+}
+
+type AssignPeerReviews struct {
+	Work string
+	Diff float64
+}
+
 type Student struct {
 	ID                 string                               `json:"_id" example:"637d5de216f58bc8ec7f7f51"`
 	User               models.SimpleUser                    `json:"user"`
@@ -66,11 +103,14 @@ type Student struct {
 	FilesUploaded      *models.FileUploadedClassroomWLookup `json:"files_uploaded,omitempty" extensions:"x-omitempty"`
 	Evuluate           map[string]int                       `json:"evaluate,omitempty" extensions:"x-omitempty"`
 	Session            *models.SessionWLookup               `json:"session,omitempty" extensions:"x-omitempty"`
+	Group              *models.WorkGroup                    `json:"group,omitempty" extensions:"x-omitempty"`
+	Late               bool                                 `json:"late"`
 }
 
 type AnswerRes struct {
-	Answer   models.Answer `json:"answer"`
-	Evaluate interface{}   `json:"evaluate,omitempty" extensions:"x-omitempty"`
+	Answer   models.Answer        `json:"answer"`
+	Evaluate interface{}          `json:"evaluate,omitempty" extensions:"x-omitempty"`
+	Feedback *models.WorkFeedback `json:"feedback,omitempty" extensions:"x-omitempty"`
 }
 
 type WorkStatus struct {
@@ -84,3 +124,229 @@ type WorkStatus struct {
 	DateUpload  time.Time `json:"date_upload"`
 	Status      int       `json:"status"`
 }
+
+type ImportGradeError struct {
+	Row     int    `json:"row" example:"3"`
+	Column  string `json:"column,omitempty" example:"B" extensions:"x-omitempty"`
+	Rut     string `json:"rut,omitempty" example:"12345678-9" extensions:"x-omitempty"`
+	Message string `json:"message" example:"calificación inválida. Mín: 1. Máx: 7"`
+}
+
+type ImportGradesRes struct {
+	Rows      int                `json:"rows" example:"30"`
+	Inserted  int                `json:"inserted" example:"25"`
+	Updated   int                `json:"updated" example:"2"`
+	Unchanged int                `json:"unchanged" example:"3"`
+	Errors    []ImportGradeError `json:"errors"`
+	Committed bool               `json:"committed"`
+}
+
+type ExportedAcumulative struct {
+	Number     int      `json:"number" example:"1"`
+	Percentage float32  `json:"percentage" example:"20"`
+	Grade      *float64 `json:"grade" example:"6.5"`
+}
+
+type ExportedGrade struct {
+	Program     int                   `json:"program" example:"1"`
+	Percentage  float32               `json:"percentage" example:"25"`
+	Grade       *float64              `json:"grade" example:"6.1"`
+	Acumulative []ExportedAcumulative `json:"acumulative,omitempty" extensions:"x-omitempty"`
+}
+
+type ExportedStudentGrades struct {
+	Student models.SimpleUser `json:"student"`
+	Grades  []ExportedGrade   `json:"grades"`
+	Average *float64          `json:"average" example:"5.8"`
+}
+
+type HistogramBin struct {
+	From  float64 `json:"from" example:"4"`
+	To    float64 `json:"to" example:"5"`
+	Count int     `json:"count" example:"12"`
+}
+
+type GradesStats struct {
+	Count     int            `json:"count" example:"30"`
+	Mean      *float64       `json:"mean" example:"5.4"`
+	Median    *float64       `json:"median" example:"5.5"`
+	StdDev    *float64       `json:"std_dev" example:"0.8"`
+	Min       *float64       `json:"min" example:"2.9"`
+	Max       *float64       `json:"max" example:"7"`
+	Passed    int            `json:"passed" example:"27"`
+	Failed    int            `json:"failed" example:"3"`
+	PassRate  *float64       `json:"pass_rate" example:"90"`
+	Histogram []HistogramBin `json:"histogram"`
+}
+
+type ProgramAnalytics struct {
+	ID            string      `json:"_id" example:"637d5de216f58bc8ec7f7f51"`
+	Number        int         `json:"number" example:"1"`
+	Percentage    float32     `json:"percentage" example:"25"`
+	IsAcumulative bool        `json:"is_acumulative"`
+	Stats         GradesStats `json:"stats"`
+}
+
+type AtRiskStudent struct {
+	Student models.SimpleUser `json:"student"`
+	Average float64           `json:"average" example:"3.6"`
+	Missing int               `json:"missing" example:"1"`
+}
+
+type ModuleAnalytics struct {
+	Module    string             `json:"module" example:"637d5de216f58bc8ec7f7f51"`
+	Section   string             `json:"section" example:"Primero A"`
+	PassGrade float64            `json:"pass_grade" example:"4"`
+	Students  int                `json:"students" example:"30"`
+	Programs  []ProgramAnalytics `json:"programs"`
+	Averages  GradesStats        `json:"averages"`
+	AtRisk    []AtRiskStudent    `json:"at_risk"`
+}
+
+type SectionAnalytics struct {
+	Module   string      `json:"module" example:"637d5de216f58bc8ec7f7f51"`
+	Section  string      `json:"section" example:"Primero B"`
+	Students int         `json:"students" example:"28"`
+	Averages GradesStats `json:"averages"`
+	AtRisk   int         `json:"at_risk" example:"2"`
+	Current  bool        `json:"current"`
+}
+
+type GradesAnalyticsRes struct {
+	Module   ModuleAnalytics    `json:"module"`
+	Sections []SectionAnalytics `json:"sections"`
+}
+
+type AlternativeAnalysis struct {
+	Index      int     `json:"index" example:"0"`
+	Answer     string  `json:"answer" example:"Santiago"`
+	Count      int     `json:"count" example:"12"`
+	Percentage float64 `json:"percentage" example:"40"`
+	Correct    bool    `json:"correct"`
+}
+
+// @Description Discrimination is null if there are not enough students to compare the groups
+// @Description Alternatives is null if question.type!=alternatives_correct && true_false
+type QuestionAnalysis struct {
+	ID             string                `json:"_id" example:"637d5de216f58bc8ec7f7f51"`
+	Question       string                `json:"question" example:"¿Capital de Chile?"`
+	Type           string                `json:"type" example:"alternatives_correct"`
+	Points         int                   `json:"points" example:"5"`
+	Students       int                   `json:"students" example:"30"`
+	Correct        float64               `json:"correct" example:"63.3"`
+	Discrimination *float64              `json:"discrimination" example:"0.42"`
+	Alternatives   []AlternativeAnalysis `json:"alternatives,omitempty" extensions:"x-omitempty"`
+	Omitted        int                   `json:"omitted" example:"2"`
+	AllMissed      bool                  `json:"all_missed"`
+	Removed        bool                  `json:"removed"`
+}
+
+type ItemAnalysisRes struct {
+	Students  int                `json:"students" example:"30"`
+	Group     int                `json:"group" example:"8"`
+	Questions []QuestionAnalysis `json:"questions"`
+}
+
+type MissingGrade struct {
+	Student     string  `json:"student" example:"637d5de216f58bc8ec7f7f51"`
+	Program     string  `json:"program" example:"637d5de216f58bc8ec7f7f51"`
+	Number      int     `json:"number" example:"3"`
+	Acumulative string  `json:"acumulative,omitempty" example:"637d5de216f58bc8ec7f7f51" extensions:"x-omitempty"`
+	Grade       float64 `json:"grade" example:"1"`
+}
+
+type CloseModulePreview struct {
+	Module string         `json:"module" example:"637d5de216f58bc8ec7f7f51"`
+	Grades []MissingGrade `json:"grades"`
+	Error  string         `json:"error,omitempty" example:"no se pudo obtener los estudiantes" extensions:"x-omitempty"`
+}
+
+type CloseSemesterPreview struct {
+	Semester string               `json:"semester" example:"637d5de216f58bc8ec7f7f51"`
+	Total    int                  `json:"total" example:"25"`
+	Modules  []CloseModulePreview `json:"modules"`
+}
+
+type CloseSemesterReport struct {
+	Success bool                  `json:"success"`
+	Message string                `json:"message,omitempty"`
+	Close   *models.SemesterClose `json:"close,omitempty"`
+	Preview *CloseSemesterPreview `json:"preview,omitempty"`
+}
+
+// @Description Average is null if the student has no grades in the module
+type ModuleAverageRes struct {
+	Module   string   `json:"module" example:"637d5de216f58bc8ec7f7f51"`
+	Subject  string   `json:"subject" example:"Matemáticas"`
+	Average  *float64 `json:"average" example:"5.8"`
+	Complete bool     `json:"complete"`
+	IsFinal  bool     `json:"is_final"`
+}
+
+// @Description IsFinal is true when the semester was closed, else the averages are partial
+type StudentAveragesRes struct {
+	Student  models.SimpleUser  `json:"student"`
+	Semester string             `json:"semester" example:"637d5de216f58bc8ec7f7f51"`
+	Modules  []ModuleAverageRes `json:"modules"`
+	Average  *float64           `json:"average" example:"5.6"`
+	IsFinal  bool               `json:"is_final"`
+}
+
+type AppliedTemplate struct {
+	Module   string `json:"module" example:"637d5de216f58bc8ec7f7f51"`
+	Template string `json:"template" example:"637d5de216f58bc8ec7f7f51"`
+	Programs int    `json:"programs" example:"5"`
+}
+
+type SkippedTemplate struct {
+	Module string `json:"module" example:"637d5de216f58bc8ec7f7f51"`
+	Reason string `json:"reason" example:"el módulo ya tiene calificaciones programadas"`
+}
+
+type ApplyTemplatesRes struct {
+	Applied []AppliedTemplate `json:"applied"`
+	Skipped []SkippedTemplate `json:"skipped"`
+}
+
+type ClonedWork struct {
+	Module string `json:"module" example:"637d5de216f58bc8ec7f7f51"`
+	Work   string `json:"work" example:"637d5de216f58bc8ec7f7f51"`
+}
+
+// @Description Students are the users of the flagged pairs
+type SimilarityReportRes struct {
+	Report   models.WorkSimilarity `json:"report"`
+	Students []models.SimpleUser   `json:"students"`
+}
+
+// @Description The reviewer doesn't know the student of the submission
+type ReviewerPeerReviewRes struct {
+	ID         string                  `json:"_id" example:"637d5de216f58bc8ec7f7f51"`
+	Number     int                     `json:"number" example:"1"`
+	Evaluate   []models.EvaluatedFiles `json:"evaluate,omitempty" extensions:"x-omitempty"`
+	Points     int                     `json:"points" example:"40"`
+	DateReview primitive.DateTime      `json:"date_review,omitempty" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00" extensions:"x-omitempty"`
+}
+
+type ReviewerPeerReviewsRes struct {
+	Pattern   []models.WorkPattern    `json:"pattern"`
+	DateLimit primitive.DateTime      `json:"date_limit" swaggertype:"string" example:"2022-09-21T20:10:23.309+00:00"`
+	Reviews   []ReviewerPeerReviewRes `json:"reviews"`
+}
+
+type StudentPeerReviewRes struct {
+	Review   models.PeerReview `json:"review"`
+	Reviewer models.SimpleUser `json:"reviewer"`
+}
+
+type PeerReviewMeanRes struct {
+	Pattern string  `json:"pattern" example:"637d5de216f58bc8ec7f7f51"`
+	Points  float64 `json:"points" example:"18.5"`
+}
+
+// @Description Points is the sum of the means of the reviewed items
+type StudentPeerReviewsRes struct {
+	Reviews []StudentPeerReviewRes `json:"reviews"`
+	Means   []PeerReviewMeanRes    `json:"means"`
+	Points  float64                `json:"points" example:"42.5"`
+}
@@ -0,0 +1,220 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var rubricService *RubricService
+
+type RubricService struct{}
+
+func (r *RubricService) getRubric(idObjRubric, idObjUser primitive.ObjectID) (*models.Rubric, *res.ErrorRes) {
+	var rubric *models.Rubric
+	cursor := rubricsModel.GetOne(bson.D{
+		{
+			Key:   "_id",
+			Value: idObjRubric,
+		},
+		{
+			Key:   "status",
+			Value: true,
+		},
+	})
+	if err := cursor.Decode(&rubric); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, &res.ErrorRes{
+				Err:        fmt.Errorf("no existe la rúbrica indicada"),
+				StatusCode: http.StatusNotFound,
+			}
+		}
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if rubric.Author != idObjUser {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("no tienes acceso a esta rúbrica"),
+			StatusCode: http.StatusUnauthorized,
+		}
+	}
+	return rubric, nil
+}
+
+// Pattern of a work from a rubric template, every criterion and level gets a new id
+func (r *RubricService) getPatternFromRubric(idRubric string, idObjUser primitive.ObjectID) ([]models.WorkPattern, *res.ErrorRes) {
+	idObjRubric, err := primitive.ObjectIDFromHex(idRubric)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	rubric, errRes := r.getRubric(idObjRubric, idObjUser)
+	if errRes != nil {
+		return nil, errRes
+	}
+	var pattern []models.WorkPattern
+	for _, criterion := range rubric.Criteria {
+		criterion.ID = primitive.NewObjectID()
+		var levels []models.WorkPatternLevel
+		for _, level := range criterion.Levels {
+			level.ID = primitive.NewObjectID()
+			levels = append(levels, level)
+		}
+		criterion.Levels = levels
+		pattern = append(pattern, criterion)
+	}
+	return pattern, nil
+}
+
+func (r *RubricService) GetRubrics(idUser string) ([]models.Rubric, *res.ErrorRes) {
+	idObjUser, err := primitive.ObjectIDFromHex(idUser)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	var rubrics []models.Rubric
+
+	opts := options.Find().SetSort(bson.D{{
+		Key:   "update_date",
+		Value: -1,
+	}})
+	cursor, err := rubricsModel.GetAll(bson.D{
+		{
+			Key:   "author",
+			Value: idObjUser,
+		},
+		{
+			Key:   "status",
+			Value: true,
+		},
+	}, opts)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if err := cursor.All(db.Ctx, &rubrics); err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return rubrics, nil
+}
+
+func (r *RubricService) UploadRubric(rubric *forms.RubricForm, idUser string) (interface{}, *res.ErrorRes) {
+	idObjUser, err := primitive.ObjectIDFromHex(idUser)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Insert
+	modelRubric := models.NewModelRubric(rubric, idObjUser)
+	inserted, err := rubricsModel.NewDocument(modelRubric)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return inserted.InsertedID, nil
+}
+
+// Works that already use the rubric keep their copy of the criteria
+func (r *RubricService) UpdateRubric(rubric *forms.RubricForm, idRubric, idUser string) *res.ErrorRes {
+	idObjUser, err := primitive.ObjectIDFromHex(idUser)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjRubric, err := primitive.ObjectIDFromHex(idRubric)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	if _, errRes := r.getRubric(idObjRubric, idObjUser); errRes != nil {
+		return errRes
+	}
+	// Update
+	modelRubric := models.NewModelRubric(rubric, idObjUser)
+	_, err = rubricsModel.Use().UpdateByID(db.Ctx, idObjRubric, bson.D{{
+		Key: "$set",
+		Value: bson.M{
+			"title":       modelRubric.Title,
+			"description": modelRubric.Description,
+			"criteria":    modelRubric.Criteria,
+			"update_date": primitive.NewDateTimeFromTime(time.Now()),
+		},
+	}})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return nil
+}
+
+func (r *RubricService) DeleteRubric(idRubric, idUser string) *res.ErrorRes {
+	idObjUser, err := primitive.ObjectIDFromHex(idUser)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjRubric, err := primitive.ObjectIDFromHex(idRubric)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	if _, errRes := r.getRubric(idObjRubric, idObjUser); errRes != nil {
+		return errRes
+	}
+	// Delete (soft)
+	_, err = rubricsModel.Use().UpdateByID(db.Ctx, idObjRubric, bson.D{{
+		Key: "$set",
+		Value: bson.M{
+			"status": false,
+		},
+	}})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return nil
+}
+
+func NewRubricService() *RubricService {
+	if rubricService == nil {
+		rubricService = &RubricService{}
+	}
+	return rubricService
+}
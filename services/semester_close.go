@@ -0,0 +1,528 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"github.com/CPU-commits/Intranet_BClassroom/utils"
+	natsPackage "github.com/nats-io/nats.go"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// A running close without progress in this time was interrupted,
+// so it can be resumed
+const CLOSE_SEMESTER_TIMEOUT = 30 * time.Minute
+
+type moduleMissingGrades struct {
+	grades  []models.Grade
+	preview []MissingGrade
+}
+
+// Grades that the close fills with the min grade of the module scale
+func getModuleMissingGrades(module models.Module) (*moduleMissingGrades, error) {
+	idModule := module.ID.Hex()
+	programs, errRes := gradesService.GetGradePrograms(idModule)
+	if errRes != nil {
+		return nil, errRes.Err
+	}
+	students, err := workService.getStudentsFromIdModule(idModule)
+	if err != nil {
+		return nil, err
+	}
+	scale, err := gradingScaleService.getModuleScale(&module)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := &moduleMissingGrades{}
+	addMissing := func(idObjStudent, idObjAcumulative primitive.ObjectID, program models.GradesProgram) {
+		missing.grades = append(missing.grades, models.NewModelGrade(
+			module.ID,
+			idObjStudent,
+			idObjAcumulative,
+			program.ID,
+			primitive.NilObjectID, // System
+			scale.Min,
+			program.IsAcumulative,
+		))
+		missingGrade := MissingGrade{
+			Student: idObjStudent.Hex(),
+			Program: program.ID.Hex(),
+			Number:  program.Number,
+			Grade:   scale.Min,
+		}
+		if !idObjAcumulative.IsZero() {
+			missingGrade.Acumulative = idObjAcumulative.Hex()
+		}
+		missing.preview = append(missing.preview, missingGrade)
+	}
+	for _, student := range students {
+		idObjStudent, err := primitive.ObjectIDFromHex(student.User.ID)
+		if err != nil {
+			return nil, err
+		}
+		grades, errRes := gradesService.GetStudentGrades(idModule, student.User.ID, false)
+		if errRes != nil {
+			return nil, errRes.Err
+		}
+		for i, program := range programs {
+			var grade *OrderedGrade
+			if i < len(grades) {
+				grade = grades[i]
+			}
+			if !program.IsAcumulative {
+				if grade == nil {
+					addMissing(idObjStudent, primitive.NilObjectID, program)
+				}
+				continue
+			}
+			// Each acumulative without grade
+			for j, acumulative := range program.Acumulative {
+				if grade != nil && j < len(grade.Acumulative) && grade.Acumulative[j] != nil {
+					continue
+				}
+				addMissing(idObjStudent, acumulative.ID, program)
+			}
+		}
+	}
+	return missing, nil
+}
+
+// Insert the grades of a module with its history, if something
+// fails nothing of the module is inserted
+func fillModuleGrades(grades []models.Grade) error {
+	if len(grades) == 0 {
+		return nil
+	}
+	_, err := models.DbConnect.WithTransaction(func(sessCtx mongo.SessionContext) (interface{}, error) {
+		modelsGrades := make([]interface{}, len(grades))
+		for i, grade := range grades {
+			modelsGrades[i] = grade
+		}
+		inserted, err := gradeModel.Use().InsertMany(sessCtx, modelsGrades)
+		if err != nil {
+			return nil, err
+		}
+		history := make([]interface{}, len(grades))
+		for i, grade := range grades {
+			history[i] = models.NewModelGradeHistory(
+				inserted.InsertedIDs[i].(primitive.ObjectID),
+				models.GRADES_COLLECTION,
+				grade.Module,
+				grade.Student,
+				nil,
+				grade.Grade,
+				primitive.NilObjectID, // System
+				models.GRADE_SOURCE_CLOSE_SEMESTER,
+				"",
+			)
+		}
+		_, err = gradeHistoryModel.Use().InsertMany(sessCtx, history)
+		return nil, err
+	})
+	return err
+}
+
+func getSemesterClose(idObjSemester primitive.ObjectID) (*models.SemesterClose, error) {
+	var semesterClose *models.SemesterClose
+
+	cursor := semesterClosesModel.GetOne(bson.D{{
+		Key:   "semester",
+		Value: idObjSemester,
+	}})
+	if err := cursor.Decode(&semesterClose); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return semesterClose, nil
+}
+
+// Get the close of the semester to run. A completed close is returned
+// as is, a failed or interrupted close is resumed
+func claimSemesterClose(
+	idObjSemester primitive.ObjectID,
+	modules []models.Module,
+) (*models.SemesterClose, error) {
+	semesterClose, err := getSemesterClose(idObjSemester)
+	if err != nil {
+		return nil, err
+	}
+	if semesterClose == nil {
+		modelClose := models.NewModelSemesterClose(idObjSemester, modules)
+		inserted, err := semesterClosesModel.NewDocument(modelClose)
+		if err != nil {
+			return nil, err
+		}
+		modelClose.ID = inserted.InsertedID.(primitive.ObjectID)
+		return &modelClose, nil
+	}
+	if semesterClose.Status == models.CLOSE_COMPLETED {
+		return semesterClose, nil
+	}
+	if semesterClose.Status == models.CLOSE_RUNNING &&
+		time.Since(semesterClose.DateUpdate.Time()) < CLOSE_SEMESTER_TIMEOUT {
+		return nil, fmt.Errorf("el cierre del semestre ya está en ejecución")
+	}
+	// Modules opened after the last attempt
+	for _, module := range modules {
+		var inClose bool
+		for _, closeModule := range semesterClose.Modules {
+			if closeModule.Module == module.ID {
+				inClose = true
+				break
+			}
+		}
+		if !inClose {
+			semesterClose.Modules = append(semesterClose.Modules, models.SemesterCloseModule{
+				Module: module.ID,
+				Status: models.CLOSE_PENDING,
+			})
+		}
+	}
+	now := primitive.NewDateTimeFromTime(time.Now())
+	// Date update as version, only one run claims the close
+	result, err := semesterClosesModel.Use().UpdateOne(db.Ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: semesterClose.ID,
+		},
+		{
+			Key:   "date_update",
+			Value: semesterClose.DateUpdate,
+		},
+	}, bson.D{
+		{
+			Key: "$set",
+			Value: bson.M{
+				"status":      models.CLOSE_RUNNING,
+				"modules":     semesterClose.Modules,
+				"date_update": now,
+			},
+		},
+		{
+			Key: "$inc",
+			Value: bson.M{
+				"attempts": 1,
+			},
+		},
+		{
+			Key: "$unset",
+			Value: bson.M{
+				"error": "",
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.MatchedCount == 0 {
+		return nil, fmt.Errorf("el cierre del semestre ya está en ejecución")
+	}
+	semesterClose.Status = models.CLOSE_RUNNING
+	semesterClose.Attempts++
+	semesterClose.DateUpdate = now
+	semesterClose.Error = ""
+	return semesterClose, nil
+}
+
+func updateCloseModule(semesterClose *models.SemesterClose, index int) error {
+	closeModule := semesterClose.Modules[index]
+	_, err := semesterClosesModel.Use().UpdateOne(db.Ctx, bson.D{
+		{
+			Key:   "_id",
+			Value: semesterClose.ID,
+		},
+		{
+			Key:   "modules.module",
+			Value: closeModule.Module,
+		},
+	}, bson.D{{
+		Key: "$set",
+		Value: bson.M{
+			"modules.$":   closeModule,
+			"date_update": closeModule.Date,
+		},
+	}})
+	return err
+}
+
+func finishSemesterClose(semesterClose *models.SemesterClose, status, errMsg string) error {
+	now := primitive.NewDateTimeFromTime(time.Now())
+	semesterClose.Status = status
+	semesterClose.Error = errMsg
+	semesterClose.DateUpdate = now
+	if status == models.CLOSE_COMPLETED {
+		semesterClose.DateFinish = now
+	}
+
+	_, err := semesterClosesModel.Use().ReplaceOne(db.Ctx, bson.D{{
+		Key:   "_id",
+		Value: semesterClose.ID,
+	}}, semesterClose)
+	return err
+}
+
+// Register the averages of the modules and the average of the
+// student in the semester
+func upsertSemesterAverages(idObjSemester primitive.ObjectID, modules []models.Module) (int, error) {
+	averages := make(map[string][]float64)
+	for _, module := range modules {
+		moduleAverages, err := upsertModuleAverages(module, idObjSemester)
+		if err != nil {
+			return 0, err
+		}
+		for idStudent, average := range moduleAverages {
+			averages[idStudent] = append(averages[idStudent], average)
+		}
+	}
+
+	opts := options.Update().SetUpsert(true)
+	for idStudent, moduleAverages := range averages {
+		idObjStudent, err := primitive.ObjectIDFromHex(idStudent)
+		if err != nil {
+			return 0, err
+		}
+		average := getMeanAverage(moduleAverages)
+
+		_, err = averageModel.Use().UpdateOne(db.Ctx, bson.D{
+			{
+				Key:   "semester",
+				Value: idObjSemester,
+			},
+			{
+				Key:   "student",
+				Value: idObjStudent,
+			},
+		}, bson.D{{
+			Key: "$set",
+			Value: bson.M{
+				"average": *average,
+			},
+		}}, opts)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(averages), nil
+}
+
+func runSemesterClose(semesterClose *models.SemesterClose, modules []models.Module) error {
+	modulesByID := make(map[primitive.ObjectID]models.Module)
+	for _, module := range modules {
+		modulesByID[module.ID] = module
+	}
+
+	progressErrors := make([]error, len(semesterClose.Modules))
+	utils.Concurrency(3, len(semesterClose.Modules), func(index int, setError func(errRes *res.ErrorRes)) {
+		closeModule := &semesterClose.Modules[index]
+		if closeModule.Status == models.CLOSE_COMPLETED {
+			return
+		}
+
+		var filled int
+		module, exists := modulesByID[closeModule.Module]
+		err := fmt.Errorf("no existe el módulo o ya fue cerrado")
+		if exists {
+			var missing *moduleMissingGrades
+			missing, err = getModuleMissingGrades(module)
+			if err == nil {
+				err = fillModuleGrades(missing.grades)
+				filled = len(missing.grades)
+			}
+		}
+		closeModule.Date = primitive.NewDateTimeFromTime(time.Now())
+		if err != nil {
+			closeModule.Status = models.CLOSE_FAILED
+			closeModule.Error = err.Error()
+		} else {
+			closeModule.Status = models.CLOSE_COMPLETED
+			closeModule.Filled = filled
+			closeModule.Error = ""
+		}
+		// The final state of the close keeps the progress anyway
+		progressErrors[index] = updateCloseModule(semesterClose, index)
+	})
+	for _, err := range progressErrors {
+		if err == nil {
+			continue
+		}
+		errMsg := fmt.Sprintf("no se pudo guardar el avance del cierre: %v", err)
+		if err := finishSemesterClose(semesterClose, models.CLOSE_FAILED, errMsg); err != nil {
+			return err
+		}
+		return errors.New(errMsg)
+	}
+
+	var failed int
+	for _, closeModule := range semesterClose.Modules {
+		if closeModule.Status != models.CLOSE_COMPLETED {
+			failed++
+		}
+	}
+	if failed > 0 {
+		errMsg := fmt.Sprintf("no se pudieron cerrar %d módulos", failed)
+		if err := finishSemesterClose(semesterClose, models.CLOSE_FAILED, errMsg); err != nil {
+			return err
+		}
+		return errors.New(errMsg)
+	}
+	// Averages only with all the grades filled
+	var closeModules []models.Module
+	for _, closeModule := range semesterClose.Modules {
+		closeModules = append(closeModules, modulesByID[closeModule.Module])
+	}
+	averages, err := upsertSemesterAverages(semesterClose.Semester, closeModules)
+	if err != nil {
+		errMsg := fmt.Sprintf("no se pudo calcular los promedios: %v", err)
+		if err := finishSemesterClose(semesterClose, models.CLOSE_FAILED, errMsg); err != nil {
+			return err
+		}
+		return errors.New(errMsg)
+	}
+	semesterClose.Averages = averages
+	return finishSemesterClose(semesterClose, models.CLOSE_COMPLETED, "")
+}
+
+func closeSemester() (*models.SemesterClose, error) {
+	semester, err := getCurrentSemester()
+	if err != nil {
+		return nil, err
+	}
+	modules, err := moduleService.GetAllModulesSemester()
+	if err != nil {
+		return nil, err
+	}
+	semesterClose, err := claimSemesterClose(semester.ID, modules)
+	if err != nil {
+		return nil, err
+	}
+	if semesterClose.Status == models.CLOSE_COMPLETED {
+		return semesterClose, nil
+	}
+	if err := runSemesterClose(semesterClose, modules); err != nil {
+		return semesterClose, err
+	}
+	return semesterClose, nil
+}
+
+func closeGrades() {
+	nats.Queue("close_grades_semester", func(m *natsPackage.Msg) {
+		respond := func(report CloseSemesterReport) {
+			jsonResponse, err := json.Marshal(report)
+			if err != nil {
+				return
+			}
+			m.Respond(jsonResponse)
+		}
+		// Recovery if close channel
+		defer func() {
+			recovery := recover()
+			if recovery != nil {
+				respond(CloseSemesterReport{
+					Message: fmt.Sprintf("%v", recovery),
+				})
+			}
+		}()
+
+		var dryRun bool
+		if payload, err := nats.DecodeDataNest(m.Data); err == nil {
+			dryRun, _ = payload["dry_run"].(bool)
+		}
+		if dryRun {
+			preview, errRes := gradesService.PreviewCloseSemester()
+			if errRes != nil {
+				respond(CloseSemesterReport{
+					Message: errRes.Err.Error(),
+				})
+				return
+			}
+			respond(CloseSemesterReport{
+				Success: true,
+				Preview: preview,
+			})
+			return
+		}
+
+		semesterClose, err := closeSemester()
+		report := CloseSemesterReport{
+			Success: err == nil,
+			Close:   semesterClose,
+		}
+		if err != nil {
+			report.Message = err.Error()
+		}
+		respond(report)
+		nats.PublishEncode("close_grades_semester_report", report)
+	})
+}
+
+func (g *GradesService) PreviewCloseSemester() (*CloseSemesterPreview, *res.ErrorRes) {
+	semester, err := getCurrentSemester()
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	modules, err := moduleService.GetAllModulesSemester()
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+
+	preview := &CloseSemesterPreview{
+		Semester: semester.ID.Hex(),
+		Modules:  make([]CloseModulePreview, len(modules)),
+	}
+	utils.Concurrency(3, len(modules), func(index int, setError func(errRes *res.ErrorRes)) {
+		modulePreview := CloseModulePreview{
+			Module: modules[index].ID.Hex(),
+			Grades: []MissingGrade{},
+		}
+		missing, err := getModuleMissingGrades(modules[index])
+		if err != nil {
+			modulePreview.Error = err.Error()
+		} else if missing.preview != nil {
+			modulePreview.Grades = missing.preview
+		}
+		preview.Modules[index] = modulePreview
+	})
+	for _, modulePreview := range preview.Modules {
+		preview.Total += len(modulePreview.Grades)
+	}
+	return preview, nil
+}
+
+func (g *GradesService) GetSemesterClose(idSemester string) (*models.SemesterClose, *res.ErrorRes) {
+	semester, errRes := getSemesterOrCurrent(idSemester)
+	if errRes != nil {
+		return nil, errRes
+	}
+	semesterClose, err := getSemesterClose(semester.ID)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if semesterClose == nil {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("el semestre no ha sido cerrado"),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	return semesterClose, nil
+}
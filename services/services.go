@@ -32,6 +32,20 @@ var (
 	fileModel             = models.NewFileModel()
 	fileUCModel           = models.NewFileUCModel()
 	sessionModel          = models.NewSessionModel()
+	workExtensionModel    = models.NewWorkExtensionsModel()
+	questionBankModel     = models.NewQuestionBankModel()
+	formAttemptsModel     = models.NewFormAttemptsModel()
+	rubricsModel          = models.NewRubricsModel()
+	workFeedbackModel     = models.NewWorkFeedbackModel()
+	gradingScalesModel    = models.NewGradingScalesModel()
+	gradeHistoryModel     = models.NewGradeHistoryModel()
+	workAppealsModel      = models.NewWorkAppealsModel()
+	semesterClosesModel   = models.NewSemesterClosesModel()
+	moduleAverageModel    = models.NewModuleAveragesModel()
+	programTemplatesModel = models.NewGradeProgramTemplatesModel()
+	workSimilaritiesModel = models.NewWorkSimilaritiesModel()
+	peerReviewsModel      = models.NewPeerReviewsModel()
+	workGroupsModel       = models.NewWorkGroupsModel()
 )
 
 // Repositories
@@ -0,0 +1,232 @@
+package services
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+)
+
+// Max size of an uploaded file to extract its text
+const SIMILARITY_MAX_FILE_SIZE = 10 << 20
+
+var (
+	errFileTooLarge    = errors.New("el archivo supera el tamaño máximo para el análisis")
+	errFileUnsupported = errors.New("tipo de archivo no soportado")
+	errFileWithoutText = errors.New("no se pudo extraer texto del archivo")
+)
+
+var pdfStreamRegex = regexp.MustCompile(`stream\r?\n`)
+
+// Text of an uploaded file, only PDF and plain text files are supported
+func getFileText(file models.File) (string, error) {
+	isPDF := file.Type == "application/pdf" || strings.HasSuffix(strings.ToLower(file.Filename), ".pdf")
+	isText := strings.HasPrefix(file.Type, "text/plain") || strings.HasSuffix(strings.ToLower(file.Filename), ".txt")
+	if !isPDF && !isText {
+		return "", errFileUnsupported
+	}
+	body, err := aws.GetFile(file.Key)
+	if err != nil {
+		return "", err
+	}
+	defer body.Close()
+
+	content, err := io.ReadAll(io.LimitReader(body, SIMILARITY_MAX_FILE_SIZE+1))
+	if err != nil {
+		return "", err
+	}
+	if len(content) > SIMILARITY_MAX_FILE_SIZE {
+		return "", errFileTooLarge
+	}
+
+	var text string
+	if isPDF {
+		text = getPDFText(content)
+	} else if utf8.Valid(content) {
+		text = string(content)
+	} else {
+		text = decodeLatin1(content)
+	}
+	if strings.TrimSpace(text) == "" {
+		return "", errFileWithoutText
+	}
+	return text, nil
+}
+
+func decodeLatin1(content []byte) string {
+	runes := make([]rune, len(content))
+	for i, b := range content {
+		runes[i] = rune(b)
+	}
+	return string(runes)
+}
+
+// Best effort text of a PDF, reads the strings shown by the text
+// operators of the uncompressed and flate content streams. Scanned
+// documents or fonts with custom encodings give no (or useless) text
+func getPDFText(content []byte) string {
+	var text strings.Builder
+
+	for _, loc := range pdfStreamRegex.FindAllIndex(content, -1) {
+		end := bytes.Index(content[loc[1]:], []byte("endstream"))
+		if end < 0 {
+			continue
+		}
+		// Dictionary of the stream
+		dictStart := bytes.LastIndex(content[:loc[0]], []byte("obj"))
+		if dictStart < 0 {
+			dictStart = 0
+		}
+		dict := content[dictStart:loc[0]]
+		stream := content[loc[1] : loc[1]+end]
+		if bytes.Contains(dict, []byte("/Filter")) {
+			if !bytes.Contains(dict, []byte("/FlateDecode")) {
+				continue
+			}
+			reader, err := zlib.NewReader(bytes.NewReader(stream))
+			if err != nil {
+				continue
+			}
+			// Corrupted streams may still give part of the text
+			stream, _ = io.ReadAll(io.LimitReader(reader, SIMILARITY_MAX_FILE_SIZE))
+			reader.Close()
+		}
+		text.WriteString(getPDFStreamText(stream))
+	}
+	return text.String()
+}
+
+// Text of the Tj, TJ, ' and " operators of a content stream
+func getPDFStreamText(stream []byte) string {
+	var text strings.Builder
+	var operands []string
+
+	for i := 0; i < len(stream); i++ {
+		switch c := stream[i]; {
+		case c == '(':
+			str, next := readPDFLiteral(stream, i+1)
+			operands = append(operands, str)
+			i = next
+		case c == '<' && i+1 < len(stream) && stream[i+1] != '<':
+			end := bytes.IndexByte(stream[i:], '>')
+			if end < 0 {
+				return text.String()
+			}
+			operands = append(operands, decodePDFHex(stream[i+1:i+end]))
+			i += end
+		case c == '-' && len(operands) > 0:
+			// A large kerning between the strings of a TJ array is
+			// usually a space
+			end := i + 1
+			for end < len(stream) && (stream[end] >= '0' && stream[end] <= '9' || stream[end] == '.') {
+				end++
+			}
+			if kerning, err := strconv.ParseFloat(string(stream[i+1:end]), 64); err == nil && kerning >= 200 {
+				operands = append(operands, " ")
+			}
+			i = end - 1
+		case c == '%':
+			for i < len(stream) && stream[i] != '\n' && stream[i] != '\r' {
+				i++
+			}
+		case isPDFLetter(c) || c == '\'' || c == '"':
+			start := i
+			for i+1 < len(stream) && (isPDFLetter(stream[i+1]) || stream[i+1] == '*') {
+				i++
+			}
+			switch string(stream[start : i+1]) {
+			case "Tj", "TJ", "'", "\"":
+				text.WriteString(strings.Join(operands, ""))
+				text.WriteByte(' ')
+			case "Td", "TD", "T*", "ET":
+				text.WriteByte(' ')
+			}
+			operands = nil
+		}
+	}
+	return text.String()
+}
+
+func isPDFLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// Literal string from the position after its open parenthesis, returns
+// the string and the position of its close parenthesis
+func readPDFLiteral(stream []byte, i int) (string, int) {
+	var str []byte
+	depth := 1
+
+	for ; i < len(stream); i++ {
+		c := stream[i]
+		switch c {
+		case '\\':
+			i++
+			if i >= len(stream) {
+				return decodeLatin1(str), i
+			}
+			switch e := stream[i]; e {
+			case 'n', 'r', 't':
+				str = append(str, ' ')
+			case 'b', 'f', '\n', '\r':
+			case '0', '1', '2', '3', '4', '5', '6', '7':
+				var octal byte
+				for j := 0; j < 3 && i < len(stream) && stream[i] >= '0' && stream[i] <= '7'; j++ {
+					octal = octal*8 + stream[i] - '0'
+					i++
+				}
+				i--
+				str = append(str, octal)
+			default:
+				str = append(str, e)
+			}
+			continue
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return decodeLatin1(str), i
+			}
+		}
+		str = append(str, c)
+	}
+	return decodeLatin1(str), i
+}
+
+func decodePDFHex(hex []byte) string {
+	var str []byte
+	var digits []byte
+	for _, c := range hex {
+		switch {
+		case c >= '0' && c <= '9':
+			digits = append(digits, c-'0')
+		case c >= 'a' && c <= 'f':
+			digits = append(digits, c-'a'+10)
+		case c >= 'A' && c <= 'F':
+			digits = append(digits, c-'A'+10)
+		}
+	}
+	if len(digits)%2 != 0 {
+		digits = append(digits, 0)
+	}
+	for i := 0; i < len(digits); i += 2 {
+		str = append(str, digits[i]<<4|digits[i+1])
+	}
+	// Two bytes glyphs (Identity-H fonts) are usually ASCII in the
+	// second byte
+	if len(str) > 1 && str[0] == 0 {
+		var ascii []byte
+		for i := 1; i < len(str); i += 2 {
+			ascii = append(ascii, str[i])
+		}
+		str = ascii
+	}
+	return decodeLatin1(str)
+}
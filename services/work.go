@@ -8,6 +8,7 @@ import (
 	"io"
 	"mime/multipart"
 	"net/http"
+	"path"
 	"sort"
 	"strings"
 	"sync"
@@ -62,16 +63,80 @@ func (w *WorkSerice) GetModulesWorks(claims Claims) ([]WorkStatus, *res.ErrorRes
 			"module": module.ID,
 		})
 	}
+	// Get extensions
+	var extensions []models.WorkExtension
+	cursorE, err := workExtensionModel.GetAll(bson.D{
+		{
+			Key:   "student",
+			Value: idObjUser,
+		},
+		{
+			Key: "date_limit",
+			Value: bson.M{
+				"$gte": primitive.NewDateTimeFromTime(time.Now()),
+			},
+		},
+	}, &options.FindOptions{})
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if err := cursorE.All(db.Ctx, &extensions); err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	extensionsDate := make(map[primitive.ObjectID]primitive.DateTime)
+	idWorksExtension := bson.A{}
+	for _, extension := range extensions {
+		extensionsDate[extension.Work] = extension.DateLimit
+		idWorksExtension = append(idWorksExtension, extension.Work)
+	}
 	// Get works
 	var works []models.Work
+	and := bson.A{bson.M{
+		"$or": bson.A{
+			bson.M{
+				"date_limit": bson.M{
+					"$gte": primitive.NewDateTimeFromTime(time.Now()),
+				},
+			},
+			bson.M{
+				"_id": bson.M{
+					"$in": idWorksExtension,
+				},
+			},
+		},
+	}}
+	if !canSeeDraftWorks(&claims) {
+		and = append(and, publishedWorkFilter())
+	}
+	// Due drafts
+	var idObjModules bson.A
+	for _, module := range modules {
+		idObjModules = append(idObjModules, module.ID)
+	}
+	err = w.publishDueWorks(bson.D{{
+		Key: "module",
+		Value: bson.M{
+			"$in": idObjModules,
+		},
+	}})
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	match := bson.D{{
 		Key: "$match",
 		Value: bson.M{
 			"$or":        modulesOr,
 			"is_revised": false,
-			"date_limit": bson.M{
-				"$gte": primitive.NewDateTimeFromTime(time.Now()),
-			},
+			"$and":       and,
 		},
 	}}
 	sortA := bson.D{{
@@ -90,6 +155,7 @@ func (w *WorkSerice) GetModulesWorks(claims Claims) ([]WorkStatus, *res.ErrorRes
 			"date_limit":   1,
 			"date_upload":  1,
 			"module":       1,
+			"groups":       1,
 			"_id":          1,
 		},
 	}}
@@ -132,6 +198,19 @@ func (w *WorkSerice) GetModulesWorks(claims Claims) ([]WorkStatus, *res.ErrorRes
 				DateLimit:   work.DateLimit.Time(),
 				DateUpload:  work.DateUpload.Time(),
 			}
+			if dateLimit, ok := extensionsDate[work.ID]; ok {
+				workStatus[i].DateLimit = dateLimit.Time()
+			}
+			// Submission of the group of the student
+			idObjSubmitter, err := w.getSubmitter(&work, idObjUser)
+			if err != nil {
+				errRet = &res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusServiceUnavailable,
+				}
+				close(c)
+				return
+			}
 			if work.Type == "files" {
 				var fUC *models.FileUploadedClassroom
 
@@ -142,7 +221,7 @@ func (w *WorkSerice) GetModulesWorks(claims Claims) ([]WorkStatus, *res.ErrorRes
 					},
 					{
 						Key:   "student",
-						Value: idObjUser,
+						Value: idObjSubmitter,
 					},
 				})
 				if err := cursor.Decode(&fUC); err != nil && err.Error() != db.NO_SINGLE_DOCUMENT {
@@ -166,7 +245,7 @@ func (w *WorkSerice) GetModulesWorks(claims Claims) ([]WorkStatus, *res.ErrorRes
 					},
 					{
 						Key:   "student",
-						Value: idObjUser,
+						Value: idObjSubmitter,
 					},
 				})
 				if err := cursor.Decode(&formAccess); err != nil && err.Error() != db.NO_SINGLE_DOCUMENT {
@@ -199,7 +278,7 @@ func (w *WorkSerice) GetModulesWorks(claims Claims) ([]WorkStatus, *res.ErrorRes
 	return workStatus, nil
 }
 
-func (w *WorkSerice) GetWorks(idModule string) ([]models.WorkWLookup, *res.ErrorRes) {
+func (w *WorkSerice) GetWorks(idModule string, claims *Claims) ([]models.WorkWLookup, *res.ErrorRes) {
 	idObjModule, err := primitive.ObjectIDFromHex(idModule)
 	if err != nil {
 		return nil, &res.ErrorRes{
@@ -207,8 +286,25 @@ func (w *WorkSerice) GetWorks(idModule string) ([]models.WorkWLookup, *res.Error
 			StatusCode: http.StatusBadRequest,
 		}
 	}
+	filter := bson.M{
+		"module": idObjModule,
+	}
+	if !canSeeDraftWorks(claims) {
+		filter["$and"] = bson.A{publishedWorkFilter()}
+	}
+	// Due drafts
+	err = w.publishDueWorks(bson.D{{
+		Key:   "module",
+		Value: idObjModule,
+	}})
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	// Get
-	works, errRes := workRepository.GetWorks(idObjModule)
+	works, errRes := workRepository.GetWorks(filter)
 	if errRes != nil {
 		return nil, errRes
 	}
@@ -235,6 +331,22 @@ func (w *WorkSerice) GetWork(idWork string, claims *Claims) (map[string]interfac
 	if errRes != nil {
 		return nil, errRes
 	}
+	if !canSeeDraftWorks(claims) && !isWorkPublished(work.Draft, work.DatePublish) {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("no existe el trabajo indicado"),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	if work.Draft && isWorkPublished(work.Draft, work.DatePublish) {
+		if err := w.publishDueWork(idObjWork); err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		work.Draft = false
+		work.DatePublish = 0
+	}
 	if time.Now().Before(work.DateStart.Time()) && (claims.UserType == models.STUDENT || claims.UserType == models.STUDENT_DIRECTIVE) {
 		return nil, &res.ErrorRes{
 			Err:        fmt.Errorf("no se puede acceder a este trabajo todavía"),
@@ -253,8 +365,15 @@ func (w *WorkSerice) GetWork(idWork string, claims *Claims) (map[string]interfac
 	// Response
 	response := make(map[string]interface{})
 	response["work"] = work
-	// Get grade
+	// Get grade, draft grades and its feedback are hidden until released
+	var gradeReleased bool
 	if work.IsRevised && (claims.UserType == models.STUDENT || claims.UserType == models.STUDENT_DIRECTIVE) {
+		if err := w.releaseDueWorkGrades(idObjWork); err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
 		lookup := bson.D{{
 			Key: "$lookup",
 			Value: bson.M{
@@ -292,6 +411,9 @@ func (w *WorkSerice) GetWork(idWork string, claims *Claims) (map[string]interfac
 			if work.Grade.IsAcumulative {
 				matchValue["acumulative"] = work.Acumulative
 			}
+			for key, value := range releasedFilter() {
+				matchValue[key] = value
+			}
 			match := bson.D{{
 				Key:   "$match",
 				Value: matchValue,
@@ -323,16 +445,24 @@ func (w *WorkSerice) GetWork(idWork string, claims *Claims) (map[string]interfac
 					StatusCode: http.StatusServiceUnavailable,
 				}
 			}
-			response["grade"] = grade[0]
+			if len(grade) > 0 {
+				response["grade"] = grade[0]
+				gradeReleased = true
+			}
 		} else {
 			var grade []models.WorkGradeWLookup
+
+			matchValue := bson.M{
+				"module":  work.Module,
+				"student": idObjUser,
+				"work":    work.ID,
+			}
+			for key, value := range releasedFilter() {
+				matchValue[key] = value
+			}
 			match := bson.D{{
-				Key: "$match",
-				Value: bson.M{
-					"module":  work.Module,
-					"student": idObjUser,
-					"work":    work.ID,
-				},
+				Key:   "$match",
+				Value: matchValue,
 			}}
 			cursor, err := workGradeModel.Aggreagate(mongo.Pipeline{
 				match,
@@ -351,7 +481,10 @@ func (w *WorkSerice) GetWork(idWork string, claims *Claims) (map[string]interfac
 					StatusCode: http.StatusServiceUnavailable,
 				}
 			}
-			response["grade"] = grade[0]
+			if len(grade) > 0 {
+				response["grade"] = grade[0]
+				gradeReleased = true
+			}
 		}
 	}
 	// Get form
@@ -367,17 +500,25 @@ func (w *WorkSerice) GetWork(idWork string, claims *Claims) (map[string]interfac
 	}
 	// Student
 	if claims.UserType == models.STUDENT || claims.UserType == models.STUDENT_DIRECTIVE {
-		if work.Type == "form" {
-			// Student access
-			idObjStudent, err := primitive.ObjectIDFromHex(claims.ID)
+		// Group of the student, its members share the submission
+		idObjSubmitter := idObjUser
+		if work.Groups != nil {
+			group, err := w.getStudentGroup(idObjWork, idObjUser)
 			if err != nil {
 				return nil, &res.ErrorRes{
 					Err:        err,
 					StatusCode: http.StatusServiceUnavailable,
 				}
 			}
+			if group != nil {
+				idObjSubmitter = group.Members[0]
+			}
+			response["group"] = group
+		}
+		if work.Type == "form" {
+			// Student access
 			formAccess, err := w.getAccessFromIdStudentNIdWork(
-				idObjStudent,
+				idObjSubmitter,
 				idObjWork,
 			)
 			if err != nil && err.Error() != db.NO_SINGLE_DOCUMENT {
@@ -389,7 +530,7 @@ func (w *WorkSerice) GetWork(idWork string, claims *Claims) (map[string]interfac
 			response["form_access"] = formAccess
 		} else if work.Type == "files" {
 			// Get files uploaded
-			fUC, err := w.getFilesUploadedStudent(idObjUser, idObjWork)
+			fUC, err := w.getFilesUploadedStudent(idObjSubmitter, idObjWork)
 			if err != nil {
 				return nil, &res.ErrorRes{
 					Err:        err,
@@ -398,10 +539,35 @@ func (w *WorkSerice) GetWork(idWork string, claims *Claims) (map[string]interfac
 			}
 			if len(fUC) > 0 {
 				response["files_uploaded"] = fUC[0]
+				if gradeReleased {
+					response["rubric"] = w.getFilledRubric(work.Pattern, fUC[0].Evaluate)
+				}
 			} else {
 				response["files_uploaded"] = nil
 			}
 		}
+		// Feedback, it's published with the grades
+		if gradeReleased {
+			feedback, err := w.getFeedbackStudent(idObjWork, idObjUser)
+			if err != nil {
+				return nil, &res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusServiceUnavailable,
+				}
+			}
+			response["feedback"] = feedback
+		}
+	}
+	// Appeals
+	if work.IsRevised {
+		appeals, err := w.getAppealsUser(idObjWork, claims)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		response["appeals"] = appeals
 	}
 	return response, nil
 }
@@ -459,6 +625,10 @@ func (w *WorkSerice) getStudentEvaluate(
 		}
 	}()
 
+	// Without questions there is nothing to evaluate
+	if len(questions) == 0 {
+		return 0, 100, nil
+	}
 	var err error
 	var wg sync.WaitGroup
 	var lock sync.Mutex
@@ -470,7 +640,7 @@ func (w *WorkSerice) getStudentEvaluate(
 		c <- 1
 		go func(question models.ItemQuestion, wg *sync.WaitGroup, lock *sync.Mutex, errRet *error) {
 			defer wg.Done()
-			if question.Type == "alternatives_correct" {
+			if question.Type != "written" && question.Type != "alternatives" {
 				answer, err := w.getAnswerStudent(idStudent, idWork, question.ID)
 				lock.Lock()
 				evaluatedSum += 1
@@ -478,15 +648,16 @@ func (w *WorkSerice) getStudentEvaluate(
 				if err != nil {
 					if err.Error() != db.NO_SINGLE_DOCUMENT {
 						*errRet = err
+						close(c)
+						return
 					}
-					close(c)
+					<-c
 					return
 				}
-				if question.Correct == answer.Answer {
-					lock.Lock()
-					totalPoints += question.Points
-					lock.Unlock()
-				}
+				points := w.getAnswerPoints(&question, answer)
+				lock.Lock()
+				totalPoints += points
+				lock.Unlock()
 			} else if question.Type == "written" {
 				var evaluateAnswer *models.EvaluatedAnswers
 				cursor := evaluatedAnswersModel.GetOne(bson.D{
@@ -678,11 +849,7 @@ func (w *WorkSerice) GetStudentsStatus(
 				StatusCode: http.StatusServiceUnavailable,
 			}
 		}
-		for _, question := range questions {
-			if question.Type != "alternatives" {
-				questionsWPoints = append(questionsWPoints, question)
-			}
-		}
+		questionsWPoints = w.getQuestionsWPoints(work, questions)
 	}
 	for i, student := range students {
 		wg.Add(1)
@@ -690,10 +857,29 @@ func (w *WorkSerice) GetStudentsStatus(
 		go func(student Student, index int, wg *sync.WaitGroup, errRet *error) {
 			defer wg.Done()
 			idObjStudent, _ := primitive.ObjectIDFromHex(student.User.ID)
+			// Student extension
+			workStudent, err := w.getWorkStudent(work, idObjStudent)
+			if err != nil {
+				*errRet = err
+				return
+			}
+			// Group of the student, its members share the submission
+			idObjSubmitter := idObjStudent
+			if work.Groups != nil {
+				group, err := w.getStudentGroup(idObjWork, idObjStudent)
+				if err != nil {
+					*errRet = err
+					return
+				}
+				if group != nil {
+					students[index].Group = group
+					idObjSubmitter = group.Members[0]
+				}
+			}
 			if work.Type == "form" {
 				// Get access
 				access, err := w.getAccessFromIdStudentNIdWork(
-					idObjStudent,
+					idObjSubmitter,
 					idObjWork,
 				)
 				if err != nil {
@@ -703,10 +889,11 @@ func (w *WorkSerice) GetStudentsStatus(
 					return
 				}
 				students[index].AccessForm = access
+				students[index].Late = w.getDateSubmitForm(workStudent, access).After(workStudent.DateLimit.Time())
 				// Response evaluate
 				pointsTotal, answereds, err := w.getStudentEvaluate(
-					questionsWPoints,
-					idObjStudent,
+					w.getQuestionsStudent(questionsWPoints, access),
+					idObjSubmitter,
 					idObjWork,
 				)
 				if err != nil {
@@ -722,13 +909,17 @@ func (w *WorkSerice) GetStudentsStatus(
 				students[index].Evuluate = evaluate
 			} else if work.Type == "files" {
 				// Get files uploaded
-				fUC, err := w.getFilesUploadedStudent(idObjStudent, idObjWork)
+				fUC, err := w.getFilesUploadedStudent(idObjSubmitter, idObjWork)
 				if err != nil {
 					*errRet = err
 					return
 				}
 				if len(fUC) > 0 {
 					students[index].FilesUploaded = &fUC[0]
+					students[index].Late = w.getDateSubmitFiles(
+						fUC[0].Date,
+						fUC[0].DateUpdate,
+					).After(workStudent.DateLimit.Time())
 				} else {
 					students[index].FilesUploaded = nil
 				}
@@ -740,7 +931,7 @@ func (w *WorkSerice) GetStudentsStatus(
 					bson.D{{
 						Key: "$match",
 						Value: bson.M{
-							"student": idObjStudent,
+							"student": idObjSubmitter,
 							"work":    idObjWork,
 						},
 					}},
@@ -832,14 +1023,6 @@ func (w *WorkSerice) GetStudentsStatus(
 }
 
 func (w *WorkSerice) DownloadFilesWorkStudent(idWork, idStudent string, writter io.Writer) (*zip.Writer, *res.ErrorRes) {
-	// Recovery if close channel
-	defer func() {
-		recovery := recover()
-		if recovery != nil {
-			fmt.Printf("A channel closed")
-		}
-	}()
-
 	idObjWork, err := primitive.ObjectIDFromHex(idWork)
 	if err != nil {
 		return nil, &res.ErrorRes{
@@ -854,6 +1037,41 @@ func (w *WorkSerice) DownloadFilesWorkStudent(idWork, idStudent string, writter
 			StatusCode: http.StatusBadRequest,
 		}
 	}
+	// Get work
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	// Files of the group of the student
+	idObjSubmitter, err := w.getSubmitter(work, idObjStudent)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return w.downloadFilesUploaded(idObjWork, idObjSubmitter, false, writter)
+}
+
+// Zip of the files uploaded by the student, the files of an anonymous
+// zip are named by their number
+func (w *WorkSerice) downloadFilesUploaded(
+	idObjWork,
+	idObjStudent primitive.ObjectID,
+	anonymous bool,
+	writter io.Writer,
+) (*zip.Writer, *res.ErrorRes) {
+	// Recovery if close channel
+	defer func() {
+		recovery := recover()
+		if recovery != nil {
+			fmt.Printf("A channel closed")
+		}
+	}()
+
 	// Get files
 	fUC, err := w.getFilesUploadedStudent(idObjStudent, idObjWork)
 	if err != nil {
@@ -887,9 +1105,13 @@ func (w *WorkSerice) DownloadFilesWorkStudent(idWork, idStudent string, writter
 				*errRet = err
 				return
 			}
+			name := file.Filename
+			if anonymous {
+				name = fmt.Sprintf("archivo_%d%s", i+1, path.Ext(file.Filename))
+			}
 			files[i] = File{
 				file: bytes,
-				name: file.Filename,
+				name: name,
 			}
 			<-c
 		}(file, i, &wg, &err)
@@ -961,6 +1183,9 @@ func (w *WorkSerice) verifyGradeWork(idObjModule, idObjGrade primitive.ObjectID)
 		}
 	}
 	gradeRet.Grade = grade.ID
+	if grade.Module != idObjModule {
+		return nil, fmt.Errorf("la calificación indicada no pertenece a este módulo")
+	}
 	// Not used
 	var work *models.Work
 	if !gradeRet.IsAcumulative {
@@ -1040,6 +1265,13 @@ func (w *WorkSerice) UploadWork(
 			StatusCode: http.StatusBadRequest,
 		}
 	}
+	datePublish, err := parsePublishDate(work.DatePublish, tLimit)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
 	// Get module
 	module, err := moduleService.GetModuleFromID(idModule)
 	if err != nil {
@@ -1077,6 +1309,22 @@ func (w *WorkSerice) UploadWork(
 			work.Grade = grade.Grade.Hex()
 		}
 	}
+	// Pattern
+	var rubricPattern []models.WorkPattern
+	if work.Type == "files" {
+		if work.Rubric != "" {
+			var errRes *res.ErrorRes
+			rubricPattern, errRes = rubricService.getPatternFromRubric(work.Rubric, idObjUser)
+			if errRes != nil {
+				return errRes
+			}
+		} else if len(work.Pattern) == 0 {
+			return &res.ErrorRes{
+				Err:        fmt.Errorf("un trabajo de archivos debe tener una pauta o una rúbrica"),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+	}
 	// Form
 	if work.Type == "form" {
 		idObjForm, err := primitive.ObjectIDFromHex(work.Form)
@@ -1112,6 +1360,24 @@ func (w *WorkSerice) UploadWork(
 				StatusCode: http.StatusBadRequest,
 			}
 		}
+		if work.FormShuffle != nil {
+			err := w.checkFormShuffle(models.NewModelWorkFormShuffle(work.FormShuffle), form)
+			if err != nil {
+				return &res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusBadRequest,
+				}
+			}
+		}
+		if work.Attempts != nil {
+			err := w.checkAttempts(models.NewModelWorkAttempts(work.Attempts), form.ID)
+			if err != nil {
+				return &res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusBadRequest,
+				}
+			}
+		}
 	}
 	// Insert
 	modelWork, err := models.NewModelWork(
@@ -1127,6 +1393,16 @@ func (w *WorkSerice) UploadWork(
 			StatusCode: http.StatusBadRequest,
 		}
 	}
+	if err := w.checkPeerReview(modelWork); err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	if rubricPattern != nil {
+		modelWork.Pattern = rubricPattern
+	}
+	setWorkDraft(modelWork, work.Draft, datePublish)
 	insertedWork, err := workModel.NewDocument(modelWork)
 	if err != nil {
 		return &res.ErrorRes{
@@ -1134,14 +1410,40 @@ func (w *WorkSerice) UploadWork(
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
+	modelWork.ID = insertedWork.InsertedID.(primitive.ObjectID)
+	if err := w.schedulePeerReviews(modelWork); err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	// Drafts are indexed and notified once published
+	if modelWork.Draft {
+		if err := w.scheduleWork(modelWork); err != nil {
+			return &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		return nil
+	}
+	return w.publishWork(modelWork, module, claims.Name)
+}
+
+// Index the work in Elasticsearch and notify the students of the module
+func (w *WorkSerice) publishWork(
+	work *models.Work,
+	module *models.Module,
+	author string,
+) *res.ErrorRes {
 	// Insert Elasticsearch
 	indexerWork := &models.WorkES{
 		Title:       work.Title,
 		Description: work.Description,
-		DateStart:   tStart,
-		DateLimit:   tLimit,
-		Author:      claims.Name,
-		IDModule:    idModule,
+		DateStart:   work.DateStart.Time(),
+		DateLimit:   work.DateLimit.Time(),
+		Author:      author,
+		IDModule:    work.Module.Hex(),
 		Published:   time.Now(),
 	}
 	data, err := json.Marshal(indexerWork)
@@ -1152,7 +1454,6 @@ func (w *WorkSerice) UploadWork(
 		}
 	}
 	// Add item to the BulkIndexer
-	oid, _ := insertedWork.InsertedID.(primitive.ObjectID)
 	bi, err := models.NewBulkWork()
 	if err != nil {
 		return &res.ErrorRes{
@@ -1164,7 +1465,7 @@ func (w *WorkSerice) UploadWork(
 		context.Background(),
 		esutil.BulkIndexerItem{
 			Action:     "index",
-			DocumentID: oid.Hex(),
+			DocumentID: work.ID.Hex(),
 			Body:       bytes.NewReader(data),
 		},
 	)
@@ -1185,8 +1486,8 @@ func (w *WorkSerice) UploadWork(
 		Title: work.Title,
 		Link: fmt.Sprintf(
 			"/aula_virtual/clase/%s/trabajos/%s",
-			idModule,
-			insertedWork.InsertedID.(primitive.ObjectID).Hex(),
+			work.Module.Hex(),
+			work.ID.Hex(),
 		),
 		Where: module.Subject.Hex(),
 		Room:  module.Section.Hex(),
@@ -1195,6 +1496,37 @@ func (w *WorkSerice) UploadWork(
 	return nil
 }
 
+// Check the answers list of multiple_select, matching and ordering questions
+func (w *WorkSerice) checkAnswers(question *models.ItemQuestion, answers []int) error {
+	lenOptions := len(question.Answers)
+	if question.Type == "matching" {
+		lenOptions = len(question.Options)
+	}
+	switch question.Type {
+	case "multiple_select":
+		if len(answers) == 0 {
+			return fmt.Errorf("seleccione al menos una respuesta")
+		}
+	case "matching", "ordering":
+		if len(answers) != len(question.Answers) {
+			return fmt.Errorf("debe responder todos los elementos de la pregunta")
+		}
+	default:
+		return fmt.Errorf("esta pregunta no admite una lista de respuestas")
+	}
+	seen := make(map[int]bool)
+	for _, answer := range answers {
+		if answer < 0 || answer >= lenOptions {
+			return fmt.Errorf("indique una respuesta válida")
+		}
+		if seen[answer] && question.Type != "matching" {
+			return fmt.Errorf("no se puede repetir una respuesta")
+		}
+		seen[answer] = true
+	}
+	return nil
+}
+
 func (w *WorkSerice) saveAnswer(
 	answer *forms.AnswerForm,
 	idObjWork,
@@ -1233,13 +1565,35 @@ func (w *WorkSerice) saveAnswer(
 	if err := cursor.Decode(&question); err != nil {
 		return err
 	}
+	// Student permutation, answers are stored with the canonical indexes
+	access, err := w.getAccessFromIdStudentNIdWork(idObjStudent, idObjWork)
+	if err != nil && err.Error() != db.NO_SINGLE_DOCUMENT {
+		return err
+	}
+	if access != nil && access.Permutation != nil {
+		if !w.hasQuestionPermutation(access.Permutation, idObjQuestion) {
+			return fmt.Errorf("la pregunta no pertenece a tu formulario")
+		}
+		answer, err = w.answerToCanonical(
+			answer,
+			w.getAnswersOrder(access.Permutation, idObjQuestion),
+		)
+		if err != nil {
+			return err
+		}
+	}
 	lenAnswers := len(question.Answers)
 
 	if question.Type != "written" && answer.Answer != nil {
-		if lenAnswers <= *answer.Answer || lenAnswers < 0 {
+		if lenAnswers <= *answer.Answer || *answer.Answer < 0 {
 			return fmt.Errorf("indique una respuesta válida")
 		}
 	}
+	if answer.Answers != nil {
+		if err := w.checkAnswers(question, answer.Answers); err != nil {
+			return err
+		}
+	}
 	// Get answer
 	var answerData *models.Answer
 	cursor = answerModel.GetOne(bson.D{
@@ -1260,7 +1614,7 @@ func (w *WorkSerice) saveAnswer(
 		return err
 	}
 	// Save
-	if answer.Answer == nil && answer.Response == "" {
+	if answer.Answer == nil && answer.Response == "" && answer.Answers == nil && answer.Number == nil {
 		if answerData == nil {
 			return fmt.Errorf("la respuesta no existe para ser eliminada")
 		}
@@ -1275,7 +1629,16 @@ func (w *WorkSerice) saveAnswer(
 		if question.Type == "written" && answer.Response == "" {
 			return fmt.Errorf("no se puede insertar una respuesta de alternativa a una pregunta de escritura")
 		}
-		if question.Type != "written" && answer.Answer == nil {
+		if question.Type == "numeric" && answer.Number == nil {
+			return fmt.Errorf("la pregunta numérica requiere una respuesta numérica")
+		}
+		if (question.Type == "multiple_select" || question.Type == "matching" || question.Type == "ordering") &&
+			answer.Answers == nil {
+			return fmt.Errorf("la pregunta requiere una lista de respuestas")
+		}
+		if (question.Type == "alternatives" ||
+			question.Type == "alternatives_correct" ||
+			question.Type == "true_false") && answer.Answer == nil {
 			return fmt.Errorf("no se puede insertar una respuesta escrita a una pregunta de alternativas")
 		}
 		if answerData == nil {
@@ -1293,6 +1656,10 @@ func (w *WorkSerice) saveAnswer(
 			setBson := bson.M{}
 			if answer.Answer != nil {
 				setBson["answer"] = answer.Answer
+			} else if answer.Answers != nil {
+				setBson["answers"] = answer.Answers
+			} else if answer.Number != nil {
+				setBson["number"] = answer.Number
 			} else {
 				setBson["response"] = answer.Response
 			}
@@ -1338,18 +1705,37 @@ func (w *WorkSerice) SaveAnswer(answer *forms.AnswerForm, idWork, idQuestion, id
 			StatusCode: http.StatusBadRequest,
 		}
 	}
+	if errRes := w.checkPublishedWork(work); errRes != nil {
+		return errRes
+	}
+	// Student extension
+	work, err = w.getWorkStudent(work, idObjStudent)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	if work.Type != "form" {
 		return &res.ErrorRes{
 			Err:        fmt.Errorf("el trabajo no es de tipo formulario"),
 			StatusCode: http.StatusBadRequest,
 		}
 	}
-	if time.Now().After(work.DateLimit.Time()) {
+	if time.Now().After(w.getLateDateLimit(work)) {
 		return &res.ErrorRes{
 			Err:        fmt.Errorf("ya no se puede acceder al formulario"),
 			StatusCode: http.StatusUnauthorized,
 		}
 	}
+	// Members of a group answer the form of the group
+	idObjStudent, err = w.getSubmitter(work, idObjStudent)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	// Get access
 	formAcess, err := w.getAccessFromIdStudentNIdWork(
 		idObjStudent,
@@ -1415,6 +1801,17 @@ func (w *WorkSerice) UploadFiles(files []*multipart.FileHeader, idWork, idUser s
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
+	if errRes := w.checkPublishedWork(work); errRes != nil {
+		return errRes
+	}
+	// Student extension
+	work, err = w.getWorkStudent(work, idObjUser)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	now := time.Now()
 	if now.Before(work.DateStart.Time()) {
 		return &res.ErrorRes{
@@ -1422,12 +1819,28 @@ func (w *WorkSerice) UploadFiles(files []*multipart.FileHeader, idWork, idUser s
 			StatusCode: http.StatusUnauthorized,
 		}
 	}
-	if now.After(work.DateLimit.Time().Add(7*24*time.Hour)) || work.IsRevised {
+	// Without late policy files are received up to a week after the limit
+	dateLimit := work.DateLimit.Time().Add(7 * 24 * time.Hour)
+	if work.LatePolicy != nil {
+		dateLimit = w.getLateDateLimit(work)
+	}
+	if now.After(dateLimit) || work.IsRevised {
 		return &res.ErrorRes{
 			Err:        fmt.Errorf("ya no se pueden subir archivos a este trabajo"),
 			StatusCode: http.StatusUnauthorized,
 		}
 	}
+	// Members of a group upload the files of the group
+	if errRes := w.checkGroupSubmission(work, idObjUser); errRes != nil {
+		return errRes
+	}
+	idObjSubmitter, err := w.getSubmitter(work, idObjUser)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	// Get files uploaded
 	var fUC *models.FileUploadedClassroom
 	cursor := fileUCModel.GetOne(bson.D{
@@ -1437,7 +1850,7 @@ func (w *WorkSerice) UploadFiles(files []*multipart.FileHeader, idWork, idUser s
 		},
 		{
 			Key:   "student",
-			Value: idObjUser,
+			Value: idObjSubmitter,
 		},
 	})
 	if err := cursor.Decode(&fUC); err != nil && err.Error() != db.NO_SINGLE_DOCUMENT {
@@ -1522,7 +1935,7 @@ func (w *WorkSerice) UploadFiles(files []*multipart.FileHeader, idWork, idUser s
 	if fUC == nil {
 		modelFileUC := models.NewModelFileUC(
 			idObjWork,
-			idObjUser,
+			idObjSubmitter,
 			filesIds,
 		)
 		_, err = fileUCModel.NewDocument(modelFileUC)
@@ -1533,14 +1946,22 @@ func (w *WorkSerice) UploadFiles(files []*multipart.FileHeader, idWork, idUser s
 			}
 		}
 	} else {
-		_, err = fileUCModel.Use().UpdateByID(db.Ctx, fUC.ID, bson.D{{
-			Key: "$push",
-			Value: bson.M{
-				"files_uploaded": bson.M{
-					"$each": filesIds,
+		_, err = fileUCModel.Use().UpdateByID(db.Ctx, fUC.ID, bson.D{
+			{
+				Key: "$push",
+				Value: bson.M{
+					"files_uploaded": bson.M{
+						"$each": filesIds,
+					},
 				},
 			},
-		}})
+			{
+				Key: "$set",
+				Value: bson.M{
+					"date_update": primitive.NewDateTimeFromTime(now),
+				},
+			},
+		})
 		if err != nil {
 			return &res.ErrorRes{
 				Err:        err,
@@ -1582,13 +2003,32 @@ func (w *WorkSerice) FinishForm(answers *forms.AnswersForm, idWork, idStudent st
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
+	if errRes := w.checkPublishedWork(work); errRes != nil {
+		return errRes
+	}
+	// Student extension
+	work, err = w.getWorkStudent(work, idObjStudent)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	now := time.Now()
-	if work.DateLimit.Time().Add(time.Minute * 5).Before(now) {
+	if w.getLateDateLimit(work).Add(time.Minute * 5).Before(now) {
 		return &res.ErrorRes{
 			Err:        fmt.Errorf("ya no se pueden modificar las respuestas de este formulario"),
 			StatusCode: http.StatusUnauthorized,
 		}
 	}
+	// Members of a group answer the form of the group
+	idObjStudent, err = w.getSubmitter(work, idObjStudent)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	// Save answers
 	var wg sync.WaitGroup
 	c := make(chan (int), 5)
@@ -1605,6 +2045,8 @@ func (w *WorkSerice) FinishForm(answers *forms.AnswersForm, idWork, idStudent st
 			c <- 1
 			answer := &forms.AnswerForm{
 				Answer:   answer.Answer,
+				Answers:  answer.Answers,
+				Number:   answer.Number,
 				Response: answer.Response,
 			}
 			go func(
@@ -1647,7 +2089,8 @@ func (w *WorkSerice) FinishForm(answers *forms.AnswersForm, idWork, idStudent st
 		bson.D{{
 			Key: "$set",
 			Value: bson.M{
-				"status": "finished",
+				"status":      "finished",
+				"date_finish": primitive.NewDateTimeFromTime(now),
 			},
 		}},
 	)
@@ -1663,6 +2106,7 @@ func (w *WorkSerice) FinishForm(answers *forms.AnswersForm, idWork, idStudent st
 type StudentGrades struct {
 	ID          primitive.ObjectID
 	Grade       float64
+	LatePenalty float64
 	ExistsGrade bool
 }
 
@@ -1671,10 +2115,12 @@ func (w *WorkSerice) gradeEvaluatedWork(
 	work *models.Work,
 	idObjUser primitive.ObjectID,
 	program *models.GradesProgram,
+	draft bool,
 ) error {
 	type UpdateGrade struct {
-		Student primitive.ObjectID
-		Grade   float64
+		Student     primitive.ObjectID
+		Grade       float64
+		LatePenalty float64
 	}
 	// Generate models
 	var modelsGrades []interface{}
@@ -1690,51 +2136,71 @@ func (w *WorkSerice) gradeEvaluatedWork(
 				student.Grade,
 				program.IsAcumulative,
 			)
+			modelGrade.LatePenalty = student.LatePenalty
+			modelGrade.Draft = draft
 			modelsGrades = append(modelsGrades, modelGrade)
 		} else {
 			updates = append(updates, UpdateGrade{
-				Student: student.ID,
-				Grade:   student.Grade,
+				Student:     student.ID,
+				Grade:       student.Grade,
+				LatePenalty: student.LatePenalty,
 			})
 		}
 	}
 	// Insert grades
 	if len(modelsGrades) > 0 {
-		_, err := gradeModel.Use().InsertMany(db.Ctx, modelsGrades)
+		inserted, err := gradeModel.Use().InsertMany(db.Ctx, modelsGrades)
 		if err != nil {
 			return err
 		}
-		// Update grades
-		for _, update := range updates {
-			filter := bson.D{
-				{
-					Key:   "module",
-					Value: work.Module,
-				},
-				{
-					Key:   "student",
-					Value: update.Student,
-				},
-				{
-					Key:   "program",
-					Value: program.ID,
-				},
-			}
-			if program.IsAcumulative {
-				filter = append(filter, bson.E{
-					Key:   "acumulative",
-					Value: program.Acumulative,
-				})
-			}
-			_, err = gradeModel.Use().UpdateOne(db.Ctx, filter, bson.D{{
-				Key: "$set",
-				Value: bson.M{
-					"grade": update.Grade,
-				},
-			}})
-			if err != nil {
-				return err
-			}
+		err = gradeHistoryService.registerInserted(
+			modelsGrades,
+			inserted.InsertedIDs,
+			idObjUser,
+			models.GRADE_SOURCE_EVALUATION,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	// Update grades
+	for _, update := range updates {
+		filter := bson.D{
+			{
+				Key:   "module",
+				Value: work.Module,
+			},
+			{
+				Key:   "student",
+				Value: update.Student,
+			},
+			{
+				Key:   "program",
+				Value: program.ID,
+			},
+		}
+		if program.IsAcumulative {
+			filter = append(filter, bson.E{
+				Key:   "acumulative",
+				Value: work.Acumulative,
+			})
+		}
+		set := bson.M{
+			"grade":        update.Grade,
+			"late_penalty": update.LatePenalty,
+		}
+		if draft {
+			set["draft"] = true
+		}
+		err := gradeHistoryService.updateGrade(
+			filter,
+			set,
+			idObjUser,
+			models.GRADE_SOURCE_EVALUATION,
+			"",
+		)
+		if err != nil {
+			return err
 		}
 	}
 	return nil
@@ -1809,39 +2275,49 @@ func (w *WorkSerice) UpdateWork(work *forms.UpdateWorkForm, idWork, idUser strin
 	}
 	now := time.Now()
 	if workData.Type == "files" && now.Before(workData.DateStart.Time()) {
-		var pattern []models.WorkPattern
+		if work.Rubric != "" {
+			pattern, errRes := rubricService.getPatternFromRubric(work.Rubric, idObjUser)
+			if errRes != nil {
+				return errRes
+			}
+			update["pattern"] = pattern
+		} else if work.Pattern != nil {
+			var pattern []models.WorkPattern
 
-		for _, item := range work.Pattern {
-			var itemAdd models.WorkPattern
-			if item.ID != "" {
-				idObjItem, err := primitive.ObjectIDFromHex(item.ID)
-				if err != nil {
-					return &res.ErrorRes{
-						Err:        err,
-						StatusCode: http.StatusBadRequest,
+			for _, item := range work.Pattern {
+				itemAdd := models.NewModelWorkPattern(
+					item.Title,
+					item.Description,
+					item.Points,
+					item.Levels,
+				)
+				if item.ID != "" {
+					idObjItem, err := primitive.ObjectIDFromHex(item.ID)
+					if err != nil {
+						return &res.ErrorRes{
+							Err:        err,
+							StatusCode: http.StatusBadRequest,
+						}
 					}
-				}
-				var find bool
-				for _, itemData := range workData.Pattern {
-					if itemData.ID == idObjItem {
-						find = true
-						break
+					var find bool
+					for _, itemData := range workData.Pattern {
+						if itemData.ID == idObjItem {
+							find = true
+							break
+						}
 					}
-				}
-				if !find {
-					return &res.ErrorRes{
-						Err:        fmt.Errorf("no se puede actualizar un item que no está registrado"),
-						StatusCode: http.StatusNotFound,
+					if !find {
+						return &res.ErrorRes{
+							Err:        fmt.Errorf("no se puede actualizar un item que no está registrado"),
+							StatusCode: http.StatusNotFound,
+						}
 					}
+					itemAdd.ID = idObjItem
 				}
-				itemAdd.ID = idObjItem
+				pattern = append(pattern, itemAdd)
 			}
-			itemAdd.Title = item.Title
-			itemAdd.Description = item.Description
-			itemAdd.Points = item.Points
-			pattern = append(pattern, itemAdd)
+			update["pattern"] = pattern
 		}
-		update["pattern"] = pattern
 	} else if workData.Type == "form" && now.Before(workData.DateStart.Time()) {
 		if work.Form != "" {
 			idObjForm, err := primitive.ObjectIDFromHex(work.Form)
@@ -1878,6 +2354,49 @@ func (w *WorkSerice) UpdateWork(work *forms.UpdateWorkForm, idWork, idUser strin
 			}
 			update["form"] = idObjForm
 		}
+		// Shuffle, it's checked again if the form changes
+		if work.FormShuffle != nil || (work.Form != "" && workData.FormShuffle != nil) {
+			formShuffle := workData.FormShuffle
+			if work.FormShuffle != nil {
+				formShuffle = models.NewModelWorkFormShuffle(work.FormShuffle)
+			}
+			idObjForm := workData.Form
+			if idForm, ok := update["form"]; ok {
+				idObjForm = idForm.(primitive.ObjectID)
+			}
+			form, err := formService.GetFormById(idObjForm)
+			if err != nil {
+				return &res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusServiceUnavailable,
+				}
+			}
+			if err := w.checkFormShuffle(formShuffle, form); err != nil {
+				return &res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusBadRequest,
+				}
+			}
+			update["form_shuffle"] = formShuffle
+		}
+		// Attempts, it's checked again if the form changes
+		if work.Attempts != nil || (work.Form != "" && workData.Attempts != nil) {
+			attempts := workData.Attempts
+			if work.Attempts != nil {
+				attempts = models.NewModelWorkAttempts(work.Attempts)
+			}
+			idObjForm := workData.Form
+			if idForm, ok := update["form"]; ok {
+				idObjForm = idForm.(primitive.ObjectID)
+			}
+			if err := w.checkAttempts(attempts, idObjForm); err != nil {
+				return &res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusBadRequest,
+				}
+			}
+			update["attempts"] = attempts
+		}
 		if work.FormAccess != "" {
 			update["form_access"] = work.FormAccess
 		}
@@ -1988,9 +2507,104 @@ func (w *WorkSerice) UpdateWork(work *forms.UpdateWorkForm, idWork, idUser strin
 			StatusCode: http.StatusBadRequest,
 		}
 	}
+	// Late policy
+	if work.LatePolicy != nil && work.RemoveLatePolicy {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("no se puede actualizar y eliminar la política de atraso a la vez"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	if work.LatePolicy != nil && !workData.IsRevised {
+		update["late_policy"] = models.NewModelWorkLatePolicy(work.LatePolicy)
+	}
+	if work.RemoveLatePolicy && workData.LatePolicy != nil && !workData.IsRevised {
+		unset["late_policy"] = ""
+	}
+	// Peer review, the review window is checked with the updated dates
+	if workData.PeerReview != nil && workData.PeerReview.Assigned &&
+		(work.PeerReview != nil || update["date_limit"] != nil || update["late_policy"] != nil || unset["late_policy"] != nil) {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("las revisiones de pares ya fueron asignadas"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	updatedWork := *workData
+	if work.PeerReview != nil {
+		if workData.Type != "files" {
+			return &res.ErrorRes{
+				Err:        fmt.Errorf("la revisión de pares es solo para trabajos de archivos"),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		peerReview, err := models.NewModelWorkPeerReview(work.PeerReview)
+		if err != nil {
+			return &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		update["peer_review"] = peerReview
+		updatedWork.PeerReview = peerReview
+	}
+	// Groups
+	if work.Groups != nil && !workData.IsRevised {
+		groups := models.NewModelWorkGroups(work.Groups)
+		update["groups"] = groups
+		updatedWork.Groups = groups
+	}
+	if dateLimit, ok := update["date_limit"].(primitive.DateTime); ok {
+		updatedWork.DateLimit = dateLimit
+	}
+	if latePolicy, ok := update["late_policy"].(*models.WorkLatePolicy); ok {
+		updatedWork.LatePolicy = latePolicy
+	}
+	if _, ok := unset["late_policy"]; ok {
+		updatedWork.LatePolicy = nil
+	}
+	if err := w.checkPeerReview(&updatedWork); err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
 	update["date_update"] = primitive.NewDateTimeFromTime(now)
 	// Update work
-	// Update ES
+	// Update ES, drafts are indexed once published
+	if !workData.Draft {
+		if errRes := w.updateWorkES(idWork, updateEs); errRes != nil {
+			return errRes
+		}
+	}
+	// Update DB
+	_, err = workModel.Use().UpdateByID(db.Ctx, idObjWork, bson.D{
+		{
+			Key:   "$set",
+			Value: update,
+		},
+		{
+			Key:   "$unset",
+			Value: unset,
+		},
+	})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	// The review window moves with the dates of the work
+	if update["peer_review"] != nil || update["date_limit"] != nil || update["late_policy"] != nil || unset["late_policy"] != nil {
+		if err := w.schedulePeerReviews(&updatedWork); err != nil {
+			return &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+	}
+	return nil
+}
+
+func (w *WorkSerice) updateWorkES(idWork string, updateEs map[string]interface{}) *res.ErrorRes {
 	data, err := json.Marshal(updateEs)
 	if err != nil {
 		return &res.ErrorRes{
@@ -2025,23 +2639,6 @@ func (w *WorkSerice) UpdateWork(work *forms.UpdateWorkForm, idWork, idUser strin
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
-	// Update DB
-	_, err = workModel.Use().UpdateByID(db.Ctx, idObjWork, bson.D{
-		{
-			Key:   "$set",
-			Value: update,
-		},
-		{
-			Key:   "$unset",
-			Value: unset,
-		},
-	})
-	if err != nil {
-		return &res.ErrorRes{
-			Err:        err,
-			StatusCode: http.StatusServiceUnavailable,
-		}
-	}
 	return nil
 }
 
@@ -2110,6 +2707,13 @@ func (w *WorkSerice) DeleteWork(idWork string) *res.ErrorRes {
 				StatusCode: http.StatusServiceUnavailable,
 			}
 		}
+		_, err = peerReviewsModel.Use().DeleteMany(db.Ctx, filter)
+		if err != nil {
+			return &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
 	} else if work.Type == "form" {
 		_, err = answerModel.Use().DeleteMany(db.Ctx, filter)
 		if err != nil {
@@ -2133,6 +2737,13 @@ func (w *WorkSerice) DeleteWork(idWork string) *res.ErrorRes {
 			}
 		}
 	}
+	_, err = workGroupsModel.Use().DeleteMany(db.Ctx, filter)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	// Delete work ElasticSearch
 	bi, err := models.NewBulkWork()
 	if err != nil {
@@ -2198,6 +2809,25 @@ func (w *WorkSerice) DeleteFileClassroom(idWork, idFile, idUser string) *res.Err
 			StatusCode: http.StatusBadRequest,
 		}
 	}
+	// Get work
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if errRes := w.checkPublishedWork(work); errRes != nil {
+		return errRes
+	}
+	// Members of a group share the files of the group
+	idObjSubmitter, err := w.getSubmitter(work, idObjUser)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	// Get files uploaded
 	var fUC *models.FileUploadedClassroom
 	cursor := fileUCModel.GetOne(bson.D{
@@ -2207,7 +2837,7 @@ func (w *WorkSerice) DeleteFileClassroom(idWork, idFile, idUser string) *res.Err
 		},
 		{
 			Key:   "student",
-			Value: idObjUser,
+			Value: idObjSubmitter,
 		},
 	})
 	if err := cursor.Decode(&fUC); err != nil {
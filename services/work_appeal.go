@@ -0,0 +1,409 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"github.com/CPU-commits/Intranet_BClassroom/funct"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (w *WorkSerice) getAppeals(filter bson.D) ([]models.WorkAppeal, error) {
+	var appeals []models.WorkAppeal
+
+	opts := options.Find().SetSort(bson.D{{
+		Key:   "date",
+		Value: 1,
+	}})
+	cursor, err := workAppealsModel.GetAll(filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &appeals); err != nil {
+		return nil, err
+	}
+	return appeals, nil
+}
+
+// Appeals of the work visible by the user, teachers and directives see
+// all the appeals
+func (w *WorkSerice) getAppealsUser(idObjWork primitive.ObjectID, claims *Claims) ([]models.WorkAppeal, error) {
+	filter := bson.D{{
+		Key:   "work",
+		Value: idObjWork,
+	}}
+	if claims.UserType == models.STUDENT || claims.UserType == models.STUDENT_DIRECTIVE {
+		filter = append(filter, bson.E{
+			Key:   "student",
+			Value: claims.IDObj,
+		})
+	} else if claims.UserType == models.ATTORNEY {
+		students, errRes := getParentStudents(claims.IDObj)
+		if errRes != nil {
+			return nil, errRes.Err
+		}
+		filter = append(filter, bson.E{
+			Key: "student",
+			Value: bson.M{
+				"$in": students,
+			},
+		})
+	}
+	return w.getAppeals(filter)
+}
+
+// Grade of a student in a work
+type workGradeStudent struct {
+	Grade       float64            `bson:"grade"`
+	Draft       bool               `bson:"draft"`
+	ReleaseDate primitive.DateTime `bson:"release_date"`
+}
+
+// Current grade of the student in the work, nil if the student has no grade.
+// It's taken from the grades of the program if the work is qualified
+func (w *WorkSerice) getGradeStudent(work *models.Work, idObjStudent primitive.ObjectID) (*workGradeStudent, error) {
+	var grade *workGradeStudent
+
+	collection, filter := w.getWorkGradesFilter(work)
+	filter = append(filter, bson.E{
+		Key:   "student",
+		Value: idObjStudent,
+	})
+	cursor := collection.GetOne(filter)
+	if err := cursor.Decode(&grade); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return grade, nil
+}
+
+func (w *WorkSerice) notifyAppeal(work *models.Work, title, link string, idUsers ...primitive.ObjectID) error {
+	module, err := moduleService.GetModuleFromID(work.Module.Hex())
+	if err != nil {
+		return err
+	}
+	var notified []primitive.ObjectID
+	for _, idUser := range idUsers {
+		if funct.Some(notified, func(id primitive.ObjectID) bool {
+			return id == idUser
+		}) {
+			continue
+		}
+		nats.PublishEncode("notify/classroom", res.NotifyClassroom{
+			Title:  fmt.Sprintf(title, work.Title),
+			Link:   link,
+			Where:  module.Subject.Hex(),
+			Room:   module.Section.Hex(),
+			Type:   res.WORK,
+			IDUser: idUser.Hex(),
+		})
+		notified = append(notified, idUser)
+	}
+	return nil
+}
+
+func (w *WorkSerice) getWorkLink(work *models.Work) string {
+	return fmt.Sprintf(
+		"/aula_virtual/clase/%s/trabajos/%s",
+		work.Module.Hex(),
+		work.ID.Hex(),
+	)
+}
+
+// The re-evaluation of a student resolves the accepted appeals
+func (w *WorkSerice) resolveAppeals(work *models.Work, idObjStudent primitive.ObjectID, grade float64) error {
+	filter := bson.D{
+		{
+			Key:   "work",
+			Value: work.ID,
+		},
+		{
+			Key:   "student",
+			Value: idObjStudent,
+		},
+		{
+			Key:   "status",
+			Value: models.APPEAL_ACCEPTED,
+		},
+	}
+	appeals, err := w.getAppeals(filter)
+	if err != nil {
+		return err
+	}
+	if len(appeals) == 0 {
+		return nil
+	}
+	_, err = workAppealsModel.Use().UpdateMany(db.Ctx, filter, bson.D{{
+		Key: "$set",
+		Value: bson.M{
+			"status":        models.APPEAL_RESOLVED,
+			"new_grade":     grade,
+			"resolved_date": primitive.NewDateTimeFromTime(time.Now()),
+		},
+	}})
+	if err != nil {
+		return err
+	}
+	for _, appeal := range appeals {
+		err := w.notifyAppeal(
+			work,
+			"Apelación resuelta en el trabajo %s",
+			w.getWorkLink(work),
+			appeal.Student,
+			appeal.Author,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *WorkSerice) UploadAppeal(
+	appeal *forms.WorkAppealForm,
+	idWork string,
+	claims *Claims,
+) (interface{}, *res.ErrorRes) {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Student
+	idObjStudent := claims.IDObj
+	if claims.UserType == models.ATTORNEY {
+		idObjStudent, err = primitive.ObjectIDFromHex(appeal.Student)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        fmt.Errorf("se debe indicar el estudiante de la apelación"),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		students, errRes := getParentStudents(claims.IDObj)
+		if errRes != nil {
+			return nil, errRes
+		}
+		if !funct.Some(students, func(idStudent primitive.ObjectID) bool {
+			return idStudent == idObjStudent
+		}) {
+			return nil, &res.ErrorRes{
+				Err:        fmt.Errorf("no eres apoderado de este estudiante"),
+				StatusCode: http.StatusUnauthorized,
+			}
+		}
+	}
+	// Get work
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if errRes := w.checkPublishedWork(work); errRes != nil {
+		return nil, errRes
+	}
+	if !work.IsRevised {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("este trabajo todavía no está evaluado"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	grade, err := w.getGradeStudent(work, idObjStudent)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if grade == nil || !isGradeReleased(grade.Draft, grade.ReleaseDate) {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("el estudiante no tiene calificación en este trabajo"),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	// Only one open appeal
+	openAppeals, err := w.getAppeals(bson.D{
+		{
+			Key:   "work",
+			Value: idObjWork,
+		},
+		{
+			Key:   "student",
+			Value: idObjStudent,
+		},
+		{
+			Key: "status",
+			Value: bson.M{
+				"$in": bson.A{models.APPEAL_PENDING, models.APPEAL_ACCEPTED},
+			},
+		},
+	})
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if len(openAppeals) > 0 {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("ya existe una apelación abierta para este trabajo"),
+			StatusCode: http.StatusConflict,
+		}
+	}
+	// Insert
+	modelAppeal := models.NewModelWorkAppeal(
+		idObjWork,
+		idObjStudent,
+		claims.IDObj,
+		appeal.Justification,
+		grade.Grade,
+	)
+	inserted, err := workAppealsModel.NewDocument(modelAppeal)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	// Notify teacher
+	err = w.notifyAppeal(
+		work,
+		"Nueva apelación en el trabajo %s",
+		fmt.Sprintf(
+			"/aula_virtual/clase/%s/trabajos/%s/apelaciones",
+			work.Module.Hex(),
+			work.ID.Hex(),
+		),
+		work.Author,
+	)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return inserted.InsertedID, nil
+}
+
+func (w *WorkSerice) AnswerAppeal(
+	answer *forms.AnswerWorkAppealForm,
+	idWork,
+	idAppeal,
+	idTeacher string,
+) *res.ErrorRes {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjAppeal, err := primitive.ObjectIDFromHex(idAppeal)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjTeacher, err := primitive.ObjectIDFromHex(idTeacher)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	if !*answer.Accept && answer.Reason == "" {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("se debe indicar el motivo del rechazo"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get appeal
+	var appeal *models.WorkAppeal
+	cursor := workAppealsModel.GetByID(idObjAppeal)
+	if err := cursor.Decode(&appeal); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return &res.ErrorRes{
+				Err:        fmt.Errorf("no existe la apelación"),
+				StatusCode: http.StatusNotFound,
+			}
+		}
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if appeal.Work != idObjWork {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("la apelación no pertenece al trabajo indicado"),
+			StatusCode: http.StatusConflict,
+		}
+	}
+	if appeal.Status != models.APPEAL_PENDING {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("esta apelación ya fue respondida"),
+			StatusCode: http.StatusConflict,
+		}
+	}
+	// Get work
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	// Update
+	status := models.APPEAL_REJECTED
+	title := "Apelación rechazada en el trabajo %s"
+	if *answer.Accept {
+		status = models.APPEAL_ACCEPTED
+		title = "Apelación aceptada en el trabajo %s"
+	}
+	set := bson.M{
+		"status":      status,
+		"teacher":     idObjTeacher,
+		"answer_date": primitive.NewDateTimeFromTime(time.Now()),
+	}
+	if answer.Reason != "" {
+		set["reason"] = answer.Reason
+	}
+	_, err = workAppealsModel.Use().UpdateByID(db.Ctx, idObjAppeal, bson.D{{
+		Key:   "$set",
+		Value: set,
+	}})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	err = w.notifyAppeal(
+		work,
+		title,
+		w.getWorkLink(work),
+		appeal.Student,
+		appeal.Author,
+	)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return nil
+}
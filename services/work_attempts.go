@@ -0,0 +1,453 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var errAttemptChanged = errors.New("ya se comenzó otro intento en este formulario")
+
+func (w *WorkSerice) hasMultipleAttempts(work *models.Work) bool {
+	return work.Attempts != nil && work.Attempts.Quantity > 1
+}
+
+// Written questions need a manual evaluation per attempt, so they can't be
+// mixed with multiple attempts
+func (w *WorkSerice) checkAttempts(attempts *models.WorkAttempts, idForm primitive.ObjectID) error {
+	if attempts == nil || attempts.Quantity <= 1 {
+		return nil
+	}
+	questions, err := w.getQuestionsFromIdForm(idForm)
+	if err != nil {
+		return err
+	}
+	for _, question := range questions {
+		if question.Type == "written" {
+			return fmt.Errorf("un formulario con preguntas de desarrollo no admite múltiples intentos")
+		}
+	}
+	return nil
+}
+
+func (w *WorkSerice) getAttemptsStudent(
+	idObjWork,
+	idObjStudent primitive.ObjectID,
+) ([]models.FormAttempt, error) {
+	var attempts []models.FormAttempt
+
+	opts := options.Find().SetSort(bson.D{{
+		Key:   "attempt",
+		Value: 1,
+	}})
+	cursor, err := formAttemptsModel.GetAll(bson.D{
+		{
+			Key:   "work",
+			Value: idObjWork,
+		},
+		{
+			Key:   "student",
+			Value: idObjStudent,
+		},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &attempts); err != nil {
+		return nil, err
+	}
+	return attempts, nil
+}
+
+// Points of the student according to the attempts scoring of the work,
+// the points of the current attempt are included and the result is
+// rescaled to maxPoints
+func (w *WorkSerice) getAttemptsPoints(
+	work *models.Work,
+	idObjStudent primitive.ObjectID,
+	points,
+	maxPoints int,
+) (int, error) {
+	if !w.hasMultipleAttempts(work) || maxPoints == 0 {
+		return points, nil
+	}
+	attempts, err := w.getAttemptsStudent(work.ID, idObjStudent)
+	if err != nil {
+		return 0, err
+	}
+	return w.scoreAttempts(work.Attempts.Scoring, attempts, points, maxPoints), nil
+}
+
+// Score of the archived attempts and the current one (points), rescaled
+// to maxPoints. The scoring is best, average or last (default)
+func (w *WorkSerice) scoreAttempts(
+	scoring string,
+	attempts []models.FormAttempt,
+	points,
+	maxPoints int,
+) int {
+	if len(attempts) == 0 || maxPoints == 0 {
+		return points
+	}
+	var ratios []float64
+	for _, attempt := range attempts {
+		if attempt.MaxPoints == 0 {
+			ratios = append(ratios, 0)
+			continue
+		}
+		ratios = append(ratios, float64(attempt.Points)/float64(attempt.MaxPoints))
+	}
+	ratios = append(ratios, float64(points)/float64(maxPoints))
+
+	var ratio float64
+	switch scoring {
+	case "best":
+		for _, r := range ratios {
+			ratio = math.Max(ratio, r)
+		}
+	case "average":
+		for _, r := range ratios {
+			ratio += r
+		}
+		ratio /= float64(len(ratios))
+	default:
+		ratio = ratios[len(ratios)-1]
+	}
+	return int(math.Round(ratio * float64(maxPoints)))
+}
+
+// Points of an archived attempt from its answers, only with the questions
+// that give points to the work. Attempts have no written questions, so all
+// the answers are auto-graded
+func (w *WorkSerice) getArchivedAttemptPoints(
+	work *models.Work,
+	questions []models.ItemQuestion,
+	attempt *models.FormAttempt,
+) (int, int) {
+	questionsStudent := w.getQuestionsStudent(questions, &models.FormAccess{
+		Permutation: attempt.Permutation,
+	})
+	points := 0
+	maxPoints := 0
+	for _, question := range w.getQuestionsWPoints(work, questionsStudent) {
+		maxPoints += question.Points
+		for _, answer := range attempt.Answers {
+			if answer.Question == question.ID {
+				points += w.getAnswerPoints(&question, &answer)
+				break
+			}
+		}
+	}
+	return points, maxPoints
+}
+
+// Score again the archived attempts of the work, after a change of the
+// questions that give points
+func (w *WorkSerice) rescoreArchivedAttempts(
+	work *models.Work,
+	questions []models.ItemQuestion,
+) error {
+	if !w.hasMultipleAttempts(work) {
+		return nil
+	}
+	var attempts []models.FormAttempt
+	cursor, err := formAttemptsModel.GetAll(bson.D{{
+		Key:   "work",
+		Value: work.ID,
+	}}, nil)
+	if err != nil {
+		return err
+	}
+	if err := cursor.All(db.Ctx, &attempts); err != nil {
+		return err
+	}
+	for i := range attempts {
+		points, maxPoints := w.getArchivedAttemptPoints(work, questions, &attempts[i])
+		if points == attempts[i].Points && maxPoints == attempts[i].MaxPoints {
+			continue
+		}
+		_, err := formAttemptsModel.Use().UpdateByID(db.Ctx, attempts[i].ID, bson.D{{
+			Key: "$set",
+			Value: bson.M{
+				"points":     points,
+				"max_points": maxPoints,
+			},
+		}})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Forms with multiple attempts are closed on their own subject, the
+// consumers of close_student_form don't know the attempts and would close
+// a later attempt with the close of a previous one
+func (w *WorkSerice) publishCloseForm(
+	work *models.Work,
+	idObjStudent primitive.ObjectID,
+	diff time.Duration,
+	attempt int,
+) error {
+	if !w.hasMultipleAttempts(work) {
+		return nats.PublishEncode("close_student_form", &CloseForm{
+			Work:    work.ID.Hex(),
+			Student: idObjStudent.Hex(),
+			Diff:    diff.Hours(),
+		})
+	}
+	return nats.PublishEncode("close_student_form_attempt", &CloseFormAttempt{
+		Work:    work.ID.Hex(),
+		Student: idObjStudent.Hex(),
+		Diff:    diff.Hours(),
+		Attempt: attempt,
+	})
+}
+
+func (w *WorkSerice) NewAttempt(idWork, idStudent string) *res.ErrorRes {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjStudent, err := primitive.ObjectIDFromHex(idStudent)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get work
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if errRes := w.checkPublishedWork(work); errRes != nil {
+		return errRes
+	}
+	if work.Type != "form" {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("este trabajo no es de tipo formulario"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	if !w.hasMultipleAttempts(work) {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("este formulario no admite múltiples intentos"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	if work.IsRevised {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("este trabajo ya está evaluado"),
+			StatusCode: http.StatusForbidden,
+		}
+	}
+	// Student extension
+	work, err = w.getWorkStudent(work, idObjStudent)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	lateDateLimit := w.getLateDateLimit(work)
+	if time.Now().After(lateDateLimit) {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("ya no se pueden realizar intentos en este formulario"),
+			StatusCode: http.StatusUnauthorized,
+		}
+	}
+	// Members of a group share the attempts of the group
+	idObjStudent, err = w.getSubmitter(work, idObjStudent)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	// Get form access
+	access, err := w.getAccessFromIdStudentNIdWork(idObjStudent, idObjWork)
+	if err != nil {
+		if err.Error() == db.NO_SINGLE_DOCUMENT {
+			return &res.ErrorRes{
+				Err:        fmt.Errorf("todavía no has abierto el formulario"),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	isClosedWTime := work.FormAccess == "wtime" && time.Now().After(
+		access.Date.Time().Add(time.Duration(work.TimeFormAccess*int(time.Second))),
+	)
+	if access.Status != "finished" && !isClosedWTime {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("debes terminar el intento actual antes de comenzar otro"),
+			StatusCode: http.StatusConflict,
+		}
+	}
+	attempt := access.Attempt
+	if attempt == 0 {
+		attempt = 1
+	}
+	if attempt >= work.Attempts.Quantity {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("no te quedan intentos disponibles"),
+			StatusCode: http.StatusForbidden,
+		}
+	}
+	// Points of the current attempt
+	questions, err := w.getQuestionsFromIdForm(work.Form)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	questionsWPoints := w.getQuestionsWPoints(work, w.getQuestionsStudent(questions, access))
+	maxPoints := 0
+	for _, question := range questionsWPoints {
+		maxPoints += question.Points
+	}
+	points := 0
+	if len(questionsWPoints) > 0 {
+		points, _, err = w.getStudentEvaluate(questionsWPoints, idObjStudent, idObjWork)
+		if err != nil {
+			return &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+	}
+	// Archive attempt
+	var answers []models.Answer
+	filterAnswers := bson.D{
+		{
+			Key:   "student",
+			Value: idObjStudent,
+		},
+		{
+			Key:   "work",
+			Value: idObjWork,
+		},
+	}
+	cursor, err := answerModel.GetAll(filterAnswers, &options.FindOptions{})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if err := cursor.All(db.Ctx, &answers); err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if access.DateFinish == 0 {
+		access.DateFinish = primitive.NewDateTimeFromTime(time.Now())
+	}
+	modelAttempt := models.NewModelFormAttempt(access, answers, points, maxPoints)
+	// New attempt
+	form, errRes := formService.GetForm(work.Form.Hex(), idStudent, false)
+	if errRes != nil {
+		return errRes
+	}
+	attempt += 1
+	set := bson.M{
+		"date":    primitive.NewDateTimeFromTime(time.Now()),
+		"status":  "opened",
+		"attempt": attempt,
+	}
+	unset := bson.M{
+		"date_finish": "",
+	}
+	permutation := w.newFormPermutation(work, &form[0], idObjStudent, attempt)
+	if permutation != nil {
+		set["permutation"] = permutation
+	} else {
+		unset["permutation"] = ""
+	}
+	// The attempt is archived and the access reset together, so the answers
+	// are never lost. The access must still be in the archived attempt,
+	// each attempt has its own opening date
+	_, err = models.DbConnect.WithTransaction(func(sessCtx mongo.SessionContext) (interface{}, error) {
+		if _, err := formAttemptsModel.Use().InsertOne(sessCtx, modelAttempt); err != nil {
+			return nil, err
+		}
+		if _, err := answerModel.Use().DeleteMany(sessCtx, filterAnswers); err != nil {
+			return nil, err
+		}
+		result, err := formAccessModel.Use().UpdateOne(sessCtx, bson.D{
+			{
+				Key:   "_id",
+				Value: access.ID,
+			},
+			{
+				Key:   "date",
+				Value: access.Date,
+			},
+		}, bson.D{
+			{
+				Key:   "$set",
+				Value: set,
+			},
+			{
+				Key:   "$unset",
+				Value: unset,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if result.ModifiedCount == 0 {
+			return nil, errAttemptChanged
+		}
+		return nil, nil
+	})
+	if errors.Is(err, errAttemptChanged) {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusConflict,
+		}
+	}
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	// Close attempt
+	var diff time.Duration
+	if work.FormAccess == "default" {
+		diff = time.Until(lateDateLimit)
+	} else {
+		diff = time.Duration(work.TimeFormAccess * int(time.Second))
+	}
+	err = w.publishCloseForm(work, idObjStudent, diff, attempt)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestScoreAttempts(t *testing.T) {
+	attempts := []models.FormAttempt{
+		{Attempt: 1, Points: 10, MaxPoints: 20},
+		{Attempt: 2, Points: 18, MaxPoints: 20},
+	}
+
+	tests := []struct {
+		name      string
+		scoring   string
+		attempts  []models.FormAttempt
+		points    int
+		maxPoints int
+		score     int
+	}{
+		{"without attempts", "best", nil, 12, 20, 12},
+		{"without max points", "best", attempts, 12, 0, 12},
+		{"best of the archived", "best", attempts, 12, 20, 18},
+		{"best is the current", "best", attempts, 20, 20, 20},
+		{"average", "average", attempts, 14, 20, 14},
+		{"average rounded", "average", attempts, 13, 20, 14},
+		{"last", "last", attempts, 6, 20, 6},
+		{"last is the default", "", attempts, 6, 20, 6},
+		{"rescaled to the max points", "best", attempts, 5, 10, 9},
+		{
+			name:    "archived without max points",
+			scoring: "average",
+			attempts: []models.FormAttempt{
+				{Attempt: 1, Points: 0, MaxPoints: 0},
+			},
+			points:    20,
+			maxPoints: 20,
+			score:     10,
+		},
+	}
+
+	w := &WorkSerice{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			score := w.scoreAttempts(test.scoring, test.attempts, test.points, test.maxPoints)
+			if score != test.score {
+				t.Errorf("scoreAttempts() = %d, want %d", score, test.score)
+			}
+		})
+	}
+}
+
+func TestGetAttemptsPointsSingleAttempt(t *testing.T) {
+	tests := []struct {
+		name      string
+		attempts  *models.WorkAttempts
+		maxPoints int
+	}{
+		{"without attempts", nil, 20},
+		{"one attempt", &models.WorkAttempts{Quantity: 1, Scoring: "best"}, 20},
+		{"without max points", &models.WorkAttempts{Quantity: 3, Scoring: "best"}, 0},
+	}
+
+	w := &WorkSerice{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			work := &models.Work{
+				ID:       primitive.NewObjectID(),
+				Attempts: test.attempts,
+			}
+			points, err := w.getAttemptsPoints(work, primitive.NewObjectID(), 12, test.maxPoints)
+			if err != nil {
+				t.Fatalf("getAttemptsPoints() error = %v", err)
+			}
+			if points != 12 {
+				t.Errorf("getAttemptsPoints() = %d, want 12", points)
+			}
+		})
+	}
+}
+
+func TestGetArchivedAttemptPoints(t *testing.T) {
+	questions := []models.ItemQuestion{
+		{ID: primitive.NewObjectID(), Type: "alternatives_correct", Points: 10, Correct: 1},
+		{ID: primitive.NewObjectID(), Type: "true_false", Points: 5, Correct: 0},
+		{ID: primitive.NewObjectID(), Type: "alternatives"},
+		{ID: primitive.NewObjectID(), Type: "multiple_select", Points: 10, Corrects: []int{0, 1}},
+	}
+	answers := []models.Answer{
+		{Question: questions[0].ID, Answer: 1},
+		{Question: questions[1].ID, Answer: 1},
+		{Question: questions[2].ID, Answer: 2},
+		{Question: questions[3].ID, Answers: []int{0}},
+	}
+
+	tests := []struct {
+		name        string
+		removed     []primitive.ObjectID
+		permutation *models.FormPermutation
+		points      int
+		maxPoints   int
+	}{
+		{
+			name:      "all the questions",
+			points:    15,
+			maxPoints: 25,
+		},
+		{
+			name:      "without the removed question",
+			removed:   []primitive.ObjectID{questions[0].ID},
+			points:    5,
+			maxPoints: 15,
+		},
+		{
+			name: "only the questions of the student",
+			permutation: &models.FormPermutation{
+				Items: []models.FormAccessItem{{
+					Questions: []primitive.ObjectID{questions[1].ID, questions[3].ID},
+				}},
+			},
+			points:    5,
+			maxPoints: 15,
+		},
+	}
+
+	w := &WorkSerice{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			work := &models.Work{
+				RemovedQuestions: test.removed,
+			}
+			attempt := &models.FormAttempt{
+				Answers:     answers,
+				Permutation: test.permutation,
+			}
+			points, maxPoints := w.getArchivedAttemptPoints(work, questions, attempt)
+			if points != test.points || maxPoints != test.maxPoints {
+				t.Errorf(
+					"getArchivedAttemptPoints() = %d, %d, want %d, %d",
+					points,
+					maxPoints,
+					test.points,
+					test.maxPoints,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type workCloneTarget struct {
+	module *models.Module
+	grade  *Grade
+}
+
+func shiftDate(date primitive.DateTime, offset int) primitive.DateTime {
+	return primitive.NewDateTimeFromTime(date.Time().AddDate(0, 0, offset))
+}
+
+// Copy of the work for the module, without submissions nor grades.
+// The sessions are only kept in the same section, because the blocks
+// belong to the calendar of the section
+func (w *WorkSerice) newCloneWork(
+	work *models.Work,
+	target *workCloneTarget,
+	author primitive.ObjectID,
+	offset int,
+	sameSection bool,
+) *models.Work {
+	now := primitive.NewDateTimeFromTime(time.Now())
+	clone := &models.Work{
+		Author:         author,
+		Module:         target.module.ID,
+		Title:          work.Title,
+		Description:    work.Description,
+		IsQualified:    work.IsQualified,
+		Type:           work.Type,
+		DateStart:      shiftDate(work.DateStart, offset),
+		DateLimit:      shiftDate(work.DateLimit, offset),
+		FormAccess:     work.FormAccess,
+		TimeFormAccess: work.TimeFormAccess,
+		IsRevised:      false,
+		Virtual:        work.Virtual,
+		DateUpload:     now,
+		DateUpdate:     now,
+	}
+	if target.grade != nil {
+		clone.Grade = target.grade.Grade
+		if target.grade.IsAcumulative {
+			clone.Acumulative = target.grade.Acumulative
+		}
+	}
+	for _, item := range work.Pattern {
+		pattern := item
+		pattern.ID = primitive.NewObjectID()
+		pattern.Levels = nil
+		for _, level := range item.Levels {
+			level.ID = primitive.NewObjectID()
+			pattern.Levels = append(pattern.Levels, level)
+		}
+		clone.Pattern = append(clone.Pattern, pattern)
+	}
+	if sameSection {
+		for _, session := range work.Sessions {
+			var dates []primitive.DateTime
+			for _, date := range session.Dates {
+				dates = append(dates, shiftDate(date, offset))
+			}
+			clone.Sessions = append(clone.Sessions, models.WorkSession{
+				Block: session.Block,
+				Dates: dates,
+			})
+		}
+	}
+	for _, attached := range work.Attached {
+		attached.ID = primitive.NewObjectID()
+		clone.Attached = append(clone.Attached, attached)
+	}
+	if work.LatePolicy != nil {
+		latePolicy := *work.LatePolicy
+		clone.LatePolicy = &latePolicy
+	}
+	if work.Attempts != nil {
+		attempts := *work.Attempts
+		clone.Attempts = &attempts
+	}
+	if work.Groups != nil {
+		groups := *work.Groups
+		clone.Groups = &groups
+	}
+	if work.PeerReview != nil {
+		clone.PeerReview = &models.WorkPeerReview{
+			Reviewers: work.PeerReview.Reviewers,
+			DateLimit: shiftDate(work.PeerReview.DateLimit, offset),
+		}
+	}
+	return clone
+}
+
+// The draws of the shuffle point to the items of the cloned form
+func (w *WorkSerice) cloneFormShuffle(
+	formShuffle *models.WorkFormShuffle,
+	items map[primitive.ObjectID]primitive.ObjectID,
+) *models.WorkFormShuffle {
+	clone := &models.WorkFormShuffle{
+		Items:     formShuffle.Items,
+		Questions: formShuffle.Questions,
+		Answers:   formShuffle.Answers,
+	}
+	for _, draw := range formShuffle.Draws {
+		if idItem, ok := items[draw.Item]; ok {
+			clone.Draws = append(clone.Draws, models.WorkFormDraw{
+				Item:     idItem,
+				Quantity: draw.Quantity,
+			})
+		}
+	}
+	return clone
+}
+
+func (w *WorkSerice) getCloneTargets(
+	cloneForm *forms.WorkCloneForm,
+	work *models.Work,
+	claims *Claims,
+) ([]workCloneTarget, *res.ErrorRes) {
+	var targets []workCloneTarget
+	grades := make(map[string]bool)
+
+	for _, target := range cloneForm.Targets {
+		if _, err := primitive.ObjectIDFromHex(target.Module); err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		if err := AuthorizedRouteFromIdModule(target.Module, claims); err != nil {
+			return nil, &res.ErrorRes{
+				Err:        fmt.Errorf("no tienes acceso al módulo %s", target.Module),
+				StatusCode: http.StatusUnauthorized,
+			}
+		}
+		module, err := moduleService.GetModuleFromID(target.Module)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		cloneTarget := workCloneTarget{
+			module: module,
+		}
+		// Grade
+		if target.Grade != "" {
+			if !work.IsQualified {
+				return nil, &res.ErrorRes{
+					Err:        fmt.Errorf("este trabajo no es calificado, no se le puede asignar una calificación"),
+					StatusCode: http.StatusBadRequest,
+				}
+			}
+			idObjGrade, err := primitive.ObjectIDFromHex(target.Grade)
+			if err != nil {
+				return nil, &res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusBadRequest,
+				}
+			}
+			if grades[target.Grade] {
+				return nil, &res.ErrorRes{
+					Err:        fmt.Errorf("la calificación %s está repetida", target.Grade),
+					StatusCode: http.StatusBadRequest,
+				}
+			}
+			grades[target.Grade] = true
+
+			grade, err := w.verifyGradeWork(module.ID, idObjGrade)
+			if err != nil {
+				return nil, &res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusBadRequest,
+				}
+			}
+			cloneTarget.grade = grade
+		}
+		targets = append(targets, cloneTarget)
+	}
+	return targets, nil
+}
+
+func (w *WorkSerice) CloneWork(
+	cloneForm *forms.WorkCloneForm,
+	idWork string,
+	claims *Claims,
+) ([]ClonedWork, *res.ErrorRes) {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjUser, err := primitive.ObjectIDFromHex(claims.ID)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get work
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	module, err := moduleService.GetModuleFromID(work.Module.Hex())
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	var form *models.Form
+	if work.Type == "form" {
+		form, err = formService.GetFormById(work.Form)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+	}
+	datePublish, err := parsePublishDate(
+		cloneForm.DatePublish,
+		shiftDate(work.DateLimit, cloneForm.Offset).Time(),
+	)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Targets
+	targets, errRes := w.getCloneTargets(cloneForm, work, claims)
+	if errRes != nil {
+		return nil, errRes
+	}
+	// Insert
+	var clones []*models.Work
+	_, err = models.DbConnect.WithTransaction(func(sessCtx mongo.SessionContext) (interface{}, error) {
+		clones = nil
+		for i := range targets {
+			clone := w.newCloneWork(
+				work,
+				&targets[i],
+				idObjUser,
+				cloneForm.Offset,
+				targets[i].module.Section == module.Section,
+			)
+			setWorkDraft(clone, cloneForm.Draft, datePublish)
+			if form != nil {
+				formData, items, err := formService.cloneForm(sessCtx, form, idObjUser)
+				if err != nil {
+					return nil, err
+				}
+				clone.Form = formData.ID
+				if work.FormShuffle != nil {
+					clone.FormShuffle = w.cloneFormShuffle(work.FormShuffle, items)
+				}
+			}
+			inserted, err := workModel.Use().InsertOne(sessCtx, clone)
+			if err != nil {
+				return nil, err
+			}
+			clone.ID = inserted.InsertedID.(primitive.ObjectID)
+			clones = append(clones, clone)
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	// Publish
+	cloned := make([]ClonedWork, len(clones))
+	for i, clone := range clones {
+		if err := w.schedulePeerReviews(clone); err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		if clone.Draft {
+			if err := w.scheduleWork(clone); err != nil {
+				return nil, &res.ErrorRes{
+					Err:        err,
+					StatusCode: http.StatusServiceUnavailable,
+				}
+			}
+		} else if errRes := w.publishWork(clone, targets[i].module, claims.Name); errRes != nil {
+			return nil, errRes
+		}
+		cloned[i] = ClonedWork{
+			Module: clone.Module.Hex(),
+			Work:   clone.ID.Hex(),
+		}
+	}
+	return cloned, nil
+}
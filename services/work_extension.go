@@ -0,0 +1,360 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"github.com/CPU-commits/Intranet_BClassroom/funct"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func (w *WorkSerice) getExtensionStudent(
+	idObjWork,
+	idObjStudent primitive.ObjectID,
+) (*models.WorkExtension, error) {
+	var extension *models.WorkExtension
+	cursor := workExtensionModel.GetOne(bson.D{
+		{
+			Key:   "work",
+			Value: idObjWork,
+		},
+		{
+			Key:   "student",
+			Value: idObjStudent,
+		},
+	})
+	if err := cursor.Decode(&extension); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return extension, nil
+}
+
+// Work with the date limit and time access of the student extension
+func (w *WorkSerice) getWorkStudent(
+	work *models.Work,
+	idObjStudent primitive.ObjectID,
+) (*models.Work, error) {
+	extension, err := w.getExtensionStudent(work.ID, idObjStudent)
+	if err != nil {
+		return nil, err
+	}
+	if extension == nil {
+		return work, nil
+	}
+	workStudent := *work
+	if extension.DateLimit != 0 {
+		workStudent.DateLimit = extension.DateLimit
+	}
+	if extension.TimeFormAccess != 0 {
+		workStudent.TimeFormAccess = extension.TimeFormAccess
+	}
+	return &workStudent, nil
+}
+
+func (w *WorkSerice) GetExtensions(idWork string) ([]models.WorkExtensionWLookup, *res.ErrorRes) {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get extensions
+	var extensions []models.WorkExtensionWLookup
+
+	match := bson.D{{
+		Key: "$match",
+		Value: bson.M{
+			"work": idObjWork,
+		},
+	}}
+	lookupUser := func(field string) bson.D {
+		return bson.D{{
+			Key: "$lookup",
+			Value: bson.M{
+				"from":         models.USERS_COLLECTION,
+				"localField":   field,
+				"foreignField": "_id",
+				"as":           field,
+				"pipeline": bson.A{bson.D{{
+					Key: "$project",
+					Value: bson.M{
+						"_id":             1,
+						"name":            1,
+						"first_lastname":  1,
+						"second_lastname": 1,
+						"rut":             1,
+					},
+				}}},
+			},
+		}}
+	}
+	set := bson.D{{
+		Key: "$set",
+		Value: bson.M{
+			"student": bson.M{
+				"$first": "$student",
+			},
+			"author": bson.M{
+				"$first": "$author",
+			},
+		},
+	}}
+	sort := bson.D{{
+		Key: "$sort",
+		Value: bson.M{
+			"date": -1,
+		},
+	}}
+	cursor, err := workExtensionModel.Aggreagate(mongo.Pipeline{
+		match,
+		lookupUser("student"),
+		lookupUser("author"),
+		set,
+		sort,
+	})
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if err := cursor.All(db.Ctx, &extensions); err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return extensions, nil
+}
+
+func (w *WorkSerice) UploadExtension(
+	extension *forms.WorkExtensionForm,
+	idWork,
+	idUser string,
+) (interface{}, *res.ErrorRes) {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjUser, err := primitive.ObjectIDFromHex(idUser)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjStudent, err := primitive.ObjectIDFromHex(extension.Student)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get work
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if work.IsRevised {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("este trabajo ya está evaluado"),
+			StatusCode: http.StatusForbidden,
+		}
+	}
+	if work.Type == "in-person" {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("no se pueden otorgar prórrogas a un trabajo presencial"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	if extension.TimeFormAccess != 0 && (work.Type != "form" || work.FormAccess != "wtime") {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("el tiempo de acceso solo aplica a formularios con tiempo"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	var tLimit time.Time
+	if extension.DateLimit != "" {
+		tLimit, err = time.Parse("2006-01-02 15:04", extension.DateLimit)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		if !tLimit.After(work.DateLimit.Time()) {
+			return nil, &res.ErrorRes{
+				Err:        fmt.Errorf("la fecha límite de la prórroga debe ser mayor a la del trabajo"),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+	}
+	// Check student
+	students, err := w.getStudentsFromIdModule(work.Module.Hex())
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if !funct.Some(students, func(student Student) bool {
+		return student.User.ID == extension.Student
+	}) {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("el estudiante no pertenece a este módulo"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Insert or replace
+	modelExtension := models.NewModelWorkExtension(
+		idObjWork,
+		idObjStudent,
+		idObjUser,
+		tLimit,
+		extension.TimeFormAccess,
+		extension.Reason,
+	)
+	extensionData, err := w.getExtensionStudent(idObjWork, idObjStudent)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	var idExtension interface{}
+	if extensionData == nil {
+		inserted, err := workExtensionModel.NewDocument(modelExtension)
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, &res.ErrorRes{
+				Err:        fmt.Errorf("el estudiante ya tiene una prórroga en este trabajo, intenta nuevamente"),
+				StatusCode: http.StatusConflict,
+			}
+		}
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		idExtension = inserted.InsertedID
+	} else {
+		_, err = workExtensionModel.Use().ReplaceOne(
+			db.Ctx,
+			bson.D{{
+				Key:   "_id",
+				Value: extensionData.ID,
+			}},
+			modelExtension,
+		)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		idExtension = extensionData.ID
+	}
+	// Send notification
+	module, err := moduleService.GetModuleFromID(work.Module.Hex())
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	nats.PublishEncode("notify/classroom", res.NotifyClassroom{
+		Title: fmt.Sprintf("Prórroga en el trabajo %s", work.Title),
+		Link: fmt.Sprintf(
+			"/aula_virtual/clase/%s/trabajos/%s",
+			work.Module.Hex(),
+			work.ID.Hex(),
+		),
+		Where:  module.Subject.Hex(),
+		Room:   module.Section.Hex(),
+		Type:   res.WORK,
+		IDUser: extension.Student,
+	})
+	return idExtension, nil
+}
+
+func (w *WorkSerice) DeleteExtension(idWork, idExtension string) *res.ErrorRes {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjExtension, err := primitive.ObjectIDFromHex(idExtension)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get extension
+	var extension *models.WorkExtension
+	cursor := workExtensionModel.GetByID(idObjExtension)
+	if err := cursor.Decode(&extension); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return &res.ErrorRes{
+				Err:        fmt.Errorf("no existe la prórroga indicada"),
+				StatusCode: http.StatusNotFound,
+			}
+		}
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if extension.Work != idObjWork {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("esta prórroga no pertenece al trabajo indicado"),
+			StatusCode: http.StatusConflict,
+		}
+	}
+	// Get work
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if work.IsRevised {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("este trabajo ya está evaluado"),
+			StatusCode: http.StatusForbidden,
+		}
+	}
+	// Delete
+	_, err = workExtensionModel.Use().DeleteOne(db.Ctx, bson.D{{
+		Key:   "_id",
+		Value: idObjExtension,
+	}})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return nil
+}
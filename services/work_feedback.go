@@ -0,0 +1,408 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"github.com/CPU-commits/Intranet_BClassroom/funct"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (w *WorkSerice) getFeedbackStudent(
+	idObjWork,
+	idObjStudent primitive.ObjectID,
+) ([]models.WorkFeedback, error) {
+	var feedback []models.WorkFeedback
+
+	opts := options.Find().SetSort(bson.D{{
+		Key:   "date",
+		Value: 1,
+	}})
+	cursor, err := workFeedbackModel.GetAll(bson.D{
+		{
+			Key:   "work",
+			Value: idObjWork,
+		},
+		{
+			Key:   "student",
+			Value: idObjStudent,
+		},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &feedback); err != nil {
+		return nil, err
+	}
+	return feedback, nil
+}
+
+// Feedback of the questions indexed by question, the overall feedback is returned apart
+func (w *WorkSerice) getFeedbackQuestions(
+	feedback []models.WorkFeedback,
+) (map[primitive.ObjectID]*models.WorkFeedback, *models.WorkFeedback) {
+	var overall *models.WorkFeedback
+	questions := make(map[primitive.ObjectID]*models.WorkFeedback)
+	for i := range feedback {
+		if !feedback[i].Question.IsZero() {
+			questions[feedback[i].Question] = &feedback[i]
+		} else if feedback[i].File.IsZero() {
+			overall = &feedback[i]
+		}
+	}
+	return questions, overall
+}
+
+func (w *WorkSerice) notifyFeedback(work *models.Work, idStudents []string) error {
+	if len(idStudents) == 0 {
+		return nil
+	}
+	module, err := moduleService.GetModuleFromID(work.Module.Hex())
+	if err != nil {
+		return err
+	}
+	for _, idStudent := range idStudents {
+		nats.PublishEncode("notify/classroom", res.NotifyClassroom{
+			Title: fmt.Sprintf("Nuevos comentarios en el trabajo %s", work.Title),
+			Link: fmt.Sprintf(
+				"/aula_virtual/clase/%s/trabajos/%s",
+				work.Module.Hex(),
+				work.ID.Hex(),
+			),
+			Where:  module.Subject.Hex(),
+			Room:   module.Section.Hex(),
+			Type:   res.WORK,
+			IDUser: idStudent,
+		})
+	}
+	return nil
+}
+
+// Feedback is published with the work, students with feedback are notified
+func (w *WorkSerice) publishFeedback(work *models.Work) error {
+	students, err := workFeedbackModel.Use().Distinct(db.Ctx, "student", bson.D{{
+		Key:   "work",
+		Value: work.ID,
+	}})
+	if err != nil {
+		return err
+	}
+	var idStudents []string
+	for _, student := range students {
+		idStudents = append(idStudents, student.(primitive.ObjectID).Hex())
+	}
+	return w.notifyFeedback(work, idStudents)
+}
+
+func (w *WorkSerice) GetFeedback(idWork, idStudent string) ([]models.WorkFeedback, *res.ErrorRes) {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjStudent, err := primitive.ObjectIDFromHex(idStudent)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	feedback, err := w.getFeedbackStudent(idObjWork, idObjStudent)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return feedback, nil
+}
+
+func (w *WorkSerice) UploadFeedback(
+	feedback *forms.WorkFeedbackForm,
+	idWork,
+	idStudent,
+	idEvaluator string,
+) (interface{}, *res.ErrorRes) {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjStudent, err := primitive.ObjectIDFromHex(idStudent)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjEvaluator, err := primitive.ObjectIDFromHex(idEvaluator)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get work
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if time.Now().Before(work.DateLimit.Time()) {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("todavía no se puede evaluar el trabajo"),
+			StatusCode: http.StatusUnauthorized,
+		}
+	}
+	// Check student
+	students, err := w.getStudentsFromIdModule(work.Module.Hex())
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if !funct.Some(students, func(student Student) bool {
+		return student.User.ID == idStudent
+	}) {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("el estudiante no pertenece a este módulo"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Question
+	var idObjQuestion primitive.ObjectID
+	if feedback.Question != "" {
+		if work.Type != "form" {
+			return nil, &res.ErrorRes{
+				Err:        fmt.Errorf("solo se pueden comentar preguntas en un formulario"),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		idObjQuestion, err = primitive.ObjectIDFromHex(feedback.Question)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		questions, err := w.getQuestionsFromIdForm(work.Form)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		if !funct.Some(questions, func(question models.ItemQuestion) bool {
+			return question.ID == idObjQuestion
+		}) {
+			return nil, &res.ErrorRes{
+				Err:        fmt.Errorf("la pregunta no pertenece al trabajo indicado"),
+				StatusCode: http.StatusNotFound,
+			}
+		}
+	}
+	// File
+	var idObjFile primitive.ObjectID
+	if feedback.File != "" {
+		if work.Type != "files" {
+			return nil, &res.ErrorRes{
+				Err:        fmt.Errorf("solo se pueden comentar archivos en un trabajo de archivos"),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		idObjFile, err = primitive.ObjectIDFromHex(feedback.File)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		var fUC *models.FileUploadedClassroom
+		cursor := fileUCModel.GetOne(bson.D{
+			{
+				Key:   "student",
+				Value: idObjStudent,
+			},
+			{
+				Key:   "work",
+				Value: idObjWork,
+			},
+		})
+		if err := cursor.Decode(&fUC); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, &res.ErrorRes{
+					Err:        fmt.Errorf("no se encontraron archivos subidos por parte del alumno"),
+					StatusCode: http.StatusNotFound,
+				}
+			}
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		if !funct.Some(fUC.FilesUploaded, func(idFile primitive.ObjectID) bool {
+			return idFile == idObjFile
+		}) {
+			return nil, &res.ErrorRes{
+				Err:        fmt.Errorf("el archivo no fue subido por el alumno en este trabajo"),
+				StatusCode: http.StatusNotFound,
+			}
+		}
+	}
+	// Insert or update
+	filterOptional := func(key string, id primitive.ObjectID) bson.E {
+		if id.IsZero() {
+			return bson.E{
+				Key: key,
+				Value: bson.M{
+					"$exists": false,
+				},
+			}
+		}
+		return bson.E{
+			Key:   key,
+			Value: id,
+		}
+	}
+	filter := bson.D{
+		{
+			Key:   "work",
+			Value: idObjWork,
+		},
+		{
+			Key:   "student",
+			Value: idObjStudent,
+		},
+		filterOptional("question", idObjQuestion),
+		filterOptional("file", idObjFile),
+	}
+	var feedbackData *models.WorkFeedback
+	cursor := workFeedbackModel.GetOne(filter)
+	if err := cursor.Decode(&feedbackData); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	var idFeedback interface{}
+	if feedbackData == nil {
+		modelFeedback := models.NewModelWorkFeedback(
+			idObjWork,
+			idObjStudent,
+			idObjEvaluator,
+			idObjQuestion,
+			idObjFile,
+			feedback.Comment,
+		)
+		inserted, err := workFeedbackModel.NewDocument(modelFeedback)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		idFeedback = inserted.InsertedID
+	} else {
+		_, err = workFeedbackModel.Use().UpdateByID(db.Ctx, feedbackData.ID, bson.D{{
+			Key: "$set",
+			Value: bson.M{
+				"comment":     feedback.Comment,
+				"evaluator":   idObjEvaluator,
+				"update_date": primitive.NewDateTimeFromTime(time.Now()),
+			},
+		}})
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		idFeedback = feedbackData.ID
+	}
+	// Published feedback
+	if work.IsRevised {
+		released, err := w.isStudentGradeReleased(work, idObjStudent)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		if !released {
+			return idFeedback, nil
+		}
+		if err := w.notifyFeedback(work, []string{idStudent}); err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+	}
+	return idFeedback, nil
+}
+
+func (w *WorkSerice) DeleteFeedback(idWork, idFeedback string) *res.ErrorRes {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjFeedback, err := primitive.ObjectIDFromHex(idFeedback)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get feedback
+	var feedback *models.WorkFeedback
+	cursor := workFeedbackModel.GetByID(idObjFeedback)
+	if err := cursor.Decode(&feedback); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return &res.ErrorRes{
+				Err:        fmt.Errorf("no existe el comentario indicado"),
+				StatusCode: http.StatusNotFound,
+			}
+		}
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if feedback.Work != idObjWork {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("este comentario no pertenece al trabajo indicado"),
+			StatusCode: http.StatusConflict,
+		}
+	}
+	// Delete
+	_, err = workFeedbackModel.Use().DeleteOne(db.Ctx, bson.D{{
+		Key:   "_id",
+		Value: idObjFeedback,
+	}})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return nil
+}
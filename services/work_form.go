@@ -45,6 +45,17 @@ func (w *WorkSerice) GetForm(idWork, userId string) (map[string]interface{}, *re
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
+	if errRes := w.checkPublishedWork(work); errRes != nil {
+		return nil, errRes
+	}
+	// Student extension
+	work, err = w.getWorkStudent(work, idObjUser)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 
 	if work.Type != "form" {
 		return nil, &res.ErrorRes{
@@ -52,6 +63,14 @@ func (w *WorkSerice) GetForm(idWork, userId string) (map[string]interface{}, *re
 			StatusCode: http.StatusBadRequest,
 		}
 	}
+	// Members of a group answer the form of the group
+	idObjSubmitter, err := w.getSubmitter(work, idObjUser)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	if time.Now().Before(work.DateStart.Time()) {
 		return nil, &res.ErrorRes{
 			Err:        fmt.Errorf("no se puede acceder a este trabajo todavía"),
@@ -64,7 +83,7 @@ func (w *WorkSerice) GetForm(idWork, userId string) (map[string]interface{}, *re
 		return nil, errRes
 	}
 	// Get form access
-	formAccess, err := w.getAccessFromIdStudentNIdWork(idObjUser, idObjWork)
+	formAccess, err := w.getAccessFromIdStudentNIdWork(idObjSubmitter, idObjWork)
 	if err != nil && err.Error() != db.NO_SINGLE_DOCUMENT {
 		return nil, &res.ErrorRes{
 			Err:        err,
@@ -72,11 +91,17 @@ func (w *WorkSerice) GetForm(idWork, userId string) (map[string]interface{}, *re
 		}
 	}
 
-	if formAccess == nil && time.Now().Before(work.DateLimit.Time()) {
+	// Late policy
+	lateDateLimit := w.getLateDateLimit(work)
+	if formAccess == nil && time.Now().Before(lateDateLimit) {
+		if errRes := w.checkGroupSubmission(work, idObjUser); errRes != nil {
+			return nil, errRes
+		}
 		modelFormAccess := models.NewModelFormAccess(
-			idObjUser,
+			idObjSubmitter,
 			idObjWork,
 		)
+		modelFormAccess.Permutation = w.newFormPermutation(work, &form[0], idObjSubmitter, 1)
 		inserted, err := formAccessModel.NewDocument(modelFormAccess)
 		if err != nil {
 			return nil, &res.ErrorRes{
@@ -87,15 +112,11 @@ func (w *WorkSerice) GetForm(idWork, userId string) (map[string]interface{}, *re
 		var diff time.Duration
 		if work.FormAccess == "default" {
 			// !Warning - before work.DateLimit.Time().Sub()
-			diff = time.Until(work.DateLimit.Time())
+			diff = time.Until(lateDateLimit)
 		} else {
 			diff = time.Duration(work.TimeFormAccess * int(time.Second))
 		}
-		err = nats.PublishEncode("close_student_form", &CloseForm{
-			Work:    idWork,
-			Student: userId,
-			Diff:    diff.Hours(),
-		})
+		err = w.publishCloseForm(work, idObjSubmitter, diff, 1)
 		if err != nil {
 			return nil, &res.ErrorRes{
 				Err:        err,
@@ -103,19 +124,40 @@ func (w *WorkSerice) GetForm(idWork, userId string) (map[string]interface{}, *re
 			}
 		}
 		formAccess = &models.FormAccess{
-			ID:      inserted.InsertedID.(primitive.ObjectID),
-			Date:    primitive.NewDateTimeFromTime(time.Now()),
-			Student: idObjUser,
-			Work:    idObjWork,
-			Status:  "opened",
+			ID:          inserted.InsertedID.(primitive.ObjectID),
+			Date:        primitive.NewDateTimeFromTime(time.Now()),
+			Student:     idObjSubmitter,
+			Work:        idObjWork,
+			Status:      "opened",
+			Permutation: modelFormAccess.Permutation,
 		}
 	}
-	if formAccess == nil && time.Now().After(work.DateLimit.Time()) {
+	if formAccess == nil && time.Now().After(lateDateLimit) {
 		return nil, &res.ErrorRes{
 			Err:        fmt.Errorf("no accediste al formulario, no hay respuestas a revisar"),
 			StatusCode: http.StatusBadRequest,
 		}
 	}
+	// Student permutation
+	form[0].Items = w.applyFormPermutation(form[0].Items, formAccess.Permutation)
+	// Evaluations, feedback and points are shown once the grade is released
+	var released bool
+	if work.IsRevised {
+		if err := w.releaseDueWorkGrades(idObjWork); err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		released, err = w.isStudentGradeReleased(work, idObjUser)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+	}
+
 	var newItems []models.FormItemWLookup
 	// Answers
 	questionsLen := 0
@@ -128,7 +170,8 @@ func (w *WorkSerice) GetForm(idWork, userId string) (map[string]interface{}, *re
 	var wg sync.WaitGroup
 	c := make(chan (int), 5)
 
-	for j, item := range form[0].Items {
+	answersOffset := 0
+	for _, item := range form[0].Items {
 		var questions = make([]models.ItemQuestion, len(item.Questions))
 		var err error
 
@@ -136,11 +179,7 @@ func (w *WorkSerice) GetForm(idWork, userId string) (map[string]interface{}, *re
 			wg.Add(1)
 			c <- 1
 
-			answerIndex := i
-			for j != 0 {
-				answerIndex += len(form[0].Items[i].Questions)
-				j -= 1
-			}
+			answerIndex := answersOffset + i
 			go func(
 				wg *sync.WaitGroup,
 				question models.ItemQuestion,
@@ -152,20 +191,25 @@ func (w *WorkSerice) GetForm(idWork, userId string) (map[string]interface{}, *re
 				defer wg.Done()
 				var questionData models.ItemQuestion
 
-				if formAccess.Status != "revised" {
+				isRevised := formAccess.Status == "revised" && released
+				order := w.getAnswersOrder(formAccess.Permutation, question.ID)
+				if !isRevised {
 					questionData = models.ItemQuestion{
 						ID:       question.ID,
 						Type:     question.Type,
 						Question: question.Question,
 						Points:   question.Points,
 					}
-					if question.Type != "written" {
+					if question.Type == "matching" {
 						questionData.Answers = question.Answers
+						questionData.Options = w.permuteAnswers(question.Options, order)
+					} else if question.Type != "written" {
+						questionData.Answers = w.permuteAnswers(question.Answers, order)
 					}
 				} else {
 					questionData = question
 				}
-				answer, err := w.getAnswerStudent(idObjUser, idObjWork, question.ID)
+				answer, err := w.getAnswerStudent(idObjSubmitter, idObjWork, question.ID)
 				if err != nil && err.Error() != db.NO_SINGLE_DOCUMENT {
 					*returnErr = err
 					close(c)
@@ -173,19 +217,22 @@ func (w *WorkSerice) GetForm(idWork, userId string) (map[string]interface{}, *re
 				}
 				// Add answer
 				if answer != nil {
+					if !isRevised {
+						w.answerToDisplay(answer, question, order)
+					}
 					answers[iAnswer] = &AnswerRes{
 						Answer: *answer,
 					}
 				}
 				// Add evalute
-				if work.IsRevised && question.Type == "written" {
+				if released && question.Type == "written" {
 					var evaluate []models.EvaluatedAnswersWLookup
 
 					match := bson.D{{
 						Key: "$match",
 						Value: bson.M{
 							"question": question.ID,
-							"student":  idObjUser,
+							"student":  idObjSubmitter,
 							"work":     idObjWork,
 						},
 					}}
@@ -237,6 +284,7 @@ func (w *WorkSerice) GetForm(idWork, userId string) (map[string]interface{}, *re
 				<-c
 			}(&wg, question, questions, i, answerIndex, &err)
 		}
+		answersOffset += len(item.Questions)
 		wg.Wait()
 		if err != nil {
 			return nil, &res.ErrorRes{
@@ -245,6 +293,7 @@ func (w *WorkSerice) GetForm(idWork, userId string) (map[string]interface{}, *re
 			}
 		}
 		newItems = append(newItems, models.FormItemWLookup{
+			ID:         item.ID,
 			Title:      item.Title,
 			PointsType: item.PointsType,
 			Questions:  questions,
@@ -252,24 +301,54 @@ func (w *WorkSerice) GetForm(idWork, userId string) (map[string]interface{}, *re
 	}
 
 	form[0].Items = newItems
+	response := make(map[string]interface{})
+	// Feedback
+	if released {
+		feedback, err := w.getFeedbackStudent(idObjWork, idObjUser)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		feedbackQuestions, overall := w.getFeedbackQuestions(feedback)
+		iAnswer := 0
+		for _, item := range form[0].Items {
+			for _, question := range item.Questions {
+				if answers[iAnswer] != nil {
+					answers[iAnswer].Feedback = feedbackQuestions[question.ID]
+				}
+				iAnswer += 1
+			}
+		}
+		response["feedback"] = overall
+	}
 	// Calculate rest time
 	var dateLimit time.Time
 	if work.FormAccess == "wtime" {
 		datePlusTime := formAccess.Date.Time().Add(time.Duration(work.TimeFormAccess * int(time.Second)))
-		if datePlusTime.Before(work.DateLimit.Time()) {
+		if datePlusTime.Before(lateDateLimit) {
 			dateLimit = datePlusTime
 		} else {
-			dateLimit = work.DateLimit.Time()
+			dateLimit = lateDateLimit
 		}
 	}
 	// Return response
-	response := make(map[string]interface{})
 	workResponse := make(map[string]interface{})
 	workResponse["wtime"] = work.FormAccess == "wtime"
 	workResponse["date_limit"] = dateLimit
+	// Attempts
+	if work.Attempts != nil {
+		attempt := formAccess.Attempt
+		if attempt == 0 {
+			attempt = 1
+		}
+		workResponse["attempt"] = attempt
+		workResponse["attempts"] = work.Attempts.Quantity
+	}
 	// Status
 	isClosedWTime := work.FormAccess == "wtime" && time.Now().After(dateLimit)
-	isClosed := time.Now().After(work.DateLimit.Time()) || formAccess.Status == "finished" || isClosedWTime
+	isClosed := time.Now().After(lateDateLimit) || formAccess.Status == "finished" || isClosedWTime
 	if formAccess.Status == "revised" {
 		workResponse["status"] = "revised"
 	} else if isClosed {
@@ -278,21 +357,19 @@ func (w *WorkSerice) GetForm(idWork, userId string) (map[string]interface{}, *re
 		workResponse["status"] = "opened"
 	}
 	// Get points
-	if work.IsRevised {
+	if released {
 		var questionsWPoints []models.ItemQuestion
 		maxPoints := 0
 		for _, item := range form[0].Items {
-			for _, question := range item.Questions {
-				if question.Type != "alternatives" {
-					maxPoints += question.Points
-					questionsWPoints = append(questionsWPoints, question)
-				}
+			for _, question := range w.getQuestionsWPoints(work, item.Questions) {
+				maxPoints += question.Points
+				questionsWPoints = append(questionsWPoints, question)
 			}
 		}
 
 		totalPoints, _, err := w.getStudentEvaluate(
 			questionsWPoints,
-			idObjUser,
+			idObjSubmitter,
 			idObjWork,
 		)
 		if err != nil {
@@ -318,7 +395,7 @@ func (w *WorkSerice) GetForm(idWork, userId string) (map[string]interface{}, *re
 func (w *WorkSerice) GetFormStudent(
 	idWork,
 	idStudent string,
-) (*models.FormWLookup, []AnswerRes, *res.ErrorRes) {
+) (*models.FormWLookup, []AnswerRes, []models.FormAttempt, *res.ErrorRes) {
 	// Recovery if close channel
 	defer func() {
 		recovery := recover()
@@ -329,14 +406,14 @@ func (w *WorkSerice) GetFormStudent(
 
 	idObjWork, err := primitive.ObjectIDFromHex(idWork)
 	if err != nil {
-		return nil, nil, &res.ErrorRes{
+		return nil, nil, nil, &res.ErrorRes{
 			Err:        err,
 			StatusCode: http.StatusBadRequest,
 		}
 	}
 	idObjStudent, err := primitive.ObjectIDFromHex(idStudent)
 	if err != nil {
-		return nil, nil, &res.ErrorRes{
+		return nil, nil, nil, &res.ErrorRes{
 			Err:        err,
 			StatusCode: http.StatusBadRequest,
 		}
@@ -344,19 +421,27 @@ func (w *WorkSerice) GetFormStudent(
 	// Get work
 	work, err := workRepository.GetWorkFromId(idObjWork)
 	if err != nil {
-		return nil, nil, &res.ErrorRes{
+		return nil, nil, nil, &res.ErrorRes{
 			Err:        err,
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
 	if work.Type != "form" {
-		return nil, nil, &res.ErrorRes{
+		return nil, nil, nil, &res.ErrorRes{
 			Err:        fmt.Errorf("el trabajo no es un formulario"),
 			StatusCode: http.StatusBadRequest,
 		}
 	}
+	// Answers of the group of the student
+	idObjSubmitter, err := w.getSubmitter(work, idObjStudent)
+	if err != nil {
+		return nil, nil, nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
 	if time.Now().Before(work.DateLimit.Time()) {
-		return nil, nil, &res.ErrorRes{
+		return nil, nil, nil, &res.ErrorRes{
 			Err:        fmt.Errorf("este formulario todavía no se puede evaluar"),
 			StatusCode: http.StatusUnauthorized,
 		}
@@ -364,25 +449,27 @@ func (w *WorkSerice) GetFormStudent(
 	// Get form
 	form, errRes := formService.GetForm(work.Form.Hex(), primitive.NilObjectID.Hex(), false)
 	if errRes != nil {
-		return nil, nil, &res.ErrorRes{
+		return nil, nil, nil, &res.ErrorRes{
 			Err:        err,
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
 	// Get access student
-	formAccess, err := w.getAccessFromIdStudentNIdWork(idObjStudent, idObjWork)
+	formAccess, err := w.getAccessFromIdStudentNIdWork(idObjSubmitter, idObjWork)
 	if err != nil && err.Error() != db.NO_SINGLE_DOCUMENT {
-		return nil, nil, &res.ErrorRes{
+		return nil, nil, nil, &res.ErrorRes{
 			Err:        err,
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
 	if formAccess == nil {
-		return nil, nil, &res.ErrorRes{
+		return nil, nil, nil, &res.ErrorRes{
 			Err:        fmt.Errorf("este alumno no ha tiene respuestas, ya que no abrió el formulario"),
 			StatusCode: http.StatusBadRequest,
 		}
 	}
+	// Student permutation
+	form[0].Items = w.applyFormPermutation(form[0].Items, formAccess.Permutation)
 	// Get answers
 	questionsLen := 0
 	for _, item := range form[0].Items {
@@ -394,25 +481,24 @@ func (w *WorkSerice) GetFormStudent(
 	var wg sync.WaitGroup
 	c := make(chan (int), 5)
 
-	for i, item := range form[0].Items {
+	answersOffset := 0
+	for _, item := range form[0].Items {
 		for j, question := range item.Questions {
 			wg.Add(1)
 			c <- 1
 
-			iAnswer := j
-			for i != 0 {
-				iAnswer += len(form[0].Items[i].Questions)
-				i -= 1
-			}
+			iAnswer := answersOffset + j
 			go func(question models.ItemQuestion, iAnswer int, wg *sync.WaitGroup, errRet *error) {
 				defer wg.Done()
 
-				answer, err := w.getAnswerStudent(idObjStudent, idObjWork, question.ID)
+				answer, err := w.getAnswerStudent(idObjSubmitter, idObjWork, question.ID)
 				if err != nil {
 					if err.Error() != db.NO_SINGLE_DOCUMENT {
 						*errRet = err
+						close(c)
+						return
 					}
-					close(c)
+					<-c
 					return
 				}
 				// Get evaluate
@@ -425,7 +511,7 @@ func (w *WorkSerice) GetFormStudent(
 						},
 						{
 							Key:   "student",
-							Value: idObjStudent,
+							Value: idObjSubmitter,
 						},
 						{
 							Key:   "work",
@@ -445,16 +531,41 @@ func (w *WorkSerice) GetFormStudent(
 				<-c
 			}(question, iAnswer, &wg, &err)
 		}
+		answersOffset += len(item.Questions)
 	}
 	wg.Wait()
 	if err != nil {
-		return nil, nil, &res.ErrorRes{
+		return nil, nil, nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	// Get feedback
+	feedback, err := w.getFeedbackStudent(idObjWork, idObjStudent)
+	if err != nil {
+		return nil, nil, nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	feedbackQuestions, _ := w.getFeedbackQuestions(feedback)
+	iAnswer := 0
+	for _, item := range form[0].Items {
+		for _, question := range item.Questions {
+			answers[iAnswer].Feedback = feedbackQuestions[question.ID]
+			iAnswer += 1
+		}
+	}
+	// Get previous attempts
+	attempts, err := w.getAttemptsStudent(idObjWork, idObjSubmitter)
+	if err != nil {
+		return nil, nil, nil, &res.ErrorRes{
 			Err:        err,
 			StatusCode: http.StatusServiceUnavailable,
 		}
 	}
 	// Get
-	return &form[0], answers, nil
+	return &form[0], answers, attempts, nil
 }
 
 func (w *WorkSerice) getQuestionsFromIdForm(idForm primitive.ObjectID) ([]models.ItemQuestion, error) {
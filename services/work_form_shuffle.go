@@ -0,0 +1,263 @@
+package services
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Question types whose answer options can be shuffled
+func (w *WorkSerice) isShuffleAnswers(question models.ItemQuestion) bool {
+	switch question.Type {
+	case "alternatives", "alternatives_correct", "multiple_select", "ordering":
+		return len(question.Answers) > 1
+	case "matching":
+		return len(question.Options) > 1
+	}
+	return false
+}
+
+func (w *WorkSerice) checkFormShuffle(formShuffle *models.WorkFormShuffle, form *models.Form) error {
+	if formShuffle == nil {
+		return nil
+	}
+	itemsDraw := make(map[primitive.ObjectID]bool)
+	for _, draw := range formShuffle.Draws {
+		if itemsDraw[draw.Item] {
+			return fmt.Errorf("no se puede sortear dos veces el mismo item del formulario")
+		}
+		itemsDraw[draw.Item] = true
+
+		index := -1
+		for i, item := range form.Items {
+			if item.ID == draw.Item {
+				index = i
+				break
+			}
+		}
+		if index == -1 {
+			return fmt.Errorf("el item a sortear no pertenece al formulario")
+		}
+		if draw.Quantity > len(form.Items[index].Questions) {
+			return fmt.Errorf("no se pueden sortear más preguntas de las que tiene el item")
+		}
+	}
+	return nil
+}
+
+// Permutation of the form for the student, it's seeded by work, student
+// and attempt so it's the same every time it's generated
+func (w *WorkSerice) newFormPermutation(
+	work *models.Work,
+	form *models.FormWLookup,
+	idObjStudent primitive.ObjectID,
+	attempt int,
+) *models.FormPermutation {
+	if work.FormShuffle == nil {
+		return nil
+	}
+	formShuffle := work.FormShuffle
+
+	seed := work.ID.Hex() + idObjStudent.Hex()
+	if attempt > 1 {
+		seed += fmt.Sprintf("%d", attempt)
+	}
+	hash := fnv.New64a()
+	hash.Write([]byte(seed))
+	random := rand.New(rand.NewSource(int64(hash.Sum64())))
+
+	permutation := &models.FormPermutation{}
+	for _, item := range form.Items {
+		questions := append([]models.ItemQuestion{}, item.Questions...)
+		// Draw
+		for _, draw := range formShuffle.Draws {
+			if draw.Item == item.ID && draw.Quantity < len(questions) {
+				indexes := random.Perm(len(questions))[:draw.Quantity]
+				sort.Ints(indexes)
+
+				drawn := make([]models.ItemQuestion, len(indexes))
+				for i, index := range indexes {
+					drawn[i] = questions[index]
+				}
+				questions = drawn
+			}
+		}
+		if formShuffle.Questions {
+			random.Shuffle(len(questions), func(i, j int) {
+				questions[i], questions[j] = questions[j], questions[i]
+			})
+		}
+		permutationItem := models.FormAccessItem{
+			Item: item.ID,
+		}
+		for _, question := range questions {
+			permutationItem.Questions = append(permutationItem.Questions, question.ID)
+			// Answers
+			if formShuffle.Answers && w.isShuffleAnswers(question) {
+				lenAnswers := len(question.Answers)
+				if question.Type == "matching" {
+					lenAnswers = len(question.Options)
+				}
+				permutation.Answers = append(permutation.Answers, models.FormAccessAnswers{
+					Question: question.ID,
+					Order:    random.Perm(lenAnswers),
+				})
+			}
+		}
+		permutation.Items = append(permutation.Items, permutationItem)
+	}
+	if formShuffle.Items {
+		random.Shuffle(len(permutation.Items), func(i, j int) {
+			permutation.Items[i], permutation.Items[j] = permutation.Items[j], permutation.Items[i]
+		})
+	}
+	return permutation
+}
+
+// Items and questions in the order of the student permutation
+func (w *WorkSerice) applyFormPermutation(
+	items []models.FormItemWLookup,
+	permutation *models.FormPermutation,
+) []models.FormItemWLookup {
+	if permutation == nil {
+		return items
+	}
+	var newItems []models.FormItemWLookup
+	for _, permutationItem := range permutation.Items {
+		for _, item := range items {
+			if item.ID != permutationItem.Item {
+				continue
+			}
+			var questions []models.ItemQuestion
+			for _, idQuestion := range permutationItem.Questions {
+				for _, question := range item.Questions {
+					if question.ID == idQuestion {
+						questions = append(questions, question)
+						break
+					}
+				}
+			}
+			item.Questions = questions
+			newItems = append(newItems, item)
+			break
+		}
+	}
+	return newItems
+}
+
+// Questions that the student received, all if the form isn't permuted
+func (w *WorkSerice) getQuestionsStudent(
+	questions []models.ItemQuestion,
+	access *models.FormAccess,
+) []models.ItemQuestion {
+	if access == nil || access.Permutation == nil {
+		return questions
+	}
+	var questionsStudent []models.ItemQuestion
+	for _, question := range questions {
+		if w.hasQuestionPermutation(access.Permutation, question.ID) {
+			questionsStudent = append(questionsStudent, question)
+		}
+	}
+	return questionsStudent
+}
+
+func (w *WorkSerice) hasQuestionPermutation(
+	permutation *models.FormPermutation,
+	idQuestion primitive.ObjectID,
+) bool {
+	for _, item := range permutation.Items {
+		for _, idObjQuestion := range item.Questions {
+			if idObjQuestion == idQuestion {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (w *WorkSerice) getAnswersOrder(
+	permutation *models.FormPermutation,
+	idQuestion primitive.ObjectID,
+) []int {
+	if permutation == nil {
+		return nil
+	}
+	for _, answers := range permutation.Answers {
+		if answers.Question == idQuestion {
+			return answers.Order
+		}
+	}
+	return nil
+}
+
+// Answer options of the question in the displayed order
+func (w *WorkSerice) permuteAnswers(answers []string, order []int) []string {
+	if order == nil || len(order) != len(answers) {
+		return answers
+	}
+	permuted := make([]string, len(order))
+	for display, canonical := range order {
+		permuted[display] = answers[canonical]
+	}
+	return permuted
+}
+
+// Map the displayed indexes sent by the student to the canonical indexes
+func (w *WorkSerice) answerToCanonical(answer *forms.AnswerForm, order []int) (*forms.AnswerForm, error) {
+	if order == nil {
+		return answer, nil
+	}
+	toCanonical := func(display int) (int, error) {
+		if display < 0 || display >= len(order) {
+			return 0, fmt.Errorf("indique una respuesta válida")
+		}
+		return order[display], nil
+	}
+
+	canonical := *answer
+	if answer.Answer != nil {
+		index, err := toCanonical(*answer.Answer)
+		if err != nil {
+			return nil, err
+		}
+		canonical.Answer = &index
+	}
+	if answer.Answers != nil {
+		canonical.Answers = make([]int, len(answer.Answers))
+		for i, display := range answer.Answers {
+			index, err := toCanonical(display)
+			if err != nil {
+				return nil, err
+			}
+			canonical.Answers[i] = index
+		}
+	}
+	return &canonical, nil
+}
+
+// Map the stored canonical indexes to the indexes displayed to the student
+func (w *WorkSerice) answerToDisplay(answer *models.Answer, question models.ItemQuestion, order []int) {
+	if order == nil {
+		return
+	}
+	toDisplay := make(map[int]int)
+	for display, canonical := range order {
+		toDisplay[canonical] = display
+	}
+	if question.Type == "alternatives" || question.Type == "alternatives_correct" {
+		answer.Answer = toDisplay[answer.Answer]
+	}
+	if answer.Answers != nil {
+		answers := make([]int, len(answer.Answers))
+		for i, canonical := range answer.Answers {
+			answers[i] = toDisplay[canonical]
+		}
+		answer.Answers = answers
+	}
+}
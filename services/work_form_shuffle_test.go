@@ -0,0 +1,278 @@
+package services
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func newShuffleForm(items, questions int) *models.FormWLookup {
+	form := &models.FormWLookup{}
+	for i := 0; i < items; i++ {
+		item := models.FormItemWLookup{
+			ID: primitive.NewObjectID(),
+		}
+		for j := 0; j < questions; j++ {
+			item.Questions = append(item.Questions, models.ItemQuestion{
+				ID:      primitive.NewObjectID(),
+				Type:    "alternatives_correct",
+				Answers: []string{"a", "b", "c", "d"},
+			})
+		}
+		form.Items = append(form.Items, item)
+	}
+	return form
+}
+
+func permutationQuestions(permutation *models.FormPermutation) []primitive.ObjectID {
+	var questions []primitive.ObjectID
+	for _, item := range permutation.Items {
+		questions = append(questions, item.Questions...)
+	}
+	return questions
+}
+
+func TestNewFormPermutation(t *testing.T) {
+	form := newShuffleForm(3, 6)
+	idObjStudent := primitive.NewObjectID()
+
+	tests := []struct {
+		name    string
+		shuffle *models.WorkFormShuffle
+		check   func(t *testing.T, permutation *models.FormPermutation)
+	}{
+		{
+			name:    "without shuffle",
+			shuffle: nil,
+			check: func(t *testing.T, permutation *models.FormPermutation) {
+				if permutation != nil {
+					t.Errorf("permutation = %v, want nil", permutation)
+				}
+			},
+		},
+		{
+			name:    "keeps all the questions",
+			shuffle: &models.WorkFormShuffle{Items: true, Questions: true},
+			check: func(t *testing.T, permutation *models.FormPermutation) {
+				if len(permutation.Items) != len(form.Items) {
+					t.Fatalf("items = %d, want %d", len(permutation.Items), len(form.Items))
+				}
+				for _, permutationItem := range permutation.Items {
+					var want []string
+					for _, item := range form.Items {
+						if item.ID == permutationItem.Item {
+							for _, question := range item.Questions {
+								want = append(want, question.ID.Hex())
+							}
+						}
+					}
+					var got []string
+					for _, idQuestion := range permutationItem.Questions {
+						got = append(got, idQuestion.Hex())
+					}
+					sort.Strings(want)
+					sort.Strings(got)
+					if !reflect.DeepEqual(got, want) {
+						t.Errorf("questions of item %s = %v, want %v", permutationItem.Item.Hex(), got, want)
+					}
+				}
+				if len(permutation.Answers) != 0 {
+					t.Errorf("answers = %d, want 0", len(permutation.Answers))
+				}
+			},
+		},
+		{
+			name: "draws questions of an item",
+			shuffle: &models.WorkFormShuffle{
+				Draws: []models.WorkFormDraw{{
+					Item:     form.Items[1].ID,
+					Quantity: 2,
+				}},
+			},
+			check: func(t *testing.T, permutation *models.FormPermutation) {
+				for i, permutationItem := range permutation.Items {
+					want := len(form.Items[i].Questions)
+					if i == 1 {
+						want = 2
+					}
+					if len(permutationItem.Questions) != want {
+						t.Errorf("questions of item %d = %d, want %d", i, len(permutationItem.Questions), want)
+					}
+				}
+				// Without shuffle the drawn questions keep the order of the form
+				drawn := permutation.Items[1].Questions
+				last := -1
+				for _, idQuestion := range drawn {
+					index := -1
+					for j, question := range form.Items[1].Questions {
+						if question.ID == idQuestion {
+							index = j
+						}
+					}
+					if index <= last {
+						t.Fatalf("drawn questions %v are not in the order of the form", drawn)
+					}
+					last = index
+				}
+			},
+		},
+		{
+			name:    "shuffles the answers",
+			shuffle: &models.WorkFormShuffle{Answers: true},
+			check: func(t *testing.T, permutation *models.FormPermutation) {
+				if len(permutation.Answers) != 18 {
+					t.Fatalf("answers = %d, want 18", len(permutation.Answers))
+				}
+				for _, answers := range permutation.Answers {
+					order := append([]int{}, answers.Order...)
+					sort.Ints(order)
+					if !reflect.DeepEqual(order, []int{0, 1, 2, 3}) {
+						t.Errorf("order %v is not a permutation of the answers", answers.Order)
+					}
+				}
+			},
+		},
+	}
+
+	w := &WorkSerice{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			work := &models.Work{
+				ID:          primitive.NewObjectID(),
+				FormShuffle: test.shuffle,
+			}
+			test.check(t, w.newFormPermutation(work, form, idObjStudent, 1))
+		})
+	}
+}
+
+func TestNewFormPermutationSeed(t *testing.T) {
+	form := newShuffleForm(4, 10)
+	work := &models.Work{
+		ID: primitive.NewObjectID(),
+		FormShuffle: &models.WorkFormShuffle{
+			Items:     true,
+			Questions: true,
+			Answers:   true,
+		},
+	}
+	idObjStudent := primitive.NewObjectID()
+
+	w := &WorkSerice{}
+	permutation := w.newFormPermutation(work, form, idObjStudent, 1)
+	if again := w.newFormPermutation(work, form, idObjStudent, 1); !reflect.DeepEqual(permutation, again) {
+		t.Errorf("the permutation of the same student and attempt changed")
+	}
+	other := w.newFormPermutation(work, form, primitive.NewObjectID(), 1)
+	if reflect.DeepEqual(permutationQuestions(permutation), permutationQuestions(other)) {
+		t.Errorf("two students got the same order of questions")
+	}
+	next := w.newFormPermutation(work, form, idObjStudent, 2)
+	if reflect.DeepEqual(permutationQuestions(permutation), permutationQuestions(next)) {
+		t.Errorf("two attempts got the same order of questions")
+	}
+}
+
+func TestApplyFormPermutation(t *testing.T) {
+	form := newShuffleForm(2, 3)
+	items := form.Items
+
+	tests := []struct {
+		name        string
+		permutation *models.FormPermutation
+		want        [][]primitive.ObjectID
+	}{
+		{
+			name:        "without permutation",
+			permutation: nil,
+			want: [][]primitive.ObjectID{
+				{items[0].Questions[0].ID, items[0].Questions[1].ID, items[0].Questions[2].ID},
+				{items[1].Questions[0].ID, items[1].Questions[1].ID, items[1].Questions[2].ID},
+			},
+		},
+		{
+			name: "items and questions in the order of the permutation",
+			permutation: &models.FormPermutation{
+				Items: []models.FormAccessItem{
+					{
+						Item: items[1].ID,
+						Questions: []primitive.ObjectID{
+							items[1].Questions[2].ID,
+							items[1].Questions[0].ID,
+							items[1].Questions[1].ID,
+						},
+					},
+					{
+						Item: items[0].ID,
+						Questions: []primitive.ObjectID{
+							items[0].Questions[1].ID,
+							items[0].Questions[0].ID,
+							items[0].Questions[2].ID,
+						},
+					},
+				},
+			},
+			want: [][]primitive.ObjectID{
+				{items[1].Questions[2].ID, items[1].Questions[0].ID, items[1].Questions[1].ID},
+				{items[0].Questions[1].ID, items[0].Questions[0].ID, items[0].Questions[2].ID},
+			},
+		},
+		{
+			name: "only the drawn questions",
+			permutation: &models.FormPermutation{
+				Items: []models.FormAccessItem{
+					{
+						Item:      items[0].ID,
+						Questions: []primitive.ObjectID{items[0].Questions[2].ID},
+					},
+					{
+						Item:      items[1].ID,
+						Questions: []primitive.ObjectID{items[1].Questions[0].ID, items[1].Questions[1].ID},
+					},
+				},
+			},
+			want: [][]primitive.ObjectID{
+				{items[0].Questions[2].ID},
+				{items[1].Questions[0].ID, items[1].Questions[1].ID},
+			},
+		},
+		{
+			name: "without the items missing in the permutation",
+			permutation: &models.FormPermutation{
+				Items: []models.FormAccessItem{
+					{
+						Item:      items[1].ID,
+						Questions: []primitive.ObjectID{items[1].Questions[1].ID},
+					},
+				},
+			},
+			want: [][]primitive.ObjectID{
+				{items[1].Questions[1].ID},
+			},
+		},
+	}
+
+	w := &WorkSerice{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got [][]primitive.ObjectID
+			for _, item := range w.applyFormPermutation(items, test.permutation) {
+				var questions []primitive.ObjectID
+				for _, question := range item.Questions {
+					questions = append(questions, question.ID)
+				}
+				got = append(got, questions)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("applyFormPermutation() = %v, want %v", got, test.want)
+			}
+		})
+	}
+	// The questions of the form are not modified
+	if len(items[0].Questions) != 3 || len(items[1].Questions) != 3 {
+		t.Errorf("applyFormPermutation() modified the items of the form")
+	}
+}
@@ -0,0 +1,888 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"github.com/CPU-commits/Intranet_BClassroom/funct"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Group of the student in the work, nil if the student works alone
+func (w *WorkSerice) getStudentGroup(
+	idObjWork,
+	idObjStudent primitive.ObjectID,
+) (*models.WorkGroup, error) {
+	var group *models.WorkGroup
+	cursor := workGroupsModel.GetOne(bson.D{
+		{
+			Key:   "work",
+			Value: idObjWork,
+		},
+		{
+			Key:   "members",
+			Value: idObjStudent,
+		},
+	})
+	if err := cursor.Decode(&group); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return group, nil
+}
+
+// Student that keeps the submission of the group of the student,
+// the student itself if it works alone
+func (w *WorkSerice) getSubmitter(
+	work *models.Work,
+	idObjStudent primitive.ObjectID,
+) (primitive.ObjectID, error) {
+	if work.Groups == nil {
+		return idObjStudent, nil
+	}
+	group, err := w.getStudentGroup(work.ID, idObjStudent)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	if group == nil {
+		return idObjStudent, nil
+	}
+	return group.Members[0], nil
+}
+
+// Students that share the grade of the student
+func (w *WorkSerice) getGroupMembers(
+	work *models.Work,
+	idObjStudent primitive.ObjectID,
+) ([]primitive.ObjectID, error) {
+	if work.Groups == nil {
+		return []primitive.ObjectID{idObjStudent}, nil
+	}
+	group, err := w.getStudentGroup(work.ID, idObjStudent)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return []primitive.ObjectID{idObjStudent}, nil
+	}
+	return group.Members, nil
+}
+
+func (w *WorkSerice) getWorkGroups(idObjWork primitive.ObjectID) ([]models.WorkGroup, error) {
+	var groups []models.WorkGroup
+	cursor, err := workGroupsModel.GetAll(bson.D{{
+		Key:   "work",
+		Value: idObjWork,
+	}}, &options.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &groups); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// Grade of the group with the adjustment of the member
+func (w *WorkSerice) applyGroupAdjustment(
+	group *models.WorkGroup,
+	idObjStudent primitive.ObjectID,
+	grade float64,
+	scale GradingScale,
+) float64 {
+	if group == nil {
+		return grade
+	}
+	adjustment := group.GetAdjustment(idObjStudent)
+	if adjustment == 0 {
+		return grade
+	}
+	return roundGrade(scale, grade+adjustment)
+}
+
+func (w *WorkSerice) applyGroupsAdjustments(
+	work *models.Work,
+	studentsGrade []StudentGrades,
+) error {
+	if work.Groups == nil {
+		return nil
+	}
+	groups, err := w.getWorkGroups(work.ID)
+	if err != nil {
+		return err
+	}
+	scale, err := getGradingScale(work.Module)
+	if err != nil {
+		return err
+	}
+	for i := range groups {
+		for j, student := range studentsGrade {
+			if groups[i].IsMember(student.ID) {
+				studentsGrade[j].Grade = w.applyGroupAdjustment(
+					&groups[i],
+					student.ID,
+					student.Grade,
+					scale,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// The student has a form access, files uploaded or a session in the work
+func (w *WorkSerice) hasSubmission(work *models.Work, idObjStudent primitive.ObjectID) (bool, error) {
+	filter := bson.D{
+		{
+			Key:   "work",
+			Value: work.ID,
+		},
+		{
+			Key:   "student",
+			Value: idObjStudent,
+		},
+	}
+	var collection *mongo.Collection
+	if work.Type == "form" {
+		collection = formAccessModel.Use()
+	} else if work.Type == "files" {
+		collection = fileUCModel.Use()
+	} else {
+		collection = sessionModel.Use()
+	}
+	count, err := collection.CountDocuments(db.Ctx, filter)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Students of group works can submit if their group reaches the minimum
+// size, without a group they can submit only if the minimum size is one
+func (w *WorkSerice) checkGroupSubmission(work *models.Work, idObjStudent primitive.ObjectID) *res.ErrorRes {
+	if work.Groups == nil {
+		return nil
+	}
+	group, err := w.getStudentGroup(work.ID, idObjStudent)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if group == nil && work.Groups.MinSize > 1 {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("debes pertenecer a un grupo para entregar este trabajo"),
+			StatusCode: http.StatusUnauthorized,
+		}
+	}
+	if group != nil && len(group.Members) < work.Groups.MinSize {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("el grupo debe tener al menos %d integrantes para entregar", work.Groups.MinSize),
+			StatusCode: http.StatusUnauthorized,
+		}
+	}
+	return nil
+}
+
+// Members of a group can't change once the group has a submission
+func (w *WorkSerice) checkGroupEditable(work *models.Work, group *models.WorkGroup) *res.ErrorRes {
+	if work.IsRevised {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("este trabajo ya está evaluado"),
+			StatusCode: http.StatusForbidden,
+		}
+	}
+	hasSubmission, err := w.hasSubmission(work, group.Members[0])
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if hasSubmission {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("el grupo ya tiene una entrega, no se pueden modificar sus integrantes"),
+			StatusCode: http.StatusConflict,
+		}
+	}
+	return nil
+}
+
+// New members can't belong to another group nor have their own submission
+func (w *WorkSerice) checkNewMembers(
+	work *models.Work,
+	members []primitive.ObjectID,
+	idObjGroup primitive.ObjectID,
+) *res.ErrorRes {
+	for _, member := range members {
+		group, err := w.getStudentGroup(work.ID, member)
+		if err != nil {
+			return &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		if group != nil && group.ID != idObjGroup {
+			return &res.ErrorRes{
+				Err:        fmt.Errorf("el estudiante %s ya pertenece a otro grupo", member.Hex()),
+				StatusCode: http.StatusConflict,
+			}
+		}
+		if group != nil {
+			continue
+		}
+		hasSubmission, err := w.hasSubmission(work, member)
+		if err != nil {
+			return &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		if hasSubmission {
+			return &res.ErrorRes{
+				Err:        fmt.Errorf("el estudiante %s ya tiene una entrega en este trabajo", member.Hex()),
+				StatusCode: http.StatusConflict,
+			}
+		}
+	}
+	return nil
+}
+
+func (w *WorkSerice) getGroupWork(idObjWork primitive.ObjectID) (*models.Work, *res.ErrorRes) {
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if work.Groups == nil {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("este trabajo no es en grupo"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	return work, nil
+}
+
+func (w *WorkSerice) getGroupFromWork(idObjWork, idObjGroup primitive.ObjectID) (*models.WorkGroup, *res.ErrorRes) {
+	var group *models.WorkGroup
+	cursor := workGroupsModel.GetByID(idObjGroup)
+	if err := cursor.Decode(&group); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, &res.ErrorRes{
+				Err:        fmt.Errorf("no existe el grupo indicado"),
+				StatusCode: http.StatusNotFound,
+			}
+		}
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if group.Work != idObjWork {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("este grupo no pertenece al trabajo indicado"),
+			StatusCode: http.StatusConflict,
+		}
+	}
+	return group, nil
+}
+
+// Members of the form, they must be students of the module
+func (w *WorkSerice) getFormMembers(work *models.Work, group *forms.WorkGroupForm) ([]primitive.ObjectID, *res.ErrorRes) {
+	if len(group.Members) < work.Groups.MinSize || len(group.Members) > work.Groups.MaxSize {
+		return nil, &res.ErrorRes{
+			Err: fmt.Errorf(
+				"el grupo debe tener entre %d y %d integrantes",
+				work.Groups.MinSize,
+				work.Groups.MaxSize,
+			),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	students, err := w.getStudentsFromIdModule(work.Module.Hex())
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	var members []primitive.ObjectID
+	for _, member := range group.Members {
+		idObjMember, err := primitive.ObjectIDFromHex(member)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		if funct.Some(members, func(idObj primitive.ObjectID) bool {
+			return idObj == idObjMember
+		}) {
+			return nil, &res.ErrorRes{
+				Err:        fmt.Errorf("el estudiante %s está repetido en el grupo", member),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		if !funct.Some(students, func(student Student) bool {
+			return student.User.ID == member
+		}) {
+			return nil, &res.ErrorRes{
+				Err:        fmt.Errorf("el estudiante %s no pertenece a este módulo", member),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		members = append(members, idObjMember)
+	}
+	return members, nil
+}
+
+func (w *WorkSerice) GetGroups(idWork string) ([]models.WorkGroupWLookup, *res.ErrorRes) {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get groups
+	var groups []models.WorkGroupWLookup
+
+	match := bson.D{{
+		Key: "$match",
+		Value: bson.M{
+			"work": idObjWork,
+		},
+	}}
+	lookup := bson.D{{
+		Key: "$lookup",
+		Value: bson.M{
+			"from":         models.USERS_COLLECTION,
+			"localField":   "members",
+			"foreignField": "_id",
+			"as":           "members",
+			"pipeline": bson.A{bson.D{{
+				Key: "$project",
+				Value: bson.M{
+					"_id":             1,
+					"name":            1,
+					"first_lastname":  1,
+					"second_lastname": 1,
+				},
+			}}},
+		},
+	}}
+	sort := bson.D{{
+		Key: "$sort",
+		Value: bson.M{
+			"name": 1,
+		},
+	}}
+	cursor, err := workGroupsModel.Aggreagate(mongo.Pipeline{
+		match,
+		lookup,
+		sort,
+	})
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if err := cursor.All(db.Ctx, &groups); err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return groups, nil
+}
+
+func (w *WorkSerice) UploadGroup(
+	group *forms.WorkGroupForm,
+	idWork,
+	idUser string,
+) (interface{}, *res.ErrorRes) {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjUser, err := primitive.ObjectIDFromHex(idUser)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get work
+	work, errRes := w.getGroupWork(idObjWork)
+	if errRes != nil {
+		return nil, errRes
+	}
+	if work.IsRevised {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("este trabajo ya está evaluado"),
+			StatusCode: http.StatusForbidden,
+		}
+	}
+	members, errRes := w.getFormMembers(work, group)
+	if errRes != nil {
+		return nil, errRes
+	}
+	if errRes := w.checkNewMembers(work, members, primitive.NilObjectID); errRes != nil {
+		return nil, errRes
+	}
+	// Insert
+	inserted, err := workGroupsModel.NewDocument(models.NewModelWorkGroup(
+		idObjWork,
+		idObjUser,
+		group.Name,
+		members,
+	))
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return inserted.InsertedID, nil
+}
+
+func (w *WorkSerice) UpdateGroup(group *forms.WorkGroupForm, idWork, idGroup string) *res.ErrorRes {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjGroup, err := primitive.ObjectIDFromHex(idGroup)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get work and group
+	work, errRes := w.getGroupWork(idObjWork)
+	if errRes != nil {
+		return errRes
+	}
+	groupData, errRes := w.getGroupFromWork(idObjWork, idObjGroup)
+	if errRes != nil {
+		return errRes
+	}
+	if errRes := w.checkGroupEditable(work, groupData); errRes != nil {
+		return errRes
+	}
+	members, errRes := w.getFormMembers(work, group)
+	if errRes != nil {
+		return errRes
+	}
+	if errRes := w.checkNewMembers(work, members, idObjGroup); errRes != nil {
+		return errRes
+	}
+	// Adjustments of the removed members are removed too
+	adjustments := []models.GroupAdjustment{}
+	for _, adjustment := range groupData.Adjustments {
+		if funct.Some(members, func(member primitive.ObjectID) bool {
+			return member == adjustment.Student
+		}) {
+			adjustments = append(adjustments, adjustment)
+		}
+	}
+	// Update
+	_, err = workGroupsModel.Use().UpdateByID(db.Ctx, idObjGroup, bson.D{{
+		Key: "$set",
+		Value: bson.M{
+			"name":        group.Name,
+			"members":     members,
+			"adjustments": adjustments,
+			"date_update": primitive.NewDateTimeFromTime(time.Now()),
+		},
+	}})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return nil
+}
+
+func (w *WorkSerice) DeleteGroup(idWork, idGroup string) *res.ErrorRes {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjGroup, err := primitive.ObjectIDFromHex(idGroup)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get work and group
+	work, errRes := w.getGroupWork(idObjWork)
+	if errRes != nil {
+		return errRes
+	}
+	group, errRes := w.getGroupFromWork(idObjWork, idObjGroup)
+	if errRes != nil {
+		return errRes
+	}
+	if errRes := w.checkGroupEditable(work, group); errRes != nil {
+		return errRes
+	}
+	// Delete
+	_, err = workGroupsModel.Use().DeleteOne(db.Ctx, bson.D{{
+		Key:   "_id",
+		Value: idObjGroup,
+	}})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return nil
+}
+
+// The adjustments are applied when the work is graded and when it's
+// evaluated again, so they can't change once the work is graded
+func (w *WorkSerice) AdjustGroupMember(
+	adjustment *forms.GroupAdjustmentForm,
+	idWork,
+	idGroup,
+	idStudent string,
+) *res.ErrorRes {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjGroup, err := primitive.ObjectIDFromHex(idGroup)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjStudent, err := primitive.ObjectIDFromHex(idStudent)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get work and group
+	work, errRes := w.getGroupWork(idObjWork)
+	if errRes != nil {
+		return errRes
+	}
+	if work.IsRevised {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("los ajustes solo se pueden modificar antes de calificar el trabajo"),
+			StatusCode: http.StatusForbidden,
+		}
+	}
+	group, errRes := w.getGroupFromWork(idObjWork, idObjGroup)
+	if errRes != nil {
+		return errRes
+	}
+	if !group.IsMember(idObjStudent) {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("el estudiante no pertenece a este grupo"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	scale, err := getGradingScale(work.Module)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	maxAdjustment := scale.Max() - scale.Min()
+	if *adjustment.Adjustment < -maxAdjustment || *adjustment.Adjustment > maxAdjustment {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("el ajuste debe estar entre %v y %v", -maxAdjustment, maxAdjustment),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Replace the adjustment of the student
+	adjustments := []models.GroupAdjustment{}
+	for _, adj := range group.Adjustments {
+		if adj.Student != idObjStudent {
+			adjustments = append(adjustments, adj)
+		}
+	}
+	if *adjustment.Adjustment != 0 {
+		adjustments = append(adjustments, models.GroupAdjustment{
+			Student:    idObjStudent,
+			Adjustment: *adjustment.Adjustment,
+			Comment:    adjustment.Comment,
+		})
+	}
+	_, err = workGroupsModel.Use().UpdateByID(db.Ctx, idObjGroup, bson.D{{
+		Key: "$set",
+		Value: bson.M{
+			"adjustments": adjustments,
+			"date_update": primitive.NewDateTimeFromTime(time.Now()),
+		},
+	}})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return nil
+}
+
+// Students form the groups of self mode works until the date limit
+func (w *WorkSerice) getSelfGroupWork(idObjWork primitive.ObjectID) (*models.Work, *res.ErrorRes) {
+	work, errRes := w.getGroupWork(idObjWork)
+	if errRes != nil {
+		return nil, errRes
+	}
+	if errRes := w.checkPublishedWork(work); errRes != nil {
+		return nil, errRes
+	}
+	if work.Groups.Mode != "self" {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("los grupos de este trabajo los forma el profesor"),
+			StatusCode: http.StatusUnauthorized,
+		}
+	}
+	if time.Now().After(work.DateLimit.Time()) || work.IsRevised {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("ya no se pueden modificar los grupos de este trabajo"),
+			StatusCode: http.StatusUnauthorized,
+		}
+	}
+	return work, nil
+}
+
+func (w *WorkSerice) CreateGroup(
+	group *forms.SelfWorkGroupForm,
+	idWork,
+	idStudent string,
+) (interface{}, *res.ErrorRes) {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjStudent, err := primitive.ObjectIDFromHex(idStudent)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get work
+	work, errRes := w.getSelfGroupWork(idObjWork)
+	if errRes != nil {
+		return nil, errRes
+	}
+	members := []primitive.ObjectID{idObjStudent}
+	if errRes := w.checkNewMembers(work, members, primitive.NilObjectID); errRes != nil {
+		return nil, errRes
+	}
+	// Insert
+	inserted, err := workGroupsModel.NewDocument(models.NewModelWorkGroup(
+		idObjWork,
+		idObjStudent,
+		group.Name,
+		members,
+	))
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return inserted.InsertedID, nil
+}
+
+func (w *WorkSerice) JoinGroup(idWork, idGroup, idStudent string) *res.ErrorRes {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjGroup, err := primitive.ObjectIDFromHex(idGroup)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjStudent, err := primitive.ObjectIDFromHex(idStudent)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get work and group
+	work, errRes := w.getSelfGroupWork(idObjWork)
+	if errRes != nil {
+		return errRes
+	}
+	group, errRes := w.getGroupFromWork(idObjWork, idObjGroup)
+	if errRes != nil {
+		return errRes
+	}
+	if group.IsMember(idObjStudent) {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("ya perteneces a este grupo"),
+			StatusCode: http.StatusConflict,
+		}
+	}
+	if errRes := w.checkGroupEditable(work, group); errRes != nil {
+		return errRes
+	}
+	if errRes := w.checkNewMembers(work, []primitive.ObjectID{idObjStudent}, idObjGroup); errRes != nil {
+		return errRes
+	}
+	// Join, only if the group has room
+	result, err := workGroupsModel.Use().UpdateOne(
+		db.Ctx,
+		bson.D{
+			{
+				Key:   "_id",
+				Value: idObjGroup,
+			},
+			{
+				Key: fmt.Sprintf("members.%d", work.Groups.MaxSize-1),
+				Value: bson.M{
+					"$exists": false,
+				},
+			},
+		},
+		bson.D{
+			{
+				Key: "$push",
+				Value: bson.M{
+					"members": idObjStudent,
+				},
+			},
+			{
+				Key: "$set",
+				Value: bson.M{
+					"date_update": primitive.NewDateTimeFromTime(time.Now()),
+				},
+			},
+		},
+	)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if result.MatchedCount == 0 {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("el grupo ya tiene el máximo de %d integrantes", work.Groups.MaxSize),
+			StatusCode: http.StatusConflict,
+		}
+	}
+	return nil
+}
+
+func (w *WorkSerice) LeaveGroup(idWork, idStudent string) *res.ErrorRes {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjStudent, err := primitive.ObjectIDFromHex(idStudent)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get work and group
+	work, errRes := w.getSelfGroupWork(idObjWork)
+	if errRes != nil {
+		return errRes
+	}
+	group, err := w.getStudentGroup(idObjWork, idObjStudent)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if group == nil {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("no perteneces a un grupo en este trabajo"),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	if errRes := w.checkGroupEditable(work, group); errRes != nil {
+		return errRes
+	}
+	// The group is deleted with its last member
+	if len(group.Members) == 1 {
+		_, err = workGroupsModel.Use().DeleteOne(db.Ctx, bson.D{{
+			Key:   "_id",
+			Value: group.ID,
+		}})
+	} else {
+		_, err = workGroupsModel.Use().UpdateByID(db.Ctx, group.ID, bson.D{
+			{
+				Key: "$pull",
+				Value: bson.M{
+					"members": idObjStudent,
+					"adjustments": bson.M{
+						"student": idObjStudent,
+					},
+				},
+			},
+			{
+				Key: "$set",
+				Value: bson.M{
+					"date_update": primitive.NewDateTimeFromTime(time.Now()),
+				},
+			},
+		})
+	}
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,469 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/funct"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"github.com/CPU-commits/Intranet_BClassroom/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Upper and lower groups of the discrimination index
+const DISCRIMINATION_GROUP = 0.27
+
+func (w *WorkSerice) isQuestionRemoved(work *models.Work, idQuestion primitive.ObjectID) bool {
+	return funct.Some(work.RemovedQuestions, func(idRemoved primitive.ObjectID) bool {
+		return idRemoved == idQuestion
+	})
+}
+
+// Questions that give points to the grade of the work, without the
+// questions removed from the grade
+func (w *WorkSerice) getQuestionsWPoints(work *models.Work, questions []models.ItemQuestion) []models.ItemQuestion {
+	var questionsWPoints []models.ItemQuestion
+	for _, question := range questions {
+		if question.Type != "alternatives" && !w.isQuestionRemoved(work, question.ID) {
+			questionsWPoints = append(questionsWPoints, question)
+		}
+	}
+	return questionsWPoints
+}
+
+// Revised form work
+func (w *WorkSerice) getRevisedFormWork(idObjWork primitive.ObjectID) (*models.Work, *res.ErrorRes) {
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if work.Type != "form" {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("el análisis de preguntas es solo para trabajos de formulario"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	if !work.IsRevised {
+		return nil, &res.ErrorRes{
+			Err:        fmt.Errorf("este trabajo todavía no está evaluado"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	return work, nil
+}
+
+type studentItems struct {
+	access  models.FormAccess
+	answers map[primitive.ObjectID]*models.Answer
+	points  map[primitive.ObjectID]int
+	// Ratio of points in the questions of the grade
+	score float64
+}
+
+// Answers and evaluated points of the students that accessed the work
+func (w *WorkSerice) getStudentsItems(work *models.Work) ([]*studentItems, error) {
+	filter := bson.D{{
+		Key:   "work",
+		Value: work.ID,
+	}}
+	var accesses []models.FormAccess
+	cursor, err := formAccessModel.GetAll(filter, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &accesses); err != nil {
+		return nil, err
+	}
+	students := make(map[primitive.ObjectID]*studentItems)
+	var studentsItems []*studentItems
+	for _, access := range accesses {
+		student := &studentItems{
+			access:  access,
+			answers: make(map[primitive.ObjectID]*models.Answer),
+			points:  make(map[primitive.ObjectID]int),
+		}
+		students[access.Student] = student
+		studentsItems = append(studentsItems, student)
+	}
+	// Answers
+	var answers []models.Answer
+	cursor, err = answerModel.GetAll(filter, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &answers); err != nil {
+		return nil, err
+	}
+	for i := range answers {
+		if student, exists := students[answers[i].Student]; exists {
+			student.answers[answers[i].Question] = &answers[i]
+		}
+	}
+	// Evaluated answers
+	var evaluatedAnswers []models.EvaluatedAnswers
+	cursor, err = evaluatedAnswersModel.GetAll(filter, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &evaluatedAnswers); err != nil {
+		return nil, err
+	}
+	for _, evaluated := range evaluatedAnswers {
+		if student, exists := students[evaluated.Student]; exists {
+			student.points[evaluated.Question] = evaluated.Points
+		}
+	}
+	return studentsItems, nil
+}
+
+// Ratio of the points of the question obtained by the student, false if
+// the question has no points or it is written and not evaluated
+func (w *WorkSerice) getQuestionCredit(question *models.ItemQuestion, student *studentItems) (float64, bool) {
+	if question.Points <= 0 {
+		return 0, false
+	}
+	if question.Type == "written" {
+		points, exists := student.points[question.ID]
+		if !exists {
+			return 0, false
+		}
+		return float64(points) / float64(question.Points), true
+	}
+	answer, exists := student.answers[question.ID]
+	if !exists {
+		return 0, true
+	}
+	return float64(w.getAnswerPoints(question, answer)) / float64(question.Points), true
+}
+
+func (w *WorkSerice) getQuestionAnalysis(
+	work *models.Work,
+	question *models.ItemQuestion,
+	students []*studentItems,
+	upper,
+	lower []*studentItems,
+) QuestionAnalysis {
+	analysis := QuestionAnalysis{
+		ID:       question.ID.Hex(),
+		Question: question.Question,
+		Type:     question.Type,
+		Points:   question.Points,
+		Removed:  w.isQuestionRemoved(work, question.ID),
+	}
+	hasAlternatives := question.Type == "alternatives_correct" || question.Type == "true_false"
+	if hasAlternatives {
+		for i, answer := range question.Answers {
+			analysis.Alternatives = append(analysis.Alternatives, AlternativeAnalysis{
+				Index:   i,
+				Answer:  answer,
+				Correct: i == question.Correct,
+			})
+		}
+	}
+	received := func(student *studentItems) bool {
+		return student.access.Permutation == nil ||
+			w.hasQuestionPermutation(student.access.Permutation, question.ID)
+	}
+	// Percentage correct
+	var credits float64
+	for _, student := range students {
+		if !received(student) {
+			continue
+		}
+		credit, ok := w.getQuestionCredit(question, student)
+		if !ok {
+			continue
+		}
+		analysis.Students++
+		credits += credit
+		answer, answered := student.answers[question.ID]
+		if !answered && question.Type != "written" {
+			analysis.Omitted++
+		} else if hasAlternatives && answered &&
+			answer.Answer >= 0 && answer.Answer < len(analysis.Alternatives) {
+			analysis.Alternatives[answer.Answer].Count++
+		}
+	}
+	if analysis.Students == 0 {
+		return analysis
+	}
+	analysis.Correct = credits / float64(analysis.Students) * 100
+	analysis.AllMissed = credits == 0
+	for i := range analysis.Alternatives {
+		analysis.Alternatives[i].Percentage = float64(analysis.Alternatives[i].Count) /
+			float64(analysis.Students) * 100
+	}
+	// Discrimination
+	groupCredit := func(group []*studentItems) (float64, bool) {
+		var sum float64
+		var count int
+		for _, student := range group {
+			if !received(student) {
+				continue
+			}
+			if credit, ok := w.getQuestionCredit(question, student); ok {
+				sum += credit
+				count++
+			}
+		}
+		if count == 0 {
+			return 0, false
+		}
+		return sum / float64(count), true
+	}
+	upperCredit, okUpper := groupCredit(upper)
+	lowerCredit, okLower := groupCredit(lower)
+	if okUpper && okLower && len(upper) > 0 {
+		discrimination := upperCredit - lowerCredit
+		analysis.Discrimination = &discrimination
+	}
+	return analysis
+}
+
+func (w *WorkSerice) GetItemAnalysis(idWork string) (*ItemAnalysisRes, *res.ErrorRes) {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	work, errRes := w.getRevisedFormWork(idObjWork)
+	if errRes != nil {
+		return nil, errRes
+	}
+	questions, err := w.getQuestionsFromIdForm(work.Form)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	students, err := w.getStudentsItems(work)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	// Score of the students to sort them
+	for _, student := range students {
+		var points, maxPoints float64
+		for _, question := range w.getQuestionsStudent(w.getQuestionsWPoints(work, questions), &student.access) {
+			credit, _ := w.getQuestionCredit(&question, student)
+			points += credit * float64(question.Points)
+			maxPoints += float64(question.Points)
+		}
+		if maxPoints > 0 {
+			student.score = points / maxPoints
+		}
+	}
+	sort.SliceStable(students, func(i, j int) bool {
+		return students[i].score > students[j].score
+	})
+	group := int(math.Round(float64(len(students)) * DISCRIMINATION_GROUP))
+	if group == 0 && len(students) > 1 {
+		group = 1
+	}
+	var upper, lower []*studentItems
+	if group > 0 {
+		upper = students[:group]
+		lower = students[len(students)-group:]
+	}
+	// Questions
+	analysis := &ItemAnalysisRes{
+		Students:  len(students),
+		Group:     group,
+		Questions: []QuestionAnalysis{},
+	}
+	for i := range questions {
+		if questions[i].Type == "alternatives" {
+			continue
+		}
+		analysis.Questions = append(
+			analysis.Questions,
+			w.getQuestionAnalysis(work, &questions[i], students, upper, lower),
+		)
+	}
+	return analysis, nil
+}
+
+// Remove the question from the grade of the work and grade again
+// all the students
+func (w *WorkSerice) RemoveQuestion(idWork, idQuestion, idUser string) *res.ErrorRes {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjQuestion, err := primitive.ObjectIDFromHex(idQuestion)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjUser, err := primitive.ObjectIDFromHex(idUser)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	work, errRes := w.getRevisedFormWork(idObjWork)
+	if errRes != nil {
+		return errRes
+	}
+	// Question
+	questions, err := w.getQuestionsFromIdForm(work.Form)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	index := funct.Index(questions, func(question models.ItemQuestion) bool {
+		return question.ID == idObjQuestion
+	})
+	if index == -1 {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("la pregunta no pertenece al trabajo indicado"),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	if questions[index].Type == "alternatives" {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("esta pregunta no tiene puntaje"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	if w.isQuestionRemoved(work, idObjQuestion) {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("esta pregunta ya fue eliminada de la calificación"),
+			StatusCode: http.StatusConflict,
+		}
+	}
+	work.RemovedQuestions = append(work.RemovedQuestions, idObjQuestion)
+	if len(w.getQuestionsWPoints(work, questions)) == 0 {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("no se pueden eliminar todas las preguntas con puntaje"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// A student with a draw of questions could be left without points
+	var accesses []models.FormAccess
+	cursor, err := formAccessModel.GetAll(bson.D{{
+		Key:   "work",
+		Value: idObjWork,
+	}}, nil)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if err := cursor.All(db.Ctx, &accesses); err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	for i := range accesses {
+		if len(w.getQuestionsWPoints(work, w.getQuestionsStudent(questions, &accesses[i]))) == 0 {
+			return &res.ErrorRes{
+				Err:        fmt.Errorf("un estudiante quedaría sin preguntas con puntaje, no se puede eliminar la pregunta"),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+	}
+	// The archived attempts keep the points of the removed question
+	if err := w.rescoreArchivedAttempts(work, questions); err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	// Grade again, the question is removed once all the students are graded
+	// so a failed grade can be done again
+	students, err := w.getStudentsFromIdModule(work.Module.Hex())
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	module, err := moduleService.GetModuleFromID(work.Module.Hex())
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	notify := make([]bool, len(students))
+	errRes = utils.Concurrency(10, len(students), func(index int, setError func(errRes *res.ErrorRes)) {
+		idObjStudent, err := primitive.ObjectIDFromHex(students[index].User.ID)
+		if err != nil {
+			setError(&res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusBadRequest,
+			})
+			return
+		}
+		if err := w.updateGrade(work, idObjStudent, idObjUser, 0); err != nil {
+			setError(&res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			})
+			return
+		}
+		released, err := w.isStudentGradeReleased(work, idObjStudent)
+		if err != nil {
+			setError(&res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			})
+			return
+		}
+		notify[index] = released
+	})
+	if errRes != nil {
+		return errRes
+	}
+	_, err = workModel.Use().UpdateByID(db.Ctx, idObjWork, bson.D{{
+		Key: "$addToSet",
+		Value: bson.M{
+			"removed_questions": idObjQuestion,
+		},
+	}})
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	// Notify
+	for i, student := range students {
+		if !notify[i] {
+			continue
+		}
+		nats.PublishEncode("notify/classroom", res.NotifyClassroom{
+			Title:  fmt.Sprintf("Trabajo re-evaluado %v", work.Title),
+			Link:   w.getWorkLink(work),
+			Where:  module.Subject.Hex(),
+			Room:   module.Section.Hex(),
+			Type:   res.GRADE,
+			IDUser: student.User.ID,
+		})
+	}
+	return nil
+}
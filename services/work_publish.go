@@ -0,0 +1,281 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	natsPackage "github.com/nats-io/nats.go"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Draft works are hidden to students and attorneys until they are published,
+// a scheduled work is visible from its publish date even if the scheduler
+// has not published it yet
+func publishedWorkFilter() bson.M {
+	return bson.M{
+		"$or": bson.A{
+			bson.M{
+				"draft": bson.M{
+					"$ne": true,
+				},
+			},
+			bson.M{
+				"date_publish": bson.M{
+					"$lte": primitive.NewDateTimeFromTime(time.Now()),
+				},
+			},
+		},
+	}
+}
+
+func isWorkPublished(draft bool, datePublish primitive.DateTime) bool {
+	if !draft {
+		return true
+	}
+	return datePublish != 0 && !datePublish.Time().After(time.Now())
+}
+
+func canSeeDraftWorks(claims *Claims) bool {
+	return claims.UserType == models.TEACHER ||
+		claims.UserType == models.DIRECTOR ||
+		claims.UserType == models.DIRECTIVE
+}
+
+func parsePublishDate(date string, dateLimit time.Time) (*time.Time, error) {
+	if date == "" {
+		return nil, nil
+	}
+	datePublish, err := time.Parse("2006-01-02 15:04", date)
+	if err != nil {
+		return nil, err
+	}
+	if !datePublish.Before(dateLimit) {
+		return nil, fmt.Errorf("la fecha de publicación debe ser anterior a la fecha límite")
+	}
+	return &datePublish, nil
+}
+
+// A publish date already passed publishes the work now
+func setWorkDraft(work *models.Work, draft bool, datePublish *time.Time) {
+	if datePublish == nil {
+		work.Draft = draft
+		return
+	}
+	work.Draft = datePublish.After(time.Now())
+	if work.Draft {
+		work.DatePublish = primitive.NewDateTimeFromTime(*datePublish)
+	}
+}
+
+func (w *WorkSerice) scheduleWork(work *models.Work) error {
+	if !work.Draft || work.DatePublish == 0 {
+		return nil
+	}
+	return nats.PublishEncode("schedule_publish_work", &PublishWork{
+		Work: work.ID.Hex(),
+		Diff: time.Until(work.DatePublish.Time()).Hours(),
+	})
+}
+
+// Unset the draft of the work, with due only if its publish date has passed.
+// Returns true if the work was published now, so it is notified only once
+func (w *WorkSerice) publishDraftWork(idObjWork primitive.ObjectID, due bool) (bool, error) {
+	filter := bson.D{
+		{
+			Key:   "_id",
+			Value: idObjWork,
+		},
+		{
+			Key:   "draft",
+			Value: true,
+		},
+	}
+	if due {
+		filter = append(filter, bson.E{
+			Key: "date_publish",
+			Value: bson.M{
+				"$lte": primitive.NewDateTimeFromTime(time.Now()),
+			},
+		})
+	}
+	result, err := workModel.Use().UpdateOne(db.Ctx, filter, bson.D{{
+		Key: "$unset",
+		Value: bson.M{
+			"draft":        "",
+			"date_publish": "",
+		},
+	}})
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
+
+// Index and notify the work once it is published, as an uploaded work
+func (w *WorkSerice) notifyPublishedWork(idObjWork primitive.ObjectID) error {
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return err
+	}
+	workAuthor, errRes := workRepository.GetWork(idObjWork)
+	if errRes != nil {
+		return errRes.Err
+	}
+	module, err := moduleService.GetModuleFromID(work.Module.Hex())
+	if err != nil {
+		return err
+	}
+	if errRes := w.publishWork(work, module, workAuthor.Author.Name); errRes != nil {
+		return errRes.Err
+	}
+	return nil
+}
+
+// Publish and notify the draft if its publish date has passed and the
+// scheduler has not published it yet
+func (w *WorkSerice) publishDueWork(idObjWork primitive.ObjectID) error {
+	published, err := w.publishDraftWork(idObjWork, true)
+	if err != nil || !published {
+		return err
+	}
+	return w.notifyPublishedWork(idObjWork)
+}
+
+// Due drafts of the filter
+func (w *WorkSerice) publishDueWorks(filter bson.D) error {
+	filter = append(filter, bson.E{
+		Key:   "draft",
+		Value: true,
+	}, bson.E{
+		Key: "date_publish",
+		Value: bson.M{
+			"$lte": primitive.NewDateTimeFromTime(time.Now()),
+		},
+	})
+	idsWorks, err := workModel.Use().Distinct(db.Ctx, "_id", filter)
+	if err != nil {
+		return err
+	}
+	for _, idWork := range idsWorks {
+		if idObjWork, ok := idWork.(primitive.ObjectID); ok {
+			if err := w.publishDueWork(idObjWork); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Students only reach published works, called once the work is read
+func (w *WorkSerice) checkPublishedWork(work *models.Work) *res.ErrorRes {
+	if !work.Draft {
+		return nil
+	}
+	if !isWorkPublished(work.Draft, work.DatePublish) {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("no existe el trabajo indicado"),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	if err := w.publishDueWork(work.ID); err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	work.Draft = false
+	work.DatePublish = 0
+	return nil
+}
+
+func (w *WorkSerice) PublishWork(form *forms.PublishWorkForm, idWork string) *res.ErrorRes {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Get work
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if !work.Draft {
+		return &res.ErrorRes{
+			Err:        fmt.Errorf("este trabajo ya está publicado"),
+			StatusCode: http.StatusConflict,
+		}
+	}
+	datePublish, err := parsePublishDate(form.Date, work.DateLimit.Time())
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	// Schedule
+	setWorkDraft(work, false, datePublish)
+	if work.Draft {
+		_, err := workModel.Use().UpdateByID(db.Ctx, idObjWork, bson.D{{
+			Key: "$set",
+			Value: bson.M{
+				"date_publish": work.DatePublish,
+			},
+		}})
+		if err != nil {
+			return &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		if err := w.scheduleWork(work); err != nil {
+			return &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		return nil
+	}
+	// Publish
+	published, err := w.publishDraftWork(idObjWork, false)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if published {
+		if err := w.notifyPublishedWork(idObjWork); err != nil {
+			return &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+	}
+	return nil
+}
+
+// Message of the scheduler once the publish date of the work is reached
+func publishScheduledWorks() {
+	nats.Queue("publish_work", func(m *natsPackage.Msg) {
+		var payload PublishWork
+		if err := nats.ExtractPayload(m.Data, &payload); err != nil {
+			return
+		}
+		idObjWork, err := primitive.ObjectIDFromHex(payload.Work)
+		if err != nil {
+			return
+		}
+		workService.publishDueWork(idObjWork)
+	})
+}
@@ -0,0 +1,494 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/CPU-commits/Intranet_BClassroom/db"
+	"github.com/CPU-commits/Intranet_BClassroom/forms"
+	"github.com/CPU-commits/Intranet_BClassroom/models"
+	"github.com/CPU-commits/Intranet_BClassroom/res"
+	"github.com/CPU-commits/Intranet_BClassroom/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// Words of each shingle
+	SHINGLE_SIZE = 5
+	// Hash functions of the MinHash signatures
+	MINHASH_SIZE = 128
+	// Default min similarity to flag a pair of students
+	SIMILARITY_THRESHOLD = 0.5
+	// A running analysis older than this can be started again
+	SIMILARITY_TIMEOUT = 30 * time.Minute
+	// Tries to mark the report as failed when it can't be saved
+	SIMILARITY_WRITE_RETRIES = 3
+)
+
+// Mersenne prime of the universal hashing (a*x + b) mod p
+const minHashPrime = 1<<61 - 1
+
+type minHashFunc struct {
+	a uint64
+	b uint64
+}
+
+// Fixed seed, so the signatures are the same between analysis
+var minHashFuncs = func() []minHashFunc {
+	random := rand.New(rand.NewSource(1))
+	funcs := make([]minHashFunc, MINHASH_SIZE)
+	for i := range funcs {
+		funcs[i] = minHashFunc{
+			a: uint64(random.Int63n(minHashPrime-1)) + 1,
+			b: uint64(random.Int63n(minHashPrime)),
+		}
+	}
+	return funcs
+}()
+
+var accentsReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "ä", "a", "â", "a",
+	"é", "e", "è", "e", "ë", "e", "ê", "e",
+	"í", "i", "ì", "i", "ï", "i", "î", "i",
+	"ó", "o", "ò", "o", "ö", "o", "ô", "o",
+	"ú", "u", "ù", "u", "ü", "u", "û", "u",
+	"ñ", "n", "ç", "c",
+)
+
+// Words of a text without case, accents and punctuation
+func getTextTokens(text string) []string {
+	text = accentsReplacer.Replace(strings.ToLower(text))
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// Hashes of the word n-grams of a text, texts with less words than a
+// shingle have not shingles
+func getShingles(text string) map[uint64]bool {
+	tokens := getTextTokens(text)
+	shingles := make(map[uint64]bool)
+	for i := 0; i+SHINGLE_SIZE <= len(tokens); i++ {
+		hash := fnv.New64a()
+		hash.Write([]byte(strings.Join(tokens[i:i+SHINGLE_SIZE], " ")))
+		shingles[hash.Sum64()] = true
+	}
+	return shingles
+}
+
+func (m minHashFunc) hash(x uint64) uint64 {
+	hi, lo := bits.Mul64(m.a, x%minHashPrime)
+	_, rem := bits.Div64(hi, lo, minHashPrime)
+	return (rem + m.b) % minHashPrime
+}
+
+func getMinHashSignature(shingles map[uint64]bool) []uint64 {
+	signature := make([]uint64, MINHASH_SIZE)
+	for i := range signature {
+		signature[i] = math.MaxUint64
+	}
+	for shingle := range shingles {
+		for i, minHash := range minHashFuncs {
+			if hash := minHash.hash(shingle); hash < signature[i] {
+				signature[i] = hash
+			}
+		}
+	}
+	return signature
+}
+
+// Estimated Jaccard similarity of the shingles of two signatures
+func getSignaturesSimilarity(a, b []uint64) float64 {
+	var equals int
+	for i := range a {
+		if a[i] == b[i] {
+			equals++
+		}
+	}
+	return float64(equals) / float64(len(a))
+}
+
+type similarityDocument struct {
+	student   primitive.ObjectID
+	signature []uint64
+}
+
+func newSimilarityDocument(student primitive.ObjectID, text string) *similarityDocument {
+	shingles := getShingles(text)
+	if len(shingles) == 0 {
+		return nil
+	}
+	return &similarityDocument{
+		student:   student,
+		signature: getMinHashSignature(shingles),
+	}
+}
+
+// Pairs of documents of a group with the similarity over the threshold
+func getSimilarityPairs(
+	documents []*similarityDocument,
+	threshold float64,
+	source string,
+	question primitive.ObjectID,
+) []models.SimilarityPair {
+	var pairs []models.SimilarityPair
+	for i := 0; i < len(documents); i++ {
+		for j := i + 1; j < len(documents); j++ {
+			similarity := getSignaturesSimilarity(documents[i].signature, documents[j].signature)
+			if similarity < threshold {
+				continue
+			}
+			pairs = append(pairs, models.SimilarityPair{
+				StudentA:   documents[i].student,
+				StudentB:   documents[j].student,
+				Source:     source,
+				Question:   question,
+				Similarity: math.Round(similarity*100) / 100,
+			})
+		}
+	}
+	return pairs
+}
+
+// Documents of the written answers, grouped by question
+func (w *WorkSerice) getWrittenDocuments(work *models.Work) (map[primitive.ObjectID][]*similarityDocument, error) {
+	questions, err := w.getQuestionsFromIdForm(work.Form)
+	if err != nil {
+		return nil, err
+	}
+	var idQuestions []primitive.ObjectID
+	for _, question := range questions {
+		if question.Type == "written" {
+			idQuestions = append(idQuestions, question.ID)
+		}
+	}
+	documents := make(map[primitive.ObjectID][]*similarityDocument)
+	if len(idQuestions) == 0 {
+		return documents, nil
+	}
+
+	var answers []models.Answer
+	cursor, err := answerModel.GetAll(bson.D{
+		{
+			Key:   "work",
+			Value: work.ID,
+		},
+		{
+			Key: "question",
+			Value: bson.M{
+				"$in": idQuestions,
+			},
+		},
+	}, &options.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(db.Ctx, &answers); err != nil {
+		return nil, err
+	}
+	for _, answer := range answers {
+		document := newSimilarityDocument(answer.Student, answer.Response)
+		if document != nil {
+			documents[answer.Question] = append(documents[answer.Question], document)
+		}
+	}
+	return documents, nil
+}
+
+// Documents of the uploaded files, the text of all the files of a
+// student is a single document
+func (w *WorkSerice) getFilesDocuments(
+	work *models.Work,
+) ([]*similarityDocument, []models.SimilarityOmitted, error) {
+	var fUCs []models.FileUploadedClassroomWLookup
+	cursor, err := fileUCModel.Aggreagate(mongo.Pipeline{
+		bson.D{{
+			Key: "$match",
+			Value: bson.M{
+				"work": work.ID,
+			},
+		}},
+		bson.D{{
+			Key: "$lookup",
+			Value: bson.M{
+				"from":         models.FILES_COLLECTION,
+				"localField":   "files_uploaded",
+				"foreignField": "_id",
+				"as":           "files_uploaded",
+			},
+		}},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := cursor.All(db.Ctx, &fUCs); err != nil {
+		return nil, nil, err
+	}
+
+	documents := make([]*similarityDocument, len(fUCs))
+	var omitted []models.SimilarityOmitted
+	var lock sync.Mutex
+
+	errRes := utils.Concurrency(5, len(fUCs), func(index int, setError func(errRes *res.ErrorRes)) {
+		fUC := fUCs[index]
+
+		var texts []string
+		for _, file := range fUC.FilesUploaded {
+			text, err := getFileText(file)
+			if err != nil {
+				reason := err.Error()
+				if !errors.Is(err, errFileUnsupported) &&
+					!errors.Is(err, errFileTooLarge) &&
+					!errors.Is(err, errFileWithoutText) {
+					reason = "no se pudo descargar el archivo"
+				}
+				lock.Lock()
+				omitted = append(omitted, models.SimilarityOmitted{
+					Student: fUC.Student,
+					File:    file.ID,
+					Reason:  reason,
+				})
+				lock.Unlock()
+				continue
+			}
+			texts = append(texts, text)
+		}
+		documents[index] = newSimilarityDocument(fUC.Student, strings.Join(texts, "\n"))
+	})
+	if errRes != nil {
+		return nil, nil, errRes.Err
+	}
+	var withText []*similarityDocument
+	for _, document := range documents {
+		if document != nil {
+			withText = append(withText, document)
+		}
+	}
+	return withText, omitted, nil
+}
+
+func (w *WorkSerice) runSimilarity(work *models.Work, report *models.WorkSimilarity) error {
+	if work.Type == "form" {
+		questionsDocuments, err := w.getWrittenDocuments(work)
+		if err != nil {
+			return err
+		}
+		for idQuestion, documents := range questionsDocuments {
+			report.Documents += len(documents)
+			report.Pairs = append(report.Pairs, getSimilarityPairs(
+				documents,
+				report.Threshold,
+				models.SIMILARITY_WRITTEN,
+				idQuestion,
+			)...)
+		}
+		return nil
+	}
+	documents, omitted, err := w.getFilesDocuments(work)
+	if err != nil {
+		return err
+	}
+	report.Documents = len(documents)
+	report.Omitted = omitted
+	report.Pairs = getSimilarityPairs(
+		documents,
+		report.Threshold,
+		models.SIMILARITY_FILES,
+		primitive.NilObjectID,
+	)
+	return nil
+}
+
+func (w *WorkSerice) getSimilarity(idObjWork primitive.ObjectID) (*models.WorkSimilarity, error) {
+	var report *models.WorkSimilarity
+	cursor := workSimilaritiesModel.GetOne(bson.D{{
+		Key:   "work",
+		Value: idObjWork,
+	}})
+	if err := cursor.Decode(&report); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return report, nil
+}
+
+func (w *WorkSerice) finishSimilarity(work *models.Work, report models.WorkSimilarity) {
+	err := w.runSimilarity(work, &report)
+	if err != nil {
+		report.Status = models.SIMILARITY_FAILED
+		report.Error = err.Error()
+		report.Pairs = []models.SimilarityPair{}
+	} else {
+		report.Status = models.SIMILARITY_COMPLETED
+	}
+	if report.Pairs == nil {
+		report.Pairs = []models.SimilarityPair{}
+	}
+	report.DateFinish = primitive.NewDateTimeFromTime(time.Now())
+	_, err = workSimilaritiesModel.Use().ReplaceOne(db.Ctx, bson.D{{
+		Key:   "_id",
+		Value: report.ID,
+	}}, report)
+	if err == nil {
+		return
+	}
+	// The report can't stay running until the timeout, it's saved as
+	// failed without its pairs
+	update := bson.D{{
+		Key: "$set",
+		Value: bson.M{
+			"status":      models.SIMILARITY_FAILED,
+			"error":       fmt.Sprintf("no se pudo guardar el análisis: %v", err),
+			"pairs":       []models.SimilarityPair{},
+			"date_finish": report.DateFinish,
+		},
+	}}
+	for i := 0; i < SIMILARITY_WRITE_RETRIES; i++ {
+		_, err = workSimilaritiesModel.Use().UpdateByID(db.Ctx, report.ID, update)
+		if err == nil {
+			return
+		}
+	}
+}
+
+// Start the similarity analysis of the written answers or the uploaded
+// files of a work. The analysis runs in background and replaces the
+// last report of the work
+func (w *WorkSerice) AnalyzeSimilarity(
+	form *forms.WorkSimilarityForm,
+	idWork,
+	idUser string,
+) *res.ErrorRes {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	idObjUser, err := primitive.ObjectIDFromHex(idUser)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	work, err := workRepository.GetWorkFromId(idObjWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if work.Type != "form" && work.Type != "files" {
+		return &res.ErrorRes{
+			Err:        errors.New("el análisis de similitud es solo para trabajos de formulario o archivos"),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	lastReport, err := w.getSimilarity(idObjWork)
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if lastReport != nil && lastReport.Status == models.SIMILARITY_RUNNING &&
+		time.Since(lastReport.Date.Time()) < SIMILARITY_TIMEOUT {
+		return &res.ErrorRes{
+			Err:        errors.New("ya hay un análisis de similitud en curso para este trabajo"),
+			StatusCode: http.StatusConflict,
+		}
+	}
+	threshold := form.Threshold
+	if threshold == 0 {
+		threshold = SIMILARITY_THRESHOLD
+	}
+	report := models.NewModelWorkSimilarity(idObjWork, idObjUser, threshold)
+	if lastReport != nil {
+		report.ID = lastReport.ID
+		_, err = workSimilaritiesModel.Use().ReplaceOne(db.Ctx, bson.D{{
+			Key:   "_id",
+			Value: report.ID,
+		}}, report)
+	} else {
+		var inserted *mongo.InsertOneResult
+		inserted, err = workSimilaritiesModel.NewDocument(report)
+		if err == nil {
+			report.ID = inserted.InsertedID.(primitive.ObjectID)
+		}
+	}
+	if err != nil {
+		return &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	go w.finishSimilarity(work, report)
+	return nil
+}
+
+func (w *WorkSerice) GetSimilarityReport(idWork string) (*SimilarityReportRes, *res.ErrorRes) {
+	idObjWork, err := primitive.ObjectIDFromHex(idWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	report, err := w.getSimilarity(idObjWork)
+	if err != nil {
+		return nil, &res.ErrorRes{
+			Err:        err,
+			StatusCode: http.StatusServiceUnavailable,
+		}
+	}
+	if report == nil {
+		return nil, &res.ErrorRes{
+			Err:        errors.New("este trabajo no tiene análisis de similitud"),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	reportRes := &SimilarityReportRes{
+		Report:   *report,
+		Students: []models.SimpleUser{},
+	}
+	// Students of the flagged pairs
+	var idStudents []primitive.ObjectID
+	added := make(map[primitive.ObjectID]bool)
+	for _, pair := range report.Pairs {
+		for _, idStudent := range []primitive.ObjectID{pair.StudentA, pair.StudentB} {
+			if !added[idStudent] {
+				added[idStudent] = true
+				idStudents = append(idStudents, idStudent)
+			}
+		}
+	}
+	if len(idStudents) > 0 {
+		students, err := w.getStudents(idStudents)
+		if err != nil {
+			return nil, &res.ErrorRes{
+				Err:        err,
+				StatusCode: http.StatusServiceUnavailable,
+			}
+		}
+		for _, student := range students {
+			reportRes.Students = append(reportRes.Students, student.User)
+		}
+	}
+	return reportRes, nil
+}
@@ -31,6 +31,9 @@ type settings struct {
 	COLLEGE_NAME        string
 	CLIENT_URL          string
 	NODE_ENV            string
+	AVERAGE_DECIMALS    int
+	AVERAGE_ROUNDING    string
+	PASS_GRADE          float64
 }
 
 func newSettings() *settings {
@@ -51,6 +54,27 @@ func newSettings() *settings {
 		elsTLS = elsTLSEnv == "true"
 	}
 
+	// Averages rounding, one decimal half up by default
+	averageDecimals := 1
+	if averageDecimalsEnv := os.Getenv("AVERAGE_DECIMALS"); averageDecimalsEnv != "" {
+		averageDecimals, err = strconv.Atoi(averageDecimalsEnv)
+		if err != nil {
+			panic(err)
+		}
+	}
+	averageRounding := os.Getenv("AVERAGE_ROUNDING")
+	if averageRounding == "" {
+		averageRounding = "half_up"
+	}
+	// Pass grade of the linear scales, zero if it is not set
+	var passGrade float64
+	if passGradeEnv := os.Getenv("PASS_GRADE"); passGradeEnv != "" {
+		passGrade, err = strconv.ParseFloat(passGradeEnv, 64)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	return &settings{
 		JWT_SECRET_KEY:      os.Getenv("JWT_SECRET_KEY"),
 		MONGO_DB:            os.Getenv("MONGO_DB"),
@@ -70,6 +94,9 @@ func newSettings() *settings {
 		CLIENT_URL:          os.Getenv("CLIENT_URL"),
 		NODE_ENV:            os.Getenv("NODE_ENV"),
 		MONGO_PORT:          mongoPort,
+		AVERAGE_DECIMALS:    averageDecimals,
+		AVERAGE_ROUNDING:    averageRounding,
+		PASS_GRADE:          passGrade,
 	}
 }
 
@@ -15,10 +15,71 @@ type FormMap struct {
 	Form []models.FormWLookup `json:"form"`
 }
 
+type BankQuestionsMap struct {
+	Questions []models.BankQuestionWLookup `json:"questions"`
+	Total     int                          `json:"total"`
+}
+
+type RubricsMap struct {
+	Rubrics []models.Rubric `json:"rubrics"`
+}
+
 type ProgramGradeMap struct {
 	Programs []models.GradesProgram `json:"programs"`
 }
 
+type GradingScaleMap struct {
+	Scale models.GradingScale `json:"scale"`
+}
+
+type GradeHistoryMap struct {
+	History []models.GradeHistoryWLookup `json:"history"`
+}
+
+type ItemAnalysisMap struct {
+	Analysis services.ItemAnalysisRes `json:"analysis"`
+}
+
+type SimilarityReportMap struct {
+	Similarity services.SimilarityReportRes `json:"similarity"`
+}
+
+type PeerReviewsMap struct {
+	PeerReviews services.ReviewerPeerReviewsRes `json:"peer_reviews"`
+}
+
+type StudentPeerReviewsMap struct {
+	PeerReviews services.StudentPeerReviewsRes `json:"peer_reviews"`
+}
+
+type GradesAnalyticsMap struct {
+	Analytics services.GradesAnalyticsRes `json:"analytics"`
+}
+
+type StudentAveragesMap struct {
+	Averages services.StudentAveragesRes `json:"averages"`
+}
+
+type ProgramTemplatesMap struct {
+	Templates []models.GradeProgramTemplate `json:"templates"`
+}
+
+type ApplyTemplatesMap struct {
+	Applied services.ApplyTemplatesRes `json:"applied"`
+}
+
+type SemesterCloseMap struct {
+	Close models.SemesterClose `json:"close"`
+}
+
+type CloseSemesterPreviewMap struct {
+	Preview services.CloseSemesterPreview `json:"preview"`
+}
+
+type ImportGradesMap struct {
+	Report services.ImportGradesRes `json:"report"`
+}
+
 type StudentsGradesMap struct {
 	Students []services.StudentGrade `json:"students"`
 }
@@ -84,15 +145,21 @@ type WorkMap struct {
 	FormHasPoints bool                                `json:"form_has_points"`
 	FormAccess    *models.FormAccess                  `json:"form_access" extensions:"x-student"`
 	FileUploaded  models.FileUploadedClassroomWLookup `json:"files_uploaded" extensions:"x-student"`
+	Rubric        []services.RubricCriterionRes       `json:"rubric,omitempty" extensions:"x-student,x-omitempty"`
+	Feedback      []models.WorkFeedback               `json:"feedback,omitempty" extensions:"x-student,x-omitempty"`
+	Appeals       []models.WorkAppeal                 `json:"appeals,omitempty" extensions:"x-omitempty"`
 }
 
 type FormWorkMap struct {
-	Form    models.FormWLookup    `json:"form"`
-	Answers []*services.AnswerRes `json:"answers"`
-	Work    struct {
+	Form     models.FormWLookup    `json:"form"`
+	Answers  []*services.AnswerRes `json:"answers"`
+	Feedback *models.WorkFeedback  `json:"feedback,omitempty" extensions:"x-omitempty"`
+	Work     struct {
 		Wtime     bool      `json:"wtime"`
 		DateLimit time.Time `json:"date_limit"`
 		Status    string    `json:"status"`
+		Attempt   int       `json:"attempt,omitempty" extensions:"x-omitempty"`
+		Attempts  int       `json:"attempts,omitempty" extensions:"x-omitempty"`
 		Points    struct {
 			MaxPoints   int `json:"max_points"`
 			TotalPoints int `json:"total_points"`
@@ -101,11 +168,28 @@ type FormWorkMap struct {
 }
 
 type FormStudentMap struct {
-	Form    *models.FormWLookup  `json:"form"`
-	Answers []services.AnswerRes `json:"answers"`
+	Form     *models.FormWLookup  `json:"form"`
+	Answers  []services.AnswerRes `json:"answers"`
+	Attempts []models.FormAttempt `json:"attempts"`
 }
 
 type StudentsStatusMap struct {
 	Students    []services.Student `json:"students"`
 	TotalPoints int                `json:"total_points"`
 }
+
+type WorkExtensionsMap struct {
+	Extensions []models.WorkExtensionWLookup `json:"extensions"`
+}
+
+type WorkClonesMap struct {
+	Works []services.ClonedWork `json:"works"`
+}
+
+type WorkGroupsMap struct {
+	Groups []models.WorkGroupWLookup `json:"groups"`
+}
+
+type WorkFeedbackMap struct {
+	Feedback []models.WorkFeedback `json:"feedback"`
+}